@@ -2,6 +2,7 @@ package buildpb
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/walleframe/wctl/utils"
@@ -77,6 +78,60 @@ func (x *OptionDesc) GetInt64(opt string, def int64) (val int64) {
 	}
 	return v.IntValue
 }
+func (x *OptionDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	v := x.getOpt(opt)
+	if v == nil {
+		return
+	}
+	switch v.Kind {
+	case OptionKind_OptionBool:
+		return v.BoolValue, true
+	case OptionKind_OptionInt:
+		return v.IntValue != 0, true
+	}
+	return
+}
+func (x *OptionDesc) GetBool(opt string, def bool) (val bool) {
+	val, ok := x.GetBoolCheck(opt)
+	if !ok {
+		return def
+	}
+	return
+}
+func (x *OptionDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	v := x.getOpt(opt)
+	if v == nil {
+		return
+	}
+	switch v.Kind {
+	case OptionKind_OptionFloat:
+		return v.FloatValue, true
+	case OptionKind_OptionInt:
+		return float64(v.IntValue), true
+	}
+	return
+}
+func (x *OptionDesc) GetFloat64(opt string, def float64) (val float64) {
+	val, ok := x.GetFloatCheck(opt)
+	if !ok {
+		return def
+	}
+	return
+}
+func (x *OptionDesc) GetList(opt string) (list []*OptionValue) {
+	v := x.getOpt(opt)
+	if v == nil {
+		return
+	}
+	return v.List
+}
+func (x *OptionDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	v := x.getOpt(opt)
+	if v == nil {
+		return
+	}
+	return v.Object
+}
 
 func (x *FileDesc) HasOption(opt string) (ok bool) {
 	return x.Options.HasOption(opt)
@@ -96,6 +151,24 @@ func (x *FileDesc) GetIntCheck(opt string) (val int64, ok bool) {
 func (x *FileDesc) GetInt64(opt string, def int64) (val int64) {
 	return x.Options.GetInt64(opt, def)
 }
+func (x *FileDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *FileDesc) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *FileDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *FileDesc) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *FileDesc) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *FileDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
 
 func (x *MsgDesc) HasOption(opt string) (ok bool) {
 	return x.Options.HasOption(opt)
@@ -115,6 +188,24 @@ func (x *MsgDesc) GetIntCheck(opt string) (val int64, ok bool) {
 func (x *MsgDesc) GetInt64(opt string, def int64) (val int64) {
 	return x.Options.GetInt64(opt, def)
 }
+func (x *MsgDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *MsgDesc) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *MsgDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *MsgDesc) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *MsgDesc) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *MsgDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
 
 func (x *Field) HasOption(opt string) (ok bool) {
 	return x.Options.HasOption(opt)
@@ -134,6 +225,61 @@ func (x *Field) GetIntCheck(opt string) (val int64, ok bool) {
 func (x *Field) GetInt64(opt string, def int64) (val int64) {
 	return x.Options.GetInt64(opt, def)
 }
+func (x *Field) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *Field) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *Field) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *Field) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *Field) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *Field) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
+
+func (x *OneofDesc) HasOption(opt string) (ok bool) {
+	return x.Options.HasOption(opt)
+}
+func (x *OneofDesc) GetStringCheck(opt string) (val string, ok bool) {
+	return x.Options.GetStringCheck(opt)
+}
+func (x *OneofDesc) GetString(opt, def string) string {
+	return x.Options.GetString(opt, def)
+}
+func (x *OneofDesc) GetStringSlice(opt, sep string, def ...string) (slice []string, ok bool) {
+	return x.Options.GetStringSlice(opt, sep, def...)
+}
+func (x *OneofDesc) GetIntCheck(opt string) (val int64, ok bool) {
+	return x.Options.GetIntCheck(opt)
+}
+func (x *OneofDesc) GetInt64(opt string, def int64) (val int64) {
+	return x.Options.GetInt64(opt, def)
+}
+func (x *OneofDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *OneofDesc) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *OneofDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *OneofDesc) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *OneofDesc) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *OneofDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
 
 func (x *MethodDesc) HasOption(opt string) (ok bool) {
 	return x.Options.HasOption(opt)
@@ -153,6 +299,34 @@ func (x *MethodDesc) GetIntCheck(opt string) (val int64, ok bool) {
 func (x *MethodDesc) GetInt64(opt string, def int64) (val int64) {
 	return x.Options.GetInt64(opt, def)
 }
+func (x *MethodDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *MethodDesc) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *MethodDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *MethodDesc) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *MethodDesc) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *MethodDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
+
+// Errors 方法可能返回的错误码. 按声明顺序展开错误枚举的值
+func (x *MethodDesc) Errors() (list []*EnumValue) {
+	for _, v := range x.Throws {
+		if v.Enum != nil {
+			list = append(list, v.Enum.Values...)
+		}
+	}
+	return
+}
 
 func (x *ServiceDesc) HasOption(opt string) (ok bool) {
 	return x.Options.HasOption(opt)
@@ -172,6 +346,24 @@ func (x *ServiceDesc) GetIntCheck(opt string) (val int64, ok bool) {
 func (x *ServiceDesc) GetInt64(opt string, def int64) (val int64) {
 	return x.Options.GetInt64(opt, def)
 }
+func (x *ServiceDesc) GetBoolCheck(opt string) (val bool, ok bool) {
+	return x.Options.GetBoolCheck(opt)
+}
+func (x *ServiceDesc) GetBool(opt string, def bool) (val bool) {
+	return x.Options.GetBool(opt, def)
+}
+func (x *ServiceDesc) GetFloatCheck(opt string) (val float64, ok bool) {
+	return x.Options.GetFloatCheck(opt)
+}
+func (x *ServiceDesc) GetFloat64(opt string, def float64) (val float64) {
+	return x.Options.GetFloat64(opt, def)
+}
+func (x *ServiceDesc) GetList(opt string) (list []*OptionValue) {
+	return x.Options.GetList(opt)
+}
+func (x *ServiceDesc) GetObject(opt string) (obj map[string]*OptionValue) {
+	return x.Options.GetObject(opt)
+}
 
 func (x *Field) GoType() (v string, err error) {
 	switch x.Type.Type {
@@ -182,15 +374,20 @@ func (x *Field) GoType() (v string, err error) {
 		}
 		v = x.Type.Key
 	case FieldType_CustomType:
-		v = "*" + custom(x.Type.Key)
+		v = x.Type.elemPointer() + custom(x.Type.Key)
 	case FieldType_ListType:
+		// 固定长度数组
+		array := "[]"
+		if x.Type.Size > 0 {
+			array = "[" + strconv.FormatInt(x.Type.Size, 10) + "]"
+		}
 		if x.Type.ElemCustom {
-			v = "[]*" + custom(x.Type.Key)
+			v = array + x.Type.elemPointer() + custom(x.Type.Key)
 		} else {
 			if x.Type.KeyBase == BaseTypeDesc_Binary {
-				v = "[][]byte"
+				v = array + "[]byte"
 			} else {
-				v = "[]" + x.Type.Key
+				v = array + x.Type.Key
 			}
 		}
 	case FieldType_MapType:
@@ -200,7 +397,7 @@ func (x *Field) GoType() (v string, err error) {
 			return
 		}
 		if x.Type.ElemCustom {
-			v = "map[" + x.Type.Key + "]*" + custom(x.Type.Value)
+			v = "map[" + x.Type.Key + "]" + x.Type.elemPointer() + custom(x.Type.Value)
 		} else {
 			if x.Type.ValueBase == BaseTypeDesc_Binary {
 				v = "map[" + x.Type.Key + "][]byte"
@@ -214,6 +411,14 @@ func (x *Field) GoType() (v string, err error) {
 	return
 }
 
+// 自定义消息使用指针, 枚举使用值类型
+func (x *TypeDesc) elemPointer() string {
+	if x.ElemEnum {
+		return ""
+	}
+	return "*"
+}
+
 func (x *Field) IsList() bool {
 	return x.Type.Type == FieldType_ListType
 }
@@ -230,11 +435,19 @@ func (x *Field) IsBasicType() bool {
 	return x.Type.Type == FieldType_BaseType
 }
 
+func (x *Field) IsEnum() bool {
+	return x.Type.ElemEnum
+}
+
+func (x *Field) IsOneof() bool {
+	return x.Oneof != ""
+}
+
 func (x *Field) ContainCustom() bool {
 	return x.Type.Msg != nil
 }
 
-func (x Field) CustomMsg() *MsgDesc {
+func (x *Field) CustomMsg() *MsgDesc {
 	return x.Type.Msg
 }
 
@@ -259,3 +472,22 @@ func (x *MsgDesc) ContainCustom() bool {
 	}
 	return false
 }
+
+// GetOneofFields 获取联合字段包含的字段
+func (x *MsgDesc) GetOneofFields(name string) (fields []*Field) {
+	for _, v := range x.Fields {
+		if v.Oneof == name {
+			fields = append(fields, v)
+		}
+	}
+	return
+}
+
+// IsStream 是否流式方法
+func (x *MethodDesc) IsStream() bool {
+	switch x.GetType() {
+	case MethodType_ServerStream, MethodType_ClientStream, MethodType_BidiStream:
+		return true
+	}
+	return false
+}
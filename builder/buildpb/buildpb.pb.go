@@ -20,6 +20,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 选项值类型
+type OptionKind int32
+
+const (
+	OptionKind_OptionString OptionKind = 0
+	OptionKind_OptionInt    OptionKind = 1
+	// 布尔值. 同时设置 IntValue(0,1)
+	OptionKind_OptionBool   OptionKind = 2
+	OptionKind_OptionFloat  OptionKind = 3
+	OptionKind_OptionList   OptionKind = 4
+	OptionKind_OptionObject OptionKind = 5
+)
+
+// Enum value maps for OptionKind.
+var (
+	OptionKind_name = map[int32]string{
+		0: "OptionString",
+		1: "OptionInt",
+		2: "OptionBool",
+		3: "OptionFloat",
+		4: "OptionList",
+		5: "OptionObject",
+	}
+	OptionKind_value = map[string]int32{
+		"OptionString": 0,
+		"OptionInt":    1,
+		"OptionBool":   2,
+		"OptionFloat":  3,
+		"OptionList":   4,
+		"OptionObject": 5,
+	}
+)
+
+func (x OptionKind) Enum() *OptionKind {
+	p := new(OptionKind)
+	*p = x
+	return p
+}
+
+func (x OptionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_buildpb_proto_enumTypes[0].Descriptor()
+}
+
+func (OptionKind) Type() protoreflect.EnumType {
+	return &file_buildpb_proto_enumTypes[0]
+}
+
+func (x OptionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionKind.Descriptor instead.
+func (OptionKind) EnumDescriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{0}
+}
+
 type FieldType int32
 
 const (
@@ -59,11 +119,11 @@ func (x FieldType) String() string {
 }
 
 func (FieldType) Descriptor() protoreflect.EnumDescriptor {
-	return file_buildpb_proto_enumTypes[0].Descriptor()
+	return file_buildpb_proto_enumTypes[1].Descriptor()
 }
 
 func (FieldType) Type() protoreflect.EnumType {
-	return &file_buildpb_proto_enumTypes[0]
+	return &file_buildpb_proto_enumTypes[1]
 }
 
 func (x FieldType) Number() protoreflect.EnumNumber {
@@ -72,7 +132,7 @@ func (x FieldType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FieldType.Descriptor instead.
 func (FieldType) EnumDescriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{0}
+	return file_buildpb_proto_rawDescGZIP(), []int{1}
 }
 
 type MethodType int32
@@ -80,6 +140,14 @@ type MethodType int32
 const (
 	MethodType_Call   MethodType = 0
 	MethodType_Notify MethodType = 1
+	// 服务器推送流
+	MethodType_ServerStream MethodType = 2
+	// 客户端上传流
+	MethodType_ClientStream MethodType = 3
+	// 双向流
+	MethodType_BidiStream MethodType = 4
+	// 广播通知
+	MethodType_Broadcast MethodType = 5
 )
 
 // Enum value maps for MethodType.
@@ -87,10 +155,18 @@ var (
 	MethodType_name = map[int32]string{
 		0: "Call",
 		1: "Notify",
+		2: "ServerStream",
+		3: "ClientStream",
+		4: "BidiStream",
+		5: "Broadcast",
 	}
 	MethodType_value = map[string]int32{
-		"Call":   0,
-		"Notify": 1,
+		"Call":         0,
+		"Notify":       1,
+		"ServerStream": 2,
+		"ClientStream": 3,
+		"BidiStream":   4,
+		"Broadcast":    5,
 	}
 )
 
@@ -105,11 +181,11 @@ func (x MethodType) String() string {
 }
 
 func (MethodType) Descriptor() protoreflect.EnumDescriptor {
-	return file_buildpb_proto_enumTypes[1].Descriptor()
+	return file_buildpb_proto_enumTypes[2].Descriptor()
 }
 
 func (MethodType) Type() protoreflect.EnumType {
-	return &file_buildpb_proto_enumTypes[1]
+	return &file_buildpb_proto_enumTypes[2]
 }
 
 func (x MethodType) Number() protoreflect.EnumNumber {
@@ -118,7 +194,7 @@ func (x MethodType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MethodType.Descriptor instead.
 func (MethodType) EnumDescriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{1}
+	return file_buildpb_proto_rawDescGZIP(), []int{2}
 }
 
 type BaseTypeDesc int32
@@ -184,11 +260,11 @@ func (x BaseTypeDesc) String() string {
 }
 
 func (BaseTypeDesc) Descriptor() protoreflect.EnumDescriptor {
-	return file_buildpb_proto_enumTypes[2].Descriptor()
+	return file_buildpb_proto_enumTypes[3].Descriptor()
 }
 
 func (BaseTypeDesc) Type() protoreflect.EnumType {
-	return &file_buildpb_proto_enumTypes[2]
+	return &file_buildpb_proto_enumTypes[3]
 }
 
 func (x BaseTypeDesc) Number() protoreflect.EnumNumber {
@@ -197,7 +273,7 @@ func (x BaseTypeDesc) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BaseTypeDesc.Descriptor instead.
 func (BaseTypeDesc) EnumDescriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{2}
+	return file_buildpb_proto_rawDescGZIP(), []int{3}
 }
 
 type BuildRQ struct {
@@ -379,6 +455,12 @@ type FileDesc struct {
 	Services []*ServiceDesc `protobuf:"bytes,7,rep,name=Services,proto3" json:"Services,omitempty"`
 	// 项目
 	Projects []*ProjectDesc `protobuf:"bytes,8,rep,name=Projects,proto3" json:"Projects,omitempty"`
+	// 常量
+	Consts []*ConstDesc `protobuf:"bytes,9,rep,name=Consts,proto3" json:"Consts,omitempty"`
+	// 类型别名
+	Aliases []*AliasDesc `protobuf:"bytes,10,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	// 生成的目标(--target). 空表示未裁剪
+	Target string `protobuf:"bytes,11,opt,name=Target,proto3" json:"Target,omitempty"`
 }
 
 func (x *FileDesc) Reset() {
@@ -469,6 +551,119 @@ func (x *FileDesc) GetProjects() []*ProjectDesc {
 	return nil
 }
 
+func (x *FileDesc) GetConsts() []*ConstDesc {
+	if x != nil {
+		return x.Consts
+	}
+	return nil
+}
+
+func (x *FileDesc) GetAliases() []*AliasDesc {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *FileDesc) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// 源码位置. 参考 protobuf SourceCodeInfo
+type SourcePos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 文件名
+	File string `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	// 起始行号,列号. 从1开始, 未知时为0
+	Line   int32 `protobuf:"varint,2,opt,name=Line,proto3" json:"Line,omitempty"`
+	Column int32 `protobuf:"varint,3,opt,name=Column,proto3" json:"Column,omitempty"`
+	// 结束位置. 消息,枚举,联合字段,服务为右括号位置, 未知时为0
+	EndLine   int32 `protobuf:"varint,4,opt,name=EndLine,proto3" json:"EndLine,omitempty"`
+	EndColumn int32 `protobuf:"varint,5,opt,name=EndColumn,proto3" json:"EndColumn,omitempty"`
+	// 元素路径. 由 FileDesc 中的字段名及定义名组成, 例如 Msgs/outer/SubMsgs/inner/Fields/uid
+	Path string `protobuf:"bytes,6,opt,name=Path,proto3" json:"Path,omitempty"`
+}
+
+func (x *SourcePos) Reset() {
+	*x = SourcePos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourcePos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourcePos) ProtoMessage() {}
+
+func (x *SourcePos) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourcePos.ProtoReflect.Descriptor instead.
+func (*SourcePos) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SourcePos) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *SourcePos) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SourcePos) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SourcePos) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *SourcePos) GetEndColumn() int32 {
+	if x != nil {
+		return x.EndColumn
+	}
+	return 0
+}
+
+func (x *SourcePos) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type DocDesc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -476,12 +671,21 @@ type DocDesc struct {
 
 	Doc     []string `protobuf:"bytes,1,rep,name=Doc,proto3" json:"Doc,omitempty"`
 	TailDoc string   `protobuf:"bytes,2,opt,name=TailDoc,proto3" json:"TailDoc,omitempty"`
+	// 已废弃. @deprecated 原因
+	Deprecated       bool   `protobuf:"varint,3,opt,name=Deprecated,proto3" json:"Deprecated,omitempty"`
+	DeprecatedReason string `protobuf:"bytes,4,opt,name=DeprecatedReason,proto3" json:"DeprecatedReason,omitempty"`
+	// 起始版本. @since 1.4
+	Since string `protobuf:"bytes,5,opt,name=Since,proto3" json:"Since,omitempty"`
+	// 示例. @example
+	Examples []string `protobuf:"bytes,6,rep,name=Examples,proto3" json:"Examples,omitempty"`
+	// 参考的定义. @see other.msg
+	See []string `protobuf:"bytes,7,rep,name=See,proto3" json:"See,omitempty"`
 }
 
 func (x *DocDesc) Reset() {
 	*x = DocDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[4]
+		mi := &file_buildpb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +698,7 @@ func (x *DocDesc) String() string {
 func (*DocDesc) ProtoMessage() {}
 
 func (x *DocDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[4]
+	mi := &file_buildpb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +711,7 @@ func (x *DocDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocDesc.ProtoReflect.Descriptor instead.
 func (*DocDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{4}
+	return file_buildpb_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DocDesc) GetDoc() []string {
@@ -524,6 +728,41 @@ func (x *DocDesc) GetTailDoc() string {
 	return ""
 }
 
+func (x *DocDesc) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *DocDesc) GetDeprecatedReason() string {
+	if x != nil {
+		return x.DeprecatedReason
+	}
+	return ""
+}
+
+func (x *DocDesc) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *DocDesc) GetExamples() []string {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *DocDesc) GetSee() []string {
+	if x != nil {
+		return x.See
+	}
+	return nil
+}
+
 type PackageDesc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -538,7 +777,7 @@ type PackageDesc struct {
 func (x *PackageDesc) Reset() {
 	*x = PackageDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[5]
+		mi := &file_buildpb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +790,7 @@ func (x *PackageDesc) String() string {
 func (*PackageDesc) ProtoMessage() {}
 
 func (x *PackageDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[5]
+	mi := &file_buildpb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +803,7 @@ func (x *PackageDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageDesc.ProtoReflect.Descriptor instead.
 func (*PackageDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{5}
+	return file_buildpb_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PackageDesc) GetPackage() string {
@@ -597,7 +836,7 @@ type ImportDesc struct {
 func (x *ImportDesc) Reset() {
 	*x = ImportDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[6]
+		mi := &file_buildpb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +849,7 @@ func (x *ImportDesc) String() string {
 func (*ImportDesc) ProtoMessage() {}
 
 func (x *ImportDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[6]
+	mi := &file_buildpb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +862,7 @@ func (x *ImportDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportDesc.ProtoReflect.Descriptor instead.
 func (*ImportDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{6}
+	return file_buildpb_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ImportDesc) GetDoc() *DocDesc {
@@ -657,12 +896,24 @@ type OptionValue struct {
 	IntValue int64  `protobuf:"varint,2,opt,name=IntValue,proto3" json:"IntValue,omitempty"`
 	// 注释
 	Doc *DocDesc `protobuf:"bytes,3,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	// 引用的常量名. Value/IntValue 为常量值
+	Macro string `protobuf:"bytes,4,opt,name=Macro,proto3" json:"Macro,omitempty"`
+	// 值类型
+	Kind       OptionKind `protobuf:"varint,5,opt,name=Kind,proto3,enum=buildpb.OptionKind" json:"Kind,omitempty"`
+	FloatValue float64    `protobuf:"fixed64,6,opt,name=FloatValue,proto3" json:"FloatValue,omitempty"`
+	BoolValue  bool       `protobuf:"varint,7,opt,name=BoolValue,proto3" json:"BoolValue,omitempty"`
+	// 列表值
+	List []*OptionValue `protobuf:"bytes,8,rep,name=List,proto3" json:"List,omitempty"`
+	// 对象值
+	Object map[string]*OptionValue `protobuf:"bytes,9,rep,name=Object,proto3" json:"Object,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 源码位置. 仅选项(OptionDesc.Options)的值填充
+	Pos *SourcePos `protobuf:"bytes,10,opt,name=Pos,proto3" json:"Pos,omitempty"`
 }
 
 func (x *OptionValue) Reset() {
 	*x = OptionValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[7]
+		mi := &file_buildpb_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -675,7 +926,7 @@ func (x *OptionValue) String() string {
 func (*OptionValue) ProtoMessage() {}
 
 func (x *OptionValue) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[7]
+	mi := &file_buildpb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +939,7 @@ func (x *OptionValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptionValue.ProtoReflect.Descriptor instead.
 func (*OptionValue) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{7}
+	return file_buildpb_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OptionValue) GetValue() string {
@@ -712,6 +963,55 @@ func (x *OptionValue) GetDoc() *DocDesc {
 	return nil
 }
 
+func (x *OptionValue) GetMacro() string {
+	if x != nil {
+		return x.Macro
+	}
+	return ""
+}
+
+func (x *OptionValue) GetKind() OptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return OptionKind_OptionString
+}
+
+func (x *OptionValue) GetFloatValue() float64 {
+	if x != nil {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *OptionValue) GetBoolValue() bool {
+	if x != nil {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *OptionValue) GetList() []*OptionValue {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *OptionValue) GetObject() map[string]*OptionValue {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *OptionValue) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
 // 选项定义
 type OptionDesc struct {
 	state         protoimpl.MessageState
@@ -725,7 +1025,7 @@ type OptionDesc struct {
 func (x *OptionDesc) Reset() {
 	*x = OptionDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[8]
+		mi := &file_buildpb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +1038,7 @@ func (x *OptionDesc) String() string {
 func (*OptionDesc) ProtoMessage() {}
 
 func (x *OptionDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[8]
+	mi := &file_buildpb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1051,7 @@ func (x *OptionDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptionDesc.ProtoReflect.Descriptor instead.
 func (*OptionDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{8}
+	return file_buildpb_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OptionDesc) GetOptions() map[string]*OptionValue {
@@ -771,12 +1071,18 @@ type EnumValue struct {
 	// 注释
 	Doc   *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
 	Value int64    `protobuf:"varint,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// 引用的常量名. Value 为常量值
+	Macro string `protobuf:"bytes,4,opt,name=Macro,proto3" json:"Macro,omitempty"`
+	// 目标标记
+	Tags []string `protobuf:"bytes,5,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,6,opt,name=Pos,proto3" json:"Pos,omitempty"`
 }
 
 func (x *EnumValue) Reset() {
 	*x = EnumValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[9]
+		mi := &file_buildpb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -789,7 +1095,7 @@ func (x *EnumValue) String() string {
 func (*EnumValue) ProtoMessage() {}
 
 func (x *EnumValue) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[9]
+	mi := &file_buildpb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +1108,7 @@ func (x *EnumValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumValue.ProtoReflect.Descriptor instead.
 func (*EnumValue) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{9}
+	return file_buildpb_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EnumValue) GetName() string {
@@ -826,6 +1132,27 @@ func (x *EnumValue) GetValue() int64 {
 	return 0
 }
 
+func (x *EnumValue) GetMacro() string {
+	if x != nil {
+		return x.Macro
+	}
+	return ""
+}
+
+func (x *EnumValue) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EnumValue) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
 // 枚举定义
 type EnumDesc struct {
 	state         protoimpl.MessageState
@@ -838,12 +1165,20 @@ type EnumDesc struct {
 	// 文件选项
 	Options *OptionDesc  `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
 	Values  []*EnumValue `protobuf:"bytes,4,rep,name=Values,proto3" json:"Values,omitempty"`
+	// 保留的枚举值区间
+	ReservedRanges []*ReservedRange `protobuf:"bytes,5,rep,name=ReservedRanges,proto3" json:"ReservedRanges,omitempty"`
+	// 保留的枚举名称
+	ReservedNames []string `protobuf:"bytes,6,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
+	// 包内全名. 嵌套枚举包含外层消息名, 例如 outer.kind
+	FullName string `protobuf:"bytes,7,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,8,opt,name=Pos,proto3" json:"Pos,omitempty"`
 }
 
 func (x *EnumDesc) Reset() {
 	*x = EnumDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[10]
+		mi := &file_buildpb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1191,7 @@ func (x *EnumDesc) String() string {
 func (*EnumDesc) ProtoMessage() {}
 
 func (x *EnumDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[10]
+	mi := &file_buildpb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1204,7 @@ func (x *EnumDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumDesc.ProtoReflect.Descriptor instead.
 func (*EnumDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{10}
+	return file_buildpb_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EnumDesc) GetName() string {
@@ -900,33 +1235,129 @@ func (x *EnumDesc) GetValues() []*EnumValue {
 	return nil
 }
 
-type TypeDesc struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EnumDesc) GetReservedRanges() []*ReservedRange {
+	if x != nil {
+		return x.ReservedRanges
+	}
+	return nil
+}
 
-	Type FieldType `protobuf:"varint,1,opt,name=Type,proto3,enum=buildpb.FieldType" json:"Type,omitempty"`
-	// Type = BaseType,CustomType
-	// 使用 Key
-	// Type = ListType
-	// 使用 Key 根据ElemCustom判断 数组元素是自定义类型还是基础类型
-	// Type = MapType
-	// Key 是基础类型. Value 根据ElemCustom判断 数组元素是自定义类型还是基础类型
-	Key   string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
-	Value string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
-	// 元素 是基础类型还是自定义类型
-	ElemCustom bool `protobuf:"varint,4,opt,name=ElemCustom,proto3" json:"ElemCustom,omitempty"`
-	// 基础类型 和Key/Value 同时填充
+func (x *EnumDesc) GetReservedNames() []string {
+	if x != nil {
+		return x.ReservedNames
+	}
+	return nil
+}
+
+func (x *EnumDesc) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *EnumDesc) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+// 保留序号区间 [Start,End]. End 为 int64 最大值时表示 max
+type ReservedRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=End,proto3" json:"End,omitempty"`
+}
+
+func (x *ReservedRange) Reset() {
+	*x = ReservedRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedRange) ProtoMessage() {}
+
+func (x *ReservedRange) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedRange.ProtoReflect.Descriptor instead.
+func (*ReservedRange) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReservedRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReservedRange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type TypeDesc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FieldType `protobuf:"varint,1,opt,name=Type,proto3,enum=buildpb.FieldType" json:"Type,omitempty"`
+	// Type = BaseType,CustomType
+	// 使用 Key
+	// Type = ListType
+	// 使用 Key 根据ElemCustom判断 数组元素是自定义类型还是基础类型
+	// Type = MapType
+	// Key 是基础类型. Value 根据ElemCustom判断 数组元素是自定义类型还是基础类型
+	Key   string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// 元素 是基础类型还是自定义类型
+	ElemCustom bool `protobuf:"varint,4,opt,name=ElemCustom,proto3" json:"ElemCustom,omitempty"`
+	// 基础类型 和Key/Value 同时填充
 	KeyBase   BaseTypeDesc `protobuf:"varint,5,opt,name=KeyBase,proto3,enum=buildpb.BaseTypeDesc" json:"KeyBase,omitempty"`
 	ValueBase BaseTypeDesc `protobuf:"varint,6,opt,name=ValueBase,proto3,enum=buildpb.BaseTypeDesc" json:"ValueBase,omitempty"`
 	// 关联自定义类型
 	Msg *MsgDesc `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// 类型别名. Key/Value 为别名对应的基础类型
+	KeyAlias   *AliasDesc `protobuf:"bytes,8,opt,name=KeyAlias,proto3" json:"KeyAlias,omitempty"`
+	ValueAlias *AliasDesc `protobuf:"bytes,9,opt,name=ValueAlias,proto3" json:"ValueAlias,omitempty"`
+	// 自定义类型(元素)解析后的全名. 本包类型为包内全名, 其他包类型包含包名
+	FullName string `protobuf:"bytes,10,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	// 自定义类型(元素)是否是枚举. 枚举时 Enum 为关联的枚举定义, Msg 为空
+	ElemEnum bool      `protobuf:"varint,11,opt,name=ElemEnum,proto3" json:"ElemEnum,omitempty"`
+	Enum     *EnumDesc `protobuf:"bytes,12,opt,name=Enum,proto3" json:"Enum,omitempty"`
+	// 固定长度数组的长度. 0 表示不定长
+	Size int64 `protobuf:"varint,13,opt,name=Size,proto3" json:"Size,omitempty"`
+	// 最大长度(数组,map,字符串,二进制). 0 表示不限制
+	MaxLen int64 `protobuf:"varint,14,opt,name=MaxLen,proto3" json:"MaxLen,omitempty"`
 }
 
 func (x *TypeDesc) Reset() {
 	*x = TypeDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[11]
+		mi := &file_buildpb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -939,7 +1370,7 @@ func (x *TypeDesc) String() string {
 func (*TypeDesc) ProtoMessage() {}
 
 func (x *TypeDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[11]
+	mi := &file_buildpb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1383,7 @@ func (x *TypeDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeDesc.ProtoReflect.Descriptor instead.
 func (*TypeDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{11}
+	return file_buildpb_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TypeDesc) GetType() FieldType {
@@ -1004,6 +1435,55 @@ func (x *TypeDesc) GetMsg() *MsgDesc {
 	return nil
 }
 
+func (x *TypeDesc) GetKeyAlias() *AliasDesc {
+	if x != nil {
+		return x.KeyAlias
+	}
+	return nil
+}
+
+func (x *TypeDesc) GetValueAlias() *AliasDesc {
+	if x != nil {
+		return x.ValueAlias
+	}
+	return nil
+}
+
+func (x *TypeDesc) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *TypeDesc) GetElemEnum() bool {
+	if x != nil {
+		return x.ElemEnum
+	}
+	return false
+}
+
+func (x *TypeDesc) GetEnum() *EnumDesc {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *TypeDesc) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TypeDesc) GetMaxLen() int64 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
 type Field struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1018,25 +1498,566 @@ type Field struct {
 	No int32 `protobuf:"varint,4,opt,name=No,proto3" json:"No,omitempty"`
 	// 字段类型
 	Type *TypeDesc `protobuf:"bytes,5,opt,name=Type,proto3" json:"Type,omitempty"`
+	// 所属联合字段名. 空表示不属于oneof
+	Oneof string `protobuf:"bytes,6,opt,name=Oneof,proto3" json:"Oneof,omitempty"`
+	// 可选字段. 区分未设置和零值
+	Optional bool `protobuf:"varint,7,opt,name=Optional,proto3" json:"Optional,omitempty"`
+	// 默认值. 枚举字段 Value 为枚举值名称, IntValue 为枚举值
+	Default *OptionValue `protobuf:"bytes,8,opt,name=Default,proto3" json:"Default,omitempty"`
+	// 嵌入来源消息. 非嵌入字段为空, Msg 为嵌入的消息定义
+	Embed *TypeDesc `protobuf:"bytes,9,opt,name=Embed,proto3" json:"Embed,omitempty"`
+	// 嵌入时的字段序号偏移. No 已包含偏移
+	EmbedOffset int32 `protobuf:"varint,10,opt,name=EmbedOffset,proto3" json:"EmbedOffset,omitempty"`
+	// 目标标记
+	Tags []string `protobuf:"bytes,11,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,12,opt,name=Pos,proto3" json:"Pos,omitempty"`
 }
 
 func (x *Field) Reset() {
 	*x = Field{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[12]
+		mi := &file_buildpb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Field) GetDoc() *DocDesc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *Field) GetOptions() *OptionDesc {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Field) GetNo() int32 {
+	if x != nil {
+		return x.No
+	}
+	return 0
+}
+
+func (x *Field) GetType() *TypeDesc {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Field) GetOneof() string {
+	if x != nil {
+		return x.Oneof
+	}
+	return ""
+}
+
+func (x *Field) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Field) GetDefault() *OptionValue {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *Field) GetEmbed() *TypeDesc {
+	if x != nil {
+		return x.Embed
+	}
+	return nil
+}
+
+func (x *Field) GetEmbedOffset() int32 {
+	if x != nil {
+		return x.EmbedOffset
+	}
+	return 0
+}
+
+func (x *Field) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Field) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+// 联合字段定义
+type OneofDesc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// 注释
+	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	// 文件选项
+	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
+	// 字段名(字段定义在消息字段中)
+	Fields []string `protobuf:"bytes,4,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,5,opt,name=Pos,proto3" json:"Pos,omitempty"`
+}
+
+func (x *OneofDesc) Reset() {
+	*x = OneofDesc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofDesc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofDesc) ProtoMessage() {}
+
+func (x *OneofDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofDesc.ProtoReflect.Descriptor instead.
+func (*OneofDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OneofDesc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OneofDesc) GetDoc() *DocDesc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *OneofDesc) GetOptions() *OptionDesc {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *OneofDesc) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *OneofDesc) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+type MsgDesc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// 注释
+	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	// 文件选项
+	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
+	// 字段
+	Fields []*Field `protobuf:"bytes,4,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// 子消息
+	SubMsgs []*MsgDesc `protobuf:"bytes,5,rep,name=SubMsgs,proto3" json:"SubMsgs,omitempty"`
+	// 联合字段
+	Oneofs []*OneofDesc `protobuf:"bytes,6,rep,name=Oneofs,proto3" json:"Oneofs,omitempty"`
+	// 保留的字段序号区间
+	ReservedRanges []*ReservedRange `protobuf:"bytes,7,rep,name=ReservedRanges,proto3" json:"ReservedRanges,omitempty"`
+	// 保留的字段名称
+	ReservedNames []string `protobuf:"bytes,8,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
+	// 消息ID. 未设置时为0
+	MsgID int64 `protobuf:"varint,9,opt,name=MsgID,proto3" json:"MsgID,omitempty"`
+	// 消息ID引用的常量名. MsgID 为常量值
+	MsgMacro string `protobuf:"bytes,10,opt,name=MsgMacro,proto3" json:"MsgMacro,omitempty"`
+	// 嵌套枚举
+	SubEnums []*EnumDesc `protobuf:"bytes,11,rep,name=SubEnums,proto3" json:"SubEnums,omitempty"`
+	// 包内全名. 嵌套消息包含外层消息名, 例如 outer.inner
+	FullName string `protobuf:"bytes,12,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	// 目标标记
+	Tags []string `protobuf:"bytes,13,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,14,opt,name=Pos,proto3" json:"Pos,omitempty"`
+}
+
+func (x *MsgDesc) Reset() {
+	*x = MsgDesc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDesc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDesc) ProtoMessage() {}
+
+func (x *MsgDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgDesc.ProtoReflect.Descriptor instead.
+func (*MsgDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgDesc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgDesc) GetDoc() *DocDesc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetOptions() *OptionDesc {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetSubMsgs() []*MsgDesc {
+	if x != nil {
+		return x.SubMsgs
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetOneofs() []*OneofDesc {
+	if x != nil {
+		return x.Oneofs
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetReservedRanges() []*ReservedRange {
+	if x != nil {
+		return x.ReservedRanges
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetReservedNames() []string {
+	if x != nil {
+		return x.ReservedNames
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetMsgID() int64 {
+	if x != nil {
+		return x.MsgID
+	}
+	return 0
+}
+
+func (x *MsgDesc) GetMsgMacro() string {
+	if x != nil {
+		return x.MsgMacro
+	}
+	return ""
+}
+
+func (x *MsgDesc) GetSubEnums() []*EnumDesc {
+	if x != nil {
+		return x.SubEnums
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *MsgDesc) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MsgDesc) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+type MethodDesc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// 注释
+	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	// 文件选项
+	Options  *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
+	Request  *MsgDesc    `protobuf:"bytes,4,opt,name=Request,proto3" json:"Request,omitempty"`
+	Reply    *MsgDesc    `protobuf:"bytes,5,opt,name=Reply,proto3" json:"Reply,omitempty"`
+	MethodID int64       `protobuf:"varint,6,opt,name=MethodID,proto3" json:"MethodID,omitempty"`
+	// flag
+	MethodFlag int32 `protobuf:"varint,7,opt,name=MethodFlag,proto3" json:"MethodFlag,omitempty"`
+	// 方法ID引用的常量名. MethodID 为常量值
+	MethodMacro string `protobuf:"bytes,8,opt,name=MethodMacro,proto3" json:"MethodMacro,omitempty"`
+	// 可能返回的错误枚举(包含服务声明的). Enum 为枚举定义, FullName 为枚举全名
+	Throws []*TypeDesc `protobuf:"bytes,9,rep,name=Throws,proto3" json:"Throws,omitempty"`
+	// 方法类型. 与 MethodFlag 相同
+	Type MethodType `protobuf:"varint,10,opt,name=Type,proto3,enum=buildpb.MethodType" json:"Type,omitempty"`
+	// 目标标记
+	Tags []string `protobuf:"bytes,11,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,12,opt,name=Pos,proto3" json:"Pos,omitempty"`
+}
+
+func (x *MethodDesc) Reset() {
+	*x = MethodDesc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodDesc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodDesc) ProtoMessage() {}
+
+func (x *MethodDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodDesc.ProtoReflect.Descriptor instead.
+func (*MethodDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MethodDesc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MethodDesc) GetDoc() *DocDesc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetOptions() *OptionDesc {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetRequest() *MsgDesc {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetReply() *MsgDesc {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetMethodID() int64 {
+	if x != nil {
+		return x.MethodID
+	}
+	return 0
+}
+
+func (x *MethodDesc) GetMethodFlag() int32 {
+	if x != nil {
+		return x.MethodFlag
+	}
+	return 0
+}
+
+func (x *MethodDesc) GetMethodMacro() string {
+	if x != nil {
+		return x.MethodMacro
+	}
+	return ""
+}
+
+func (x *MethodDesc) GetThrows() []*TypeDesc {
+	if x != nil {
+		return x.Throws
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetType() MethodType {
+	if x != nil {
+		return x.Type
+	}
+	return MethodType_Call
+}
+
+func (x *MethodDesc) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MethodDesc) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+type ServiceDesc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// 注释
+	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	// 文件选项
+	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
+	// 方法集合
+	Methods []*MethodDesc `protobuf:"bytes,4,rep,name=Methods,proto3" json:"Methods,omitempty"`
+	// 服务声明的错误枚举
+	Throws []*TypeDesc `protobuf:"bytes,5,rep,name=Throws,proto3" json:"Throws,omitempty"`
+	// 目标标记
+	Tags []string `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// 源码位置
+	Pos *SourcePos `protobuf:"bytes,7,opt,name=Pos,proto3" json:"Pos,omitempty"`
+}
+
+func (x *ServiceDesc) Reset() {
+	*x = ServiceDesc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buildpb_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Field) String() string {
+func (x *ServiceDesc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Field) ProtoMessage() {}
+func (*ServiceDesc) ProtoMessage() {}
 
-func (x *Field) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[12]
+func (x *ServiceDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,79 +2068,90 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Field.ProtoReflect.Descriptor instead.
-func (*Field) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ServiceDesc.ProtoReflect.Descriptor instead.
+func (*ServiceDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Field) GetName() string {
+func (x *ServiceDesc) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Field) GetDoc() *DocDesc {
+func (x *ServiceDesc) GetDoc() *DocDesc {
 	if x != nil {
 		return x.Doc
 	}
 	return nil
 }
 
-func (x *Field) GetOptions() *OptionDesc {
+func (x *ServiceDesc) GetOptions() *OptionDesc {
 	if x != nil {
 		return x.Options
 	}
 	return nil
 }
 
-func (x *Field) GetNo() int32 {
+func (x *ServiceDesc) GetMethods() []*MethodDesc {
 	if x != nil {
-		return x.No
+		return x.Methods
 	}
-	return 0
+	return nil
 }
 
-func (x *Field) GetType() *TypeDesc {
+func (x *ServiceDesc) GetThrows() []*TypeDesc {
 	if x != nil {
-		return x.Type
+		return x.Throws
 	}
 	return nil
 }
 
-type MsgDesc struct {
+func (x *ServiceDesc) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ServiceDesc) GetPos() *SourcePos {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+// 常量值
+type ConstValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	// 注释
-	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
-	// 文件选项
-	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
-	// 字段
-	Fields []*Field `protobuf:"bytes,4,rep,name=Fields,proto3" json:"Fields,omitempty"`
-	// 子消息
-	SubMsgs []*MsgDesc `protobuf:"bytes,5,rep,name=SubMsgs,proto3" json:"SubMsgs,omitempty"`
+	Doc      *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
+	Value    string   `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	IntValue int64    `protobuf:"varint,4,opt,name=IntValue,proto3" json:"IntValue,omitempty"`
 }
 
-func (x *MsgDesc) Reset() {
-	*x = MsgDesc{}
+func (x *ConstValue) Reset() {
+	*x = ConstValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[13]
+		mi := &file_buildpb_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDesc) String() string {
+func (x *ConstValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDesc) ProtoMessage() {}
+func (*ConstValue) ProtoMessage() {}
 
-func (x *MsgDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[13]
+func (x *ConstValue) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,47 +2162,41 @@ func (x *MsgDesc) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgDesc.ProtoReflect.Descriptor instead.
-func (*MsgDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ConstValue.ProtoReflect.Descriptor instead.
+func (*ConstValue) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgDesc) GetName() string {
+func (x *ConstValue) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgDesc) GetDoc() *DocDesc {
+func (x *ConstValue) GetDoc() *DocDesc {
 	if x != nil {
 		return x.Doc
 	}
 	return nil
 }
 
-func (x *MsgDesc) GetOptions() *OptionDesc {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *MsgDesc) GetFields() []*Field {
+func (x *ConstValue) GetValue() string {
 	if x != nil {
-		return x.Fields
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgDesc) GetSubMsgs() []*MsgDesc {
+func (x *ConstValue) GetIntValue() int64 {
 	if x != nil {
-		return x.SubMsgs
+		return x.IntValue
 	}
-	return nil
+	return 0
 }
 
-type MethodDesc struct {
+// 常量定义
+type ConstDesc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1178,32 +2204,28 @@ type MethodDesc struct {
 	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	// 注释
 	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
-	// 文件选项
-	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
-	Request *MsgDesc `protobuf:"bytes,4,opt,name=Request,proto3" json:"Request,omitempty"`
-	Reply   *MsgDesc `protobuf:"bytes,5,opt,name=Reply,proto3" json:"Reply,omitempty"`
-	MethodID int64 `protobuf:"varint,6,opt,name=MethodID,proto3" json:"MethodID,omitempty"`
-	// flag
-	MethodFlag int32 `protobuf:"varint,7,opt,name=MethodFlag,proto3" json:"MethodFlag,omitempty"`
+	// 常量类型. 整数类型或String
+	Type   BaseTypeDesc  `protobuf:"varint,3,opt,name=Type,proto3,enum=buildpb.BaseTypeDesc" json:"Type,omitempty"`
+	Values []*ConstValue `protobuf:"bytes,4,rep,name=Values,proto3" json:"Values,omitempty"`
 }
 
-func (x *MethodDesc) Reset() {
-	*x = MethodDesc{}
+func (x *ConstDesc) Reset() {
+	*x = ConstDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[14]
+		mi := &file_buildpb_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MethodDesc) String() string {
+func (x *ConstDesc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MethodDesc) ProtoMessage() {}
+func (*ConstDesc) ProtoMessage() {}
 
-func (x *MethodDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[14]
+func (x *ConstDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,91 +2236,71 @@ func (x *MethodDesc) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MethodDesc.ProtoReflect.Descriptor instead.
-func (*MethodDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ConstDesc.ProtoReflect.Descriptor instead.
+func (*ConstDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MethodDesc) GetName() string {
+func (x *ConstDesc) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MethodDesc) GetDoc() *DocDesc {
+func (x *ConstDesc) GetDoc() *DocDesc {
 	if x != nil {
 		return x.Doc
 	}
 	return nil
 }
 
-func (x *MethodDesc) GetOptions() *OptionDesc {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *MethodDesc) GetRequest() *MsgDesc {
+func (x *ConstDesc) GetType() BaseTypeDesc {
 	if x != nil {
-		return x.Request
+		return x.Type
 	}
-	return nil
+	return BaseTypeDesc_Int8
 }
 
-func (x *MethodDesc) GetReply() *MsgDesc {
+func (x *ConstDesc) GetValues() []*ConstValue {
 	if x != nil {
-		return x.Reply
+		return x.Values
 	}
 	return nil
 }
 
-func (x *MethodDesc) GetMethodID() int64 {
-	if x != nil {
-		return x.MethodID
-	}
-	return 0
-}
-
-func (x *MethodDesc) GetMethodFlag() int32 {
-	if x != nil {
-		return x.MethodFlag
-	}
-	return 0
-}
-
-type ServiceDesc struct {
+type AliasDesc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// 别名
 	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	// 注释
 	Doc *DocDesc `protobuf:"bytes,2,opt,name=Doc,proto3" json:"Doc,omitempty"`
-	// 文件选项
-	Options *OptionDesc `protobuf:"bytes,3,opt,name=Options,proto3" json:"Options,omitempty"`
-	// 方法集合
-	Methods []*MethodDesc `protobuf:"bytes,4,rep,name=Methods,proto3" json:"Methods,omitempty"`
+	// 定义别名的包名
+	Package string `protobuf:"bytes,3,opt,name=Package,proto3" json:"Package,omitempty"`
+	// 基础类型
+	Type BaseTypeDesc `protobuf:"varint,4,opt,name=Type,proto3,enum=buildpb.BaseTypeDesc" json:"Type,omitempty"`
 }
 
-func (x *ServiceDesc) Reset() {
-	*x = ServiceDesc{}
+func (x *AliasDesc) Reset() {
+	*x = AliasDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[15]
+		mi := &file_buildpb_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceDesc) String() string {
+func (x *AliasDesc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceDesc) ProtoMessage() {}
+func (*AliasDesc) ProtoMessage() {}
 
-func (x *ServiceDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[15]
+func (x *AliasDesc) ProtoReflect() protoreflect.Message {
+	mi := &file_buildpb_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,37 +2311,37 @@ func (x *ServiceDesc) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceDesc.ProtoReflect.Descriptor instead.
-func (*ServiceDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AliasDesc.ProtoReflect.Descriptor instead.
+func (*AliasDesc) Descriptor() ([]byte, []int) {
+	return file_buildpb_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ServiceDesc) GetName() string {
+func (x *AliasDesc) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ServiceDesc) GetDoc() *DocDesc {
+func (x *AliasDesc) GetDoc() *DocDesc {
 	if x != nil {
 		return x.Doc
 	}
 	return nil
 }
 
-func (x *ServiceDesc) GetOptions() *OptionDesc {
+func (x *AliasDesc) GetPackage() string {
 	if x != nil {
-		return x.Options
+		return x.Package
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceDesc) GetMethods() []*MethodDesc {
+func (x *AliasDesc) GetType() BaseTypeDesc {
 	if x != nil {
-		return x.Methods
+		return x.Type
 	}
-	return nil
+	return BaseTypeDesc_Int8
 }
 
 type ProjectDesc struct {
@@ -1357,7 +2359,7 @@ type ProjectDesc struct {
 func (x *ProjectDesc) Reset() {
 	*x = ProjectDesc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buildpb_proto_msgTypes[16]
+		mi := &file_buildpb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1370,7 +2372,7 @@ func (x *ProjectDesc) String() string {
 func (*ProjectDesc) ProtoMessage() {}
 
 func (x *ProjectDesc) ProtoReflect() protoreflect.Message {
-	mi := &file_buildpb_proto_msgTypes[16]
+	mi := &file_buildpb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,7 +2385,7 @@ func (x *ProjectDesc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectDesc.ProtoReflect.Descriptor instead.
 func (*ProjectDesc) Descriptor() ([]byte, []int) {
-	return file_buildpb_proto_rawDescGZIP(), []int{16}
+	return file_buildpb_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProjectDesc) GetName() string {
@@ -1429,7 +2431,7 @@ var file_buildpb_proto_rawDesc = []byte{
 	0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x53, 0x12, 0x2c, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
 	0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc9, 0x03, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44,
 	0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x50,
@@ -1451,118 +2453,289 @@ var file_buildpb_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
 	0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x22, 0x35, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44,
-	0x6f, 0x63, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a,
-	0x07, 0x54, 0x61, 0x69, 0x6c, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x54, 0x61, 0x69, 0x6c, 0x44, 0x6f, 0x63, 0x22, 0x4b, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52,
-	0x03, 0x44, 0x6f, 0x63, 0x22, 0x5a, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65,
-	0x73, 0x63, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73,
-	0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65,
-	0x22, 0x63, 0x0a, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63,
-	0x52, 0x03, 0x44, 0x6f, 0x63, 0x22, 0x9a, 0x01, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x12, 0x3a, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0x50, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x59, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65,
-	0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9d, 0x01,
-	0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
-	0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44,
-	0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x2a, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84, 0x02,
-	0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6c,
-	0x65, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x45, 0x6c, 0x65, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x07, 0x4b, 0x65,
-	0x79, 0x42, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
-	0x73, 0x63, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52,
-	0x03, 0x4d, 0x73, 0x67, 0x22, 0xa5, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73,
-	0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x4e, 0x6f, 0x12, 0x25, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x01, 0x0a,
-	0x07, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x65, 0x73,
+	0x63, 0x52, 0x07, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45,
+	0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0xc5, 0x01, 0x0a,
+	0x07, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61,
+	0x69, 0x6c, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x69,
+	0x6c, 0x44, 0x6f, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x53, 0x65, 0x65, 0x22, 0x4b, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a,
+	0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f,
+	0x63, 0x22, 0x5a, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12,
+	0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03,
+	0x44, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xbb, 0x03,
+	0x0a, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03,
+	0x44, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x12, 0x27, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x1a, 0x4f, 0x0a, 0x0b, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x0a,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x3a, 0x0a, 0x07, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x50, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24,
+	0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52,
+	0x03, 0x50, 0x6f, 0x73, 0x22, 0xc5, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x73,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63,
+	0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52,
+	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x75,
+	0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x75,
+	0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x22, 0x37, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0xf3, 0x03, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6c, 0x65, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x6c, 0x65, 0x6d, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x42,
+	0x61, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x09, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x08,
+	0x4b, 0x65, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x65,
+	0x73, 0x63, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x0a,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x6c, 0x65, 0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x45, 0x6c, 0x65, 0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x22, 0x8c, 0x03, 0x0a, 0x05,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a,
+	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x4e, 0x6f, 0x12, 0x25, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x05, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x09, 0x4f,
+	0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03,
 	0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c,
 	0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63,
 	0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x26, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x4d, 0x73,
-	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x53, 0x75, 0x62, 0x4d,
-	0x73, 0x67, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65,
-	0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f,
-	0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d,
-	0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a,
-	0x08, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x22, 0x8d, 0x04,
+	0x0a, 0x07, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
 	0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69,
 	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f,
 	0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x2d, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22,
+	0x12, 0x26, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x4d,
+	0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x53, 0x75, 0x62,
+	0x4d, 0x73, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f,
+	0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65, 0x73, 0x63, 0x52, 0x06, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x73,
+	0x12, 0x3e, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x4d, 0x73, 0x67, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4d, 0x73, 0x67, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x45,
+	0x6e, 0x75, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x52, 0x08, 0x53,
+	0x75, 0x62, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x22, 0xb3, 0x03,
+	0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52,
+	0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x73, 0x63, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x63,
+	0x52, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x46, 0x6c, 0x61,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4d, 0x61, 0x63,
+	0x72, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4d, 0x61, 0x63, 0x72, 0x6f, 0x12, 0x29, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x06, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a,
+	0x03, 0x50, 0x6f, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03,
+	0x50, 0x6f, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44,
+	0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63,
+	0x52, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x54, 0x68, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x06, 0x54, 0x68,
+	0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x50, 0x6f, 0x73, 0x22, 0x76,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52,
+	0x03, 0x44, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x44, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x29, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x44, 0x65,
+	0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f,
+	0x63, 0x44, 0x65, 0x73, 0x63, 0x52, 0x03, 0x44, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22,
 	0xc7, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12,
 	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
@@ -1575,28 +2748,40 @@ var file_buildpb_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x75, 0x69, 0x6c,
 	0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x50, 0x0a, 0x09, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x6e, 0x6b, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x10, 0x02, 0x12, 0x0b,
-	0x0a, 0x07, 0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x10, 0x04, 0x2a, 0x22, 0x0a, 0x0a, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x61, 0x6c,
-	0x6c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x10, 0x01, 0x2a,
-	0xa4, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x69,
-	0x6e, 0x74, 0x38, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x31, 0x36, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x06, 0x12, 0x0a,
-	0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x10, 0x0b, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x36, 0x34, 0x10, 0x0c, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x6f, 0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f,
-	0x77, 0x63, 0x74, 0x6c, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x70, 0x0a, 0x0a, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x6c, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x05, 0x2a, 0x50, 0x0a, 0x09, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x6e, 0x6b, 0x6f,
+	0x77, 0x6e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x10, 0x03, 0x12, 0x0e, 0x0a,
+	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x10, 0x04, 0x2a, 0x65, 0x0a,
+	0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x43,
+	0x61, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x69, 0x64, 0x69, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x10, 0x05, 0x2a, 0xa4, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x55, 0x69, 0x6e, 0x74, 0x38, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e,
+	0x74, 0x31, 0x36, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x31, 0x36, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x10, 0x07, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x10,
+	0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x10, 0x0b, 0x12, 0x0b,
+	0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x10, 0x0c, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x2f, 0x77, 0x63, 0x74, 0x6c, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65,
+	0x72, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1611,79 +2796,121 @@ func file_buildpb_proto_rawDescGZIP() []byte {
 	return file_buildpb_proto_rawDescData
 }
 
-var file_buildpb_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_buildpb_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_buildpb_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_buildpb_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_buildpb_proto_goTypes = []interface{}{
-	(FieldType)(0),      // 0: buildpb.FieldType
-	(MethodType)(0),     // 1: buildpb.MethodType
-	(BaseTypeDesc)(0),   // 2: buildpb.BaseTypeDesc
-	(*BuildRQ)(nil),     // 3: buildpb.BuildRQ
-	(*BuildOutput)(nil), // 4: buildpb.BuildOutput
-	(*BuildRS)(nil),     // 5: buildpb.BuildRS
-	(*FileDesc)(nil),    // 6: buildpb.FileDesc
-	(*DocDesc)(nil),     // 7: buildpb.DocDesc
-	(*PackageDesc)(nil), // 8: buildpb.PackageDesc
-	(*ImportDesc)(nil),  // 9: buildpb.ImportDesc
-	(*OptionValue)(nil), // 10: buildpb.OptionValue
-	(*OptionDesc)(nil),  // 11: buildpb.OptionDesc
-	(*EnumValue)(nil),   // 12: buildpb.EnumValue
-	(*EnumDesc)(nil),    // 13: buildpb.EnumDesc
-	(*TypeDesc)(nil),    // 14: buildpb.TypeDesc
-	(*Field)(nil),       // 15: buildpb.Field
-	(*MsgDesc)(nil),     // 16: buildpb.MsgDesc
-	(*MethodDesc)(nil),  // 17: buildpb.MethodDesc
-	(*ServiceDesc)(nil), // 18: buildpb.ServiceDesc
-	(*ProjectDesc)(nil), // 19: buildpb.ProjectDesc
-	nil,                 // 20: buildpb.BuildRQ.ProgramsEntry
-	nil,                 // 21: buildpb.OptionDesc.OptionsEntry
-	nil,                 // 22: buildpb.ProjectDesc.ConfEntry
+	(OptionKind)(0),       // 0: buildpb.OptionKind
+	(FieldType)(0),        // 1: buildpb.FieldType
+	(MethodType)(0),       // 2: buildpb.MethodType
+	(BaseTypeDesc)(0),     // 3: buildpb.BaseTypeDesc
+	(*BuildRQ)(nil),       // 4: buildpb.BuildRQ
+	(*BuildOutput)(nil),   // 5: buildpb.BuildOutput
+	(*BuildRS)(nil),       // 6: buildpb.BuildRS
+	(*FileDesc)(nil),      // 7: buildpb.FileDesc
+	(*SourcePos)(nil),     // 8: buildpb.SourcePos
+	(*DocDesc)(nil),       // 9: buildpb.DocDesc
+	(*PackageDesc)(nil),   // 10: buildpb.PackageDesc
+	(*ImportDesc)(nil),    // 11: buildpb.ImportDesc
+	(*OptionValue)(nil),   // 12: buildpb.OptionValue
+	(*OptionDesc)(nil),    // 13: buildpb.OptionDesc
+	(*EnumValue)(nil),     // 14: buildpb.EnumValue
+	(*EnumDesc)(nil),      // 15: buildpb.EnumDesc
+	(*ReservedRange)(nil), // 16: buildpb.ReservedRange
+	(*TypeDesc)(nil),      // 17: buildpb.TypeDesc
+	(*Field)(nil),         // 18: buildpb.Field
+	(*OneofDesc)(nil),     // 19: buildpb.OneofDesc
+	(*MsgDesc)(nil),       // 20: buildpb.MsgDesc
+	(*MethodDesc)(nil),    // 21: buildpb.MethodDesc
+	(*ServiceDesc)(nil),   // 22: buildpb.ServiceDesc
+	(*ConstValue)(nil),    // 23: buildpb.ConstValue
+	(*ConstDesc)(nil),     // 24: buildpb.ConstDesc
+	(*AliasDesc)(nil),     // 25: buildpb.AliasDesc
+	(*ProjectDesc)(nil),   // 26: buildpb.ProjectDesc
+	nil,                   // 27: buildpb.BuildRQ.ProgramsEntry
+	nil,                   // 28: buildpb.OptionValue.ObjectEntry
+	nil,                   // 29: buildpb.OptionDesc.OptionsEntry
+	nil,                   // 30: buildpb.ProjectDesc.ConfEntry
 }
 var file_buildpb_proto_depIdxs = []int32{
-	20, // 0: buildpb.BuildRQ.Programs:type_name -> buildpb.BuildRQ.ProgramsEntry
-	4,  // 1: buildpb.BuildRS.Result:type_name -> buildpb.BuildOutput
-	8,  // 2: buildpb.FileDesc.Pkg:type_name -> buildpb.PackageDesc
-	9,  // 3: buildpb.FileDesc.Imports:type_name -> buildpb.ImportDesc
-	11, // 4: buildpb.FileDesc.Options:type_name -> buildpb.OptionDesc
-	13, // 5: buildpb.FileDesc.Enums:type_name -> buildpb.EnumDesc
-	16, // 6: buildpb.FileDesc.Msgs:type_name -> buildpb.MsgDesc
-	18, // 7: buildpb.FileDesc.Services:type_name -> buildpb.ServiceDesc
-	19, // 8: buildpb.FileDesc.Projects:type_name -> buildpb.ProjectDesc
-	7,  // 9: buildpb.PackageDesc.Doc:type_name -> buildpb.DocDesc
-	7,  // 10: buildpb.ImportDesc.Doc:type_name -> buildpb.DocDesc
-	7,  // 11: buildpb.OptionValue.Doc:type_name -> buildpb.DocDesc
-	21, // 12: buildpb.OptionDesc.Options:type_name -> buildpb.OptionDesc.OptionsEntry
-	7,  // 13: buildpb.EnumValue.Doc:type_name -> buildpb.DocDesc
-	7,  // 14: buildpb.EnumDesc.Doc:type_name -> buildpb.DocDesc
-	11, // 15: buildpb.EnumDesc.Options:type_name -> buildpb.OptionDesc
-	12, // 16: buildpb.EnumDesc.Values:type_name -> buildpb.EnumValue
-	0,  // 17: buildpb.TypeDesc.Type:type_name -> buildpb.FieldType
-	2,  // 18: buildpb.TypeDesc.KeyBase:type_name -> buildpb.BaseTypeDesc
-	2,  // 19: buildpb.TypeDesc.ValueBase:type_name -> buildpb.BaseTypeDesc
-	16, // 20: buildpb.TypeDesc.Msg:type_name -> buildpb.MsgDesc
-	7,  // 21: buildpb.Field.Doc:type_name -> buildpb.DocDesc
-	11, // 22: buildpb.Field.Options:type_name -> buildpb.OptionDesc
-	14, // 23: buildpb.Field.Type:type_name -> buildpb.TypeDesc
-	7,  // 24: buildpb.MsgDesc.Doc:type_name -> buildpb.DocDesc
-	11, // 25: buildpb.MsgDesc.Options:type_name -> buildpb.OptionDesc
-	15, // 26: buildpb.MsgDesc.Fields:type_name -> buildpb.Field
-	16, // 27: buildpb.MsgDesc.SubMsgs:type_name -> buildpb.MsgDesc
-	7,  // 28: buildpb.MethodDesc.Doc:type_name -> buildpb.DocDesc
-	11, // 29: buildpb.MethodDesc.Options:type_name -> buildpb.OptionDesc
-	16, // 30: buildpb.MethodDesc.Request:type_name -> buildpb.MsgDesc
-	16, // 31: buildpb.MethodDesc.Reply:type_name -> buildpb.MsgDesc
-	7,  // 32: buildpb.ServiceDesc.Doc:type_name -> buildpb.DocDesc
-	11, // 33: buildpb.ServiceDesc.Options:type_name -> buildpb.OptionDesc
-	17, // 34: buildpb.ServiceDesc.Methods:type_name -> buildpb.MethodDesc
-	7,  // 35: buildpb.ProjectDesc.Doc:type_name -> buildpb.DocDesc
-	22, // 36: buildpb.ProjectDesc.Conf:type_name -> buildpb.ProjectDesc.ConfEntry
-	6,  // 37: buildpb.BuildRQ.ProgramsEntry.value:type_name -> buildpb.FileDesc
-	10, // 38: buildpb.OptionDesc.OptionsEntry.value:type_name -> buildpb.OptionValue
-	11, // 39: buildpb.ProjectDesc.ConfEntry.value:type_name -> buildpb.OptionDesc
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	27, // 0: buildpb.BuildRQ.Programs:type_name -> buildpb.BuildRQ.ProgramsEntry
+	5,  // 1: buildpb.BuildRS.Result:type_name -> buildpb.BuildOutput
+	10, // 2: buildpb.FileDesc.Pkg:type_name -> buildpb.PackageDesc
+	11, // 3: buildpb.FileDesc.Imports:type_name -> buildpb.ImportDesc
+	13, // 4: buildpb.FileDesc.Options:type_name -> buildpb.OptionDesc
+	15, // 5: buildpb.FileDesc.Enums:type_name -> buildpb.EnumDesc
+	20, // 6: buildpb.FileDesc.Msgs:type_name -> buildpb.MsgDesc
+	22, // 7: buildpb.FileDesc.Services:type_name -> buildpb.ServiceDesc
+	26, // 8: buildpb.FileDesc.Projects:type_name -> buildpb.ProjectDesc
+	24, // 9: buildpb.FileDesc.Consts:type_name -> buildpb.ConstDesc
+	25, // 10: buildpb.FileDesc.Aliases:type_name -> buildpb.AliasDesc
+	9,  // 11: buildpb.PackageDesc.Doc:type_name -> buildpb.DocDesc
+	9,  // 12: buildpb.ImportDesc.Doc:type_name -> buildpb.DocDesc
+	9,  // 13: buildpb.OptionValue.Doc:type_name -> buildpb.DocDesc
+	0,  // 14: buildpb.OptionValue.Kind:type_name -> buildpb.OptionKind
+	12, // 15: buildpb.OptionValue.List:type_name -> buildpb.OptionValue
+	28, // 16: buildpb.OptionValue.Object:type_name -> buildpb.OptionValue.ObjectEntry
+	8,  // 17: buildpb.OptionValue.Pos:type_name -> buildpb.SourcePos
+	29, // 18: buildpb.OptionDesc.Options:type_name -> buildpb.OptionDesc.OptionsEntry
+	9,  // 19: buildpb.EnumValue.Doc:type_name -> buildpb.DocDesc
+	8,  // 20: buildpb.EnumValue.Pos:type_name -> buildpb.SourcePos
+	9,  // 21: buildpb.EnumDesc.Doc:type_name -> buildpb.DocDesc
+	13, // 22: buildpb.EnumDesc.Options:type_name -> buildpb.OptionDesc
+	14, // 23: buildpb.EnumDesc.Values:type_name -> buildpb.EnumValue
+	16, // 24: buildpb.EnumDesc.ReservedRanges:type_name -> buildpb.ReservedRange
+	8,  // 25: buildpb.EnumDesc.Pos:type_name -> buildpb.SourcePos
+	1,  // 26: buildpb.TypeDesc.Type:type_name -> buildpb.FieldType
+	3,  // 27: buildpb.TypeDesc.KeyBase:type_name -> buildpb.BaseTypeDesc
+	3,  // 28: buildpb.TypeDesc.ValueBase:type_name -> buildpb.BaseTypeDesc
+	20, // 29: buildpb.TypeDesc.Msg:type_name -> buildpb.MsgDesc
+	25, // 30: buildpb.TypeDesc.KeyAlias:type_name -> buildpb.AliasDesc
+	25, // 31: buildpb.TypeDesc.ValueAlias:type_name -> buildpb.AliasDesc
+	15, // 32: buildpb.TypeDesc.Enum:type_name -> buildpb.EnumDesc
+	9,  // 33: buildpb.Field.Doc:type_name -> buildpb.DocDesc
+	13, // 34: buildpb.Field.Options:type_name -> buildpb.OptionDesc
+	17, // 35: buildpb.Field.Type:type_name -> buildpb.TypeDesc
+	12, // 36: buildpb.Field.Default:type_name -> buildpb.OptionValue
+	17, // 37: buildpb.Field.Embed:type_name -> buildpb.TypeDesc
+	8,  // 38: buildpb.Field.Pos:type_name -> buildpb.SourcePos
+	9,  // 39: buildpb.OneofDesc.Doc:type_name -> buildpb.DocDesc
+	13, // 40: buildpb.OneofDesc.Options:type_name -> buildpb.OptionDesc
+	8,  // 41: buildpb.OneofDesc.Pos:type_name -> buildpb.SourcePos
+	9,  // 42: buildpb.MsgDesc.Doc:type_name -> buildpb.DocDesc
+	13, // 43: buildpb.MsgDesc.Options:type_name -> buildpb.OptionDesc
+	18, // 44: buildpb.MsgDesc.Fields:type_name -> buildpb.Field
+	20, // 45: buildpb.MsgDesc.SubMsgs:type_name -> buildpb.MsgDesc
+	19, // 46: buildpb.MsgDesc.Oneofs:type_name -> buildpb.OneofDesc
+	16, // 47: buildpb.MsgDesc.ReservedRanges:type_name -> buildpb.ReservedRange
+	15, // 48: buildpb.MsgDesc.SubEnums:type_name -> buildpb.EnumDesc
+	8,  // 49: buildpb.MsgDesc.Pos:type_name -> buildpb.SourcePos
+	9,  // 50: buildpb.MethodDesc.Doc:type_name -> buildpb.DocDesc
+	13, // 51: buildpb.MethodDesc.Options:type_name -> buildpb.OptionDesc
+	20, // 52: buildpb.MethodDesc.Request:type_name -> buildpb.MsgDesc
+	20, // 53: buildpb.MethodDesc.Reply:type_name -> buildpb.MsgDesc
+	17, // 54: buildpb.MethodDesc.Throws:type_name -> buildpb.TypeDesc
+	2,  // 55: buildpb.MethodDesc.Type:type_name -> buildpb.MethodType
+	8,  // 56: buildpb.MethodDesc.Pos:type_name -> buildpb.SourcePos
+	9,  // 57: buildpb.ServiceDesc.Doc:type_name -> buildpb.DocDesc
+	13, // 58: buildpb.ServiceDesc.Options:type_name -> buildpb.OptionDesc
+	21, // 59: buildpb.ServiceDesc.Methods:type_name -> buildpb.MethodDesc
+	17, // 60: buildpb.ServiceDesc.Throws:type_name -> buildpb.TypeDesc
+	8,  // 61: buildpb.ServiceDesc.Pos:type_name -> buildpb.SourcePos
+	9,  // 62: buildpb.ConstValue.Doc:type_name -> buildpb.DocDesc
+	9,  // 63: buildpb.ConstDesc.Doc:type_name -> buildpb.DocDesc
+	3,  // 64: buildpb.ConstDesc.Type:type_name -> buildpb.BaseTypeDesc
+	23, // 65: buildpb.ConstDesc.Values:type_name -> buildpb.ConstValue
+	9,  // 66: buildpb.AliasDesc.Doc:type_name -> buildpb.DocDesc
+	3,  // 67: buildpb.AliasDesc.Type:type_name -> buildpb.BaseTypeDesc
+	9,  // 68: buildpb.ProjectDesc.Doc:type_name -> buildpb.DocDesc
+	30, // 69: buildpb.ProjectDesc.Conf:type_name -> buildpb.ProjectDesc.ConfEntry
+	7,  // 70: buildpb.BuildRQ.ProgramsEntry.value:type_name -> buildpb.FileDesc
+	12, // 71: buildpb.OptionValue.ObjectEntry.value:type_name -> buildpb.OptionValue
+	12, // 72: buildpb.OptionDesc.OptionsEntry.value:type_name -> buildpb.OptionValue
+	13, // 73: buildpb.ProjectDesc.ConfEntry.value:type_name -> buildpb.OptionDesc
+	74, // [74:74] is the sub-list for method output_type
+	74, // [74:74] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_buildpb_proto_init() }
@@ -1741,7 +2968,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocDesc); i {
+			switch v := v.(*SourcePos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1753,7 +2980,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PackageDesc); i {
+			switch v := v.(*DocDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1765,7 +2992,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportDesc); i {
+			switch v := v.(*PackageDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1777,7 +3004,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptionValue); i {
+			switch v := v.(*ImportDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,7 +3016,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptionDesc); i {
+			switch v := v.(*OptionValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,7 +3028,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumValue); i {
+			switch v := v.(*OptionDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,7 +3040,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumDesc); i {
+			switch v := v.(*EnumValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1825,7 +3052,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeDesc); i {
+			switch v := v.(*EnumDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1837,7 +3064,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Field); i {
+			switch v := v.(*ReservedRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1849,7 +3076,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDesc); i {
+			switch v := v.(*TypeDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1861,7 +3088,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MethodDesc); i {
+			switch v := v.(*Field); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1873,7 +3100,7 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceDesc); i {
+			switch v := v.(*OneofDesc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1885,6 +3112,78 @@ func file_buildpb_proto_init() {
 			}
 		}
 		file_buildpb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDesc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MethodDesc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceDesc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConstValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConstDesc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AliasDesc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buildpb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProjectDesc); i {
 			case 0:
 				return &v.state
@@ -1902,8 +3201,8 @@ func file_buildpb_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_buildpb_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   20,
+			NumEnums:      4,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -41,4 +41,22 @@ func (gen *Generator) LuaDoc(doc *buildpb.DocDesc) {
 		v = "--" + strings.TrimPrefix(v, "//")
 		gen.P(v)
 	}
+	if doc.Deprecated {
+		gen.P("---@deprecated ", doc.DeprecatedReason)
+	}
+}
+
+// GoDeprecated 输出go风格的废弃说明. 在 Doc 之后调用
+func (gen *Generator) GoDeprecated(doc *buildpb.DocDesc) {
+	if !doc.GetDeprecated() {
+		return
+	}
+	if len(doc.Doc) > 0 {
+		gen.P("//")
+	}
+	reason := doc.DeprecatedReason
+	if reason == "" {
+		reason = "do not use."
+	}
+	gen.P("// Deprecated: ", reason)
 }
@@ -133,17 +133,25 @@ func baseTypeName(typ *ast.YTBaseType) string {
 	}
 }
 
+// 自定义类型名. 使用解析后的全名, 嵌套类型在其他消息中可以正确引用
+func customTypeName(typ *ast.YTCustomType) string {
+	if typ.FullName != "" {
+		return typ.FullName
+	}
+	return typ.Name
+}
+
 func getTypeName(typ *ast.YTFieldType) string {
 	switch {
 	case typ.YTBaseType != nil:
 		return baseTypeName(typ.YTBaseType)
 	case typ.YTCustomType != nil:
-		return typ.YTCustomType.Name
+		return customTypeName(typ.YTCustomType)
 	case typ.YTListType != nil:
 		if typ.YTListType.YTBaseType != nil {
 			return "repeated " + baseTypeName(typ.YTListType.YTBaseType)
 		} else if typ.YTListType.YTCustomType != nil {
-			return "repeated " + typ.YTListType.YTCustomType.Name
+			return "repeated " + customTypeName(typ.YTListType.YTCustomType)
 		}
 	case typ.YTMapTypee != nil:
 
@@ -152,7 +160,7 @@ func getTypeName(typ *ast.YTFieldType) string {
 				baseTypeName(typ.YTMapTypee.Value.YTBaseType) + ">"
 		} else if typ.YTMapTypee.Value.YTCustomType != nil {
 			return "map<" + baseTypeName(typ.YTMapTypee.Key) + "," +
-				typ.YTMapTypee.Value.YTCustomType.Name + ">"
+				customTypeName(typ.YTMapTypee.Value.YTCustomType) + ">"
 		}
 	}
 	fmt.Printf("%#v\n", typ)
@@ -187,34 +195,109 @@ func transProtobuf(prog *ast.YTProgram) (data []byte, err error) {
 	pb.P()
 	// 枚举定义
 	for _, v := range prog.EnumDefs {
-		printDoc(pb, v.YTDoc)
-		pb.P(`enum `, v.Name, " {")
-		pb.In()
-
-		for _, ev := range v.Values {
-			printDoc(pb, ev.YTDoc)
-			pb.P(ev.Name, " = ", ev.Value, ";")
-		}
-
-		pb.Out()
-		pb.P(`}`)
+		printEnum(pb, v)
 	}
 	// Message
 	for _, v := range prog.Messages {
-		printDoc(pb, v.YTDoc)
-		pb.P(`message `, v.Name, " {")
-		pb.In()
+		printMessage(pb, v)
+	}
+	data, _ = pb.Bytes()
+	return
+}
+
+func printEnum(pb *gen.Generator, def *ast.YTEnumDef) {
+	printDoc(pb, def.YTDoc)
+	pb.P(`enum `, def.Name, " {")
+	pb.In()
+
+	printDeprecated(pb, def.YTDoc)
+	printReserved(pb, &def.Reserved)
+	for _, ev := range def.Values {
+		printDoc(pb, ev.YTDoc)
+		pb.P(ev.Name, " = ", ev.Value, fieldDeprecated(ev.YTDoc), ";")
+	}
 
-		for _, fv := range v.Fields {
-			printDoc(pb, fv.YTDoc)
-			pb.P(getTypeName(fv.Type), " ", fv.Name, " = ", fv.No, ";")
+	pb.Out()
+	pb.P(`}`)
+}
+
+func printMessage(pb *gen.Generator, msg *ast.YTMessage) {
+	printDoc(pb, msg.YTDoc)
+	pb.P(`message `, msg.Name, " {")
+	pb.In()
+
+	printDeprecated(pb, msg.YTDoc)
+	printReserved(pb, &msg.Reserved)
+	// 嵌套类型
+	for _, sub := range msg.SubEnums {
+		printEnum(pb, sub)
+	}
+	for _, sub := range msg.SubMsgs {
+		printMessage(pb, sub)
+	}
+	for _, fv := range msg.Fields {
+		if fv.Oneof != nil {
+			// 联合字段在第一个字段处整体输出
+			if fv == fv.Oneof.Fields[0] {
+				printOneof(pb, fv.Oneof)
+			}
+			continue
+		}
+		printDoc(pb, fv.YTDoc)
+		// proto3 不支持默认值, 只输出 optional
+		label := ""
+		if fv.Optional {
+			label = "optional "
 		}
+		pb.P(label, getTypeName(fv.Type), " ", fv.Name, " = ", fv.No, fieldDeprecated(fv.YTDoc), ";")
+	}
+
+	pb.Out()
+	pb.P(`}`)
+}
 
-		pb.Out()
-		pb.P(`}`)
+func printOneof(pb *gen.Generator, oneof *ast.YTOneof) {
+	printDoc(pb, oneof.YTDoc)
+	pb.P(`oneof `, oneof.Name, " {")
+	pb.In()
+	for _, fv := range oneof.Fields {
+		printDoc(pb, fv.YTDoc)
+		pb.P(getTypeName(fv.Type), " ", fv.Name, " = ", fv.No, fieldDeprecated(fv.YTDoc), ";")
+	}
+	pb.Out()
+	pb.P(`}`)
+}
+
+// 消息及枚举的废弃选项
+func printDeprecated(pb *gen.Generator, doc *ast.YTDoc) {
+	if doc.IsDeprecated() {
+		pb.P("option deprecated = true;")
+	}
+}
+
+// 字段及枚举值的废弃选项
+func fieldDeprecated(doc *ast.YTDoc) string {
+	if doc.IsDeprecated() {
+		return " [deprecated = true]"
+	}
+	return ""
+}
+
+func printReserved(pb *gen.Generator, rs *ast.YTReserved) {
+	if len(rs.Ranges) > 0 {
+		list := make([]string, 0, len(rs.Ranges))
+		for _, v := range rs.Ranges {
+			list = append(list, v.String())
+		}
+		pb.P("reserved ", strings.Join(list, ", "), ";")
+	}
+	if len(rs.Names) > 0 {
+		list := make([]string, 0, len(rs.Names))
+		for _, v := range rs.Names {
+			list = append(list, `"`+v.Name+`"`)
+		}
+		pb.P("reserved ", strings.Join(list, ", "), ";")
 	}
-	data, _ = pb.Bytes()
-	return
 }
 
 // var gToProtoTemplate = `
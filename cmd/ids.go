@@ -0,0 +1,39 @@
+/*
+   Copyright © 2020 aggronmagi <czy463@163.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/walleframe/wctl/commands/ids"
+
+	"github.com/spf13/cobra"
+)
+
+// idsCmd represents the ids command
+var idsCmd = &cobra.Command{
+	Use:     "ids",
+	Short:   "打印消息ID注册表",
+	Long:    ids.Help,
+	Example: ids.Example,
+	Version: ids.Version,
+	Run:     ids.RunCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(idsCmd)
+	// 命令参数
+	ids.Flags(idsCmd.Flags())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -29,12 +30,31 @@ var config = struct {
 	cmdPlguins []string
 	// 全局选项,属性配置
 	options []string
+	// 选项配置文件
+	optionsFile string
 	// 是否合并文件
 	mergeFile bool
 	// 文件名后缀
 	fileSuffix string
+	// 是否使用数值做请求ID
+	useMethodID bool
+	// import 查找目录
+	importPaths []string
+	// 是否允许循环导入
+	allowImportCycle bool
+	// 自动分配接口序号方式
+	methodIDAlloc string
+	// 序号锁定文件
+	methodIDLock string
+	// 消息ID自动分配区间
+	msgIDRanges []string
+	// 选项定义文件
+	optionSchemas []string
+	// 生成目标. 按目标标记裁剪定义
+	target string
 }{
-	fileSuffix: ".wproto",
+	fileSuffix:   ".wproto",
+	methodIDLock: "method_id.lock",
 }
 
 const (
@@ -57,6 +77,10 @@ const (
 输入文件会 输出到同样的相对路径(基于-o参数)
 如果没有指定 -f 参数. 将递归解析-i所在目录下所有 .yt 文件
 
+3. 依赖文件分布在多个目录. 使用 -I/--proto-path 参数追加查找目录(可以多次设置)
+	wctl gen -i base_dir -I third_party -I common path/xx.yt
+import 文件按 -i, -I 的顺序查找. 多个目录中存在同名文件时,使用第一个并输出警告.
+
 内置生成器：
   printer 用于打印输出信息，调试用
 `
@@ -70,6 +94,12 @@ const (
   wctl gen -i base_dir -f path/xx.yt
 解析某个目录
   wctl gen -i base_dir 
+追加import查找目录
+  wctl gen -i base_dir -I third_party path/xx.yt
+自动分配接口序号
+  wctl gen -i base_dir --method-id-alloc seq --method-id-lock method_id.lock
+只生成客户端使用的定义
+  wctl gen -i base_dir --target client
 `
 )
 
@@ -81,6 +111,7 @@ func Flags(genCmd *pflag.FlagSet) {
 	genCmd.StringSliceVarP(&config.files, "file", "f", nil, "解析文件")
 	genCmd.StringVarP(&config.input, "input", "i", "./", "输入基础路径.查找文件基于这个目录进行查找.")
 	genCmd.StringVarP(&config.output, "output", "o", "", "输出文件路径,默认使用input目录")
+	genCmd.StringSliceVarP(&config.importPaths, "proto-path", "I", nil, "import文件查找目录.可以多次设置,按顺序查找")
 
 	// 插件支持
 	genCmd.StringSliceVar(&config.goPlugins, "go-plugin", nil, "go版本插件")
@@ -89,8 +120,15 @@ func Flags(genCmd *pflag.FlagSet) {
 	genCmd.StringSliceVarP(&config.cmdPlguins, "cmd", "c", nil, "创建命令行生成器 可执行文件名")
 
 	// 全局选项
-	genCmd.StringSliceVar(&config.options, "options", nil, `全局Options. 格式为 "xx.xxx=66" "xx.x1" "xx.xx2=xxx"`)
-	genCmd.BoolVar(&ast.Flag.ServiceUseMethodID, "use-method-id", ast.Flag.ServiceUseMethodID, "是否使用数值做请求ID")
+	genCmd.StringArrayVar(&config.options, "options", nil, `选项. 格式为 "xx.xxx=66" "xx.x1" "pkg.Msg.field:xx.xx2=xxx" "service/*:xx.x3=5". 每次设置一个选项,值中可以包含","`)
+	genCmd.StringVar(&config.optionsFile, "options-file", "", "选项配置文件. 每行一个选项(格式同--options), #开头为注释. 命令行选项优先")
+	genCmd.BoolVar(&config.useMethodID, "use-method-id", config.useMethodID, "是否使用数值做请求ID")
+	genCmd.StringVar(&config.methodIDAlloc, "method-id-alloc", config.methodIDAlloc, "自动分配未设置的接口序号(hash|seq). 开启后默认使用数值做请求ID")
+	genCmd.StringVar(&config.methodIDLock, "method-id-lock", config.methodIDLock, "序号锁定文件(基于input目录). 保存分配的接口序号及消息ID,应提交到版本库")
+	genCmd.StringSliceVar(&config.msgIDRanges, "msg-id-range", nil, `消息ID自动分配区间. 格式 "package=min-max", 可以多次设置`)
+	genCmd.StringSliceVar(&config.optionSchemas, "option-schema", nil, "选项定义文件(yaml,基于input目录). 可以多次设置")
+	genCmd.StringVar(&config.target, "target", config.target, "生成目标. 裁剪标记了其他目标(例如 @server)的消息,字段,枚举值,服务及方法")
+	genCmd.BoolVar(&config.allowImportCycle, "allow-import-cycle", config.allowImportCycle, "是否允许循环导入(循环导入的文件之间只能引用类型)")
 	genCmd.StringVar(&config.fileSuffix, "suffix", config.fileSuffix, "解析文件后缀名")
 	genCmd.BoolVarP(&config.mergeFile, "merge-same-file", "m", false, "执行命令时,不论是否是同一个插件. 生成文件名相同时候,是否合并文件(开启后,会在内存缓存生成的文件信息)")
 }
@@ -150,7 +188,16 @@ func executeGenCmd() {
 	if utils.Debug() {
 		fmt.Printf("config %#v", config)
 	}
-	protocol.SetBasePath(config.input)
+	loader := protocol.NewLoader(
+		protocol.WithBasePath(config.input),
+		protocol.WithServiceUseMethodID(config.useMethodID),
+		protocol.WithImportPaths(config.importPaths...),
+		protocol.WithAllowImportCycle(config.allowImportCycle),
+		protocol.WithMethodIDAlloc(config.methodIDAlloc),
+		protocol.WithMethodIDLock(config.methodIDLock),
+		protocol.WithMsgIDRanges(config.msgIDRanges...),
+		protocol.WithOptionSchemaFiles(config.optionSchemas...),
+	)
 	var progList []*ast.YTProgram
 	var prog *ast.YTProgram
 	var err error
@@ -158,17 +205,32 @@ func executeGenCmd() {
 	if len(config.files) > 0 {
 		// 文件列表
 		for _, file := range config.files {
-			prog, err = protocol.AnalyseFile(file)
+			prog, err = loader.AnalyseFile(file)
 			utils.PanicIf(err)
 			progList = append(progList, prog)
 		}
 	} else {
 		// 解析目录
-		progList, err = protocol.AnlysePath(config.input, config.fileSuffix)
+		progList, err = loader.AnalysePath(config.input, config.fileSuffix)
+		utils.PanicIf(err)
+	}
+	for _, v := range loader.Warnings() {
+		fmt.Fprintln(os.Stderr, "WARN", v)
+	}
+	err = loader.SaveMethodIDLock()
+	utils.PanicIf(err)
+	// 选项覆盖. 配置文件在前, 命令行在后
+	options, err := readOptionsFile(config.optionsFile)
+	utils.PanicIf(err)
+	options = append(options, config.options...)
+	for _, v := range progList {
+		err = v.ApplyCmdOptions(options...)
 		utils.PanicIf(err)
 	}
+	// 按目标裁剪
 	for _, v := range progList {
-		v.ApplyCmdOptions(config.options...)
+		err = v.FilterTarget(config.target)
+		utils.PanicIf(err)
 	}
 	// 解析完成. 进行生成
 	err = builder.Build(progList, config.output, config.mergeFile)
@@ -179,3 +241,22 @@ func executeGenCmd() {
 	}
 	return
 }
+
+// 读取选项配置文件. 每行一个选项, 忽略空行及#开头的注释
+func readOptionsFile(file string) (options []string, err error) {
+	if file == "" {
+		return
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read options file failed. %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		options = append(options, line)
+	}
+	return
+}
@@ -0,0 +1,33 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/walleframe/wctl/protocol/ast"
+)
+
+func TestOptionsFlag(t *testing.T) {
+	defer func() {
+		config.options = nil
+	}()
+	set := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+	Flags(set)
+	err := set.Parse([]string{
+		"--options", `pkg.Msg.field:go.tag=json:"x,omitempty"`,
+		"--options", "a.b=1",
+	})
+	if !assert.Nil(t, err, "parse flags") {
+		return
+	}
+	// 值中的","不分隔选项
+	assert.Equal(t, []string{`pkg.Msg.field:go.tag=json:"x,omitempty"`, "a.b=1"}, config.options, "options flag")
+
+	ov, err := ast.ParseOptionOverride(config.options[0])
+	if assert.Nil(t, err, "parse override") {
+		assert.Equal(t, "pkg.Msg.field", ov.Pattern, "override pattern")
+		assert.Equal(t, "go.tag", ov.Key, "override key")
+		assert.Equal(t, `json:"x,omitempty"`, *ov.Value.Value, "override value")
+	}
+}
@@ -0,0 +1,160 @@
+package ids
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/walleframe/wctl/protocol"
+	"github.com/walleframe/wctl/utils"
+)
+
+var config = struct {
+	// 输入目录
+	input string
+	// 指定文件
+	files []string
+	// import 查找目录
+	importPaths []string
+	// 文件名后缀
+	fileSuffix string
+	// 序号锁定文件
+	methodIDLock string
+	// 消息ID自动分配区间
+	msgIDRanges []string
+	// 输出格式
+	format string
+}{
+	input:        "./",
+	fileSuffix:   ".wproto",
+	methodIDLock: "method_id.lock",
+	format:       "table",
+}
+
+const (
+	// Version 命令行工具版本
+	Version = "0.0.1"
+
+	Help = `打印消息ID注册表:
+
+解析协议文件(参数与 gen 命令相同), 输出所有消息的消息ID.
+包括显式设置的消息ID和按 --msg-id-range 区间自动分配的消息ID. 只读取锁定文件,不会修改.
+输出格式: table(默认), csv, json
+`
+	Example = `打印目录下所有消息ID
+  wctl ids -i base_dir
+按区间分配并输出csv
+  wctl ids -i base_dir --msg-id-range login=1000-1999 --format csv
+`
+)
+
+func Flags(cmd *pflag.FlagSet) {
+	// 参数不排序
+	cmd.SortFlags = false
+
+	cmd.StringSliceVarP(&config.files, "file", "f", nil, "解析文件")
+	cmd.StringVarP(&config.input, "input", "i", config.input, "输入基础路径.查找文件基于这个目录进行查找.")
+	cmd.StringSliceVarP(&config.importPaths, "proto-path", "I", nil, "import文件查找目录.可以多次设置,按顺序查找")
+	cmd.StringVar(&config.fileSuffix, "suffix", config.fileSuffix, "解析文件后缀名")
+	cmd.StringVar(&config.methodIDLock, "method-id-lock", config.methodIDLock, "序号锁定文件(基于input目录)")
+	cmd.StringSliceVar(&config.msgIDRanges, "msg-id-range", nil, `消息ID自动分配区间. 格式 "package=min-max", 可以多次设置`)
+	cmd.StringVar(&config.format, "format", config.format, "输出格式(table|csv|json)")
+}
+
+// RunCommand run ids command
+func RunCommand(cmd *cobra.Command, args []string) {
+	config.files = append(config.files, args...)
+	config.input, _ = filepath.Abs(config.input)
+	loader := protocol.NewLoader(
+		protocol.WithBasePath(config.input),
+		protocol.WithImportPaths(config.importPaths...),
+		protocol.WithMethodIDLock(config.methodIDLock),
+		protocol.WithMsgIDRanges(config.msgIDRanges...),
+	)
+	var err error
+	if len(config.files) > 0 {
+		for _, file := range config.files {
+			_, err = loader.AnalyseFile(file)
+			utils.PanicIf(err)
+		}
+	} else {
+		_, err = loader.AnalysePath(config.input, config.fileSuffix)
+		utils.PanicIf(err)
+	}
+	for _, v := range loader.Warnings() {
+		fmt.Fprintln(os.Stderr, "WARN", v)
+	}
+	err = Print(os.Stdout, config.format, loader.MessageIDs())
+	utils.PanicIf(err)
+}
+
+// 注册表输出行
+type row struct {
+	ID      int64  `json:"id"`
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Macro   string `json:"macro,omitempty"`
+	Auto    bool   `json:"auto"`
+}
+
+var header = []string{"ID", "PACKAGE", "MESSAGE", "FILE", "MACRO", "AUTO"}
+
+// Print 按格式输出消息ID注册表
+func Print(w io.Writer, format string, list []*protocol.MessageID) (err error) {
+	rows := make([]row, 0, len(list))
+	for _, v := range list {
+		rows = append(rows, row{
+			ID:      v.ID,
+			Package: v.Package,
+			Name:    v.Name,
+			File:    v.File,
+			Line:    v.Pos.Line,
+			Macro:   v.Macro,
+			Auto:    v.Auto,
+		})
+	}
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(header)
+		for _, v := range rows {
+			cw.Write(v.strings())
+		}
+		cw.Flush()
+		return cw.Error()
+	case "table", "":
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, v := range rows {
+			fmt.Fprintln(tw, strings.Join(v.strings(), "\t"))
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("invalid format [%s], support table,csv,json", format)
+	}
+}
+
+func (r row) strings() []string {
+	return []string{
+		strconv.FormatInt(r.ID, 10),
+		r.Package,
+		r.Name,
+		r.File + ":" + strconv.Itoa(r.Line),
+		r.Macro,
+		strconv.FormatBool(r.Auto),
+	}
+}
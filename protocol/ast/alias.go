@@ -0,0 +1,155 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// YTTypeAlias 类型别名. type player_id = int64;
+type YTTypeAlias struct {
+	*YTDoc
+	DefPos token.Pos
+	Name   string
+	// 定义别名的包名
+	Package string
+	// 引用的类型名. 其他类型别名(未解析时)
+	Type string
+	// 基础类型. 引用其他别名时在分析阶段填充
+	Base *YTBaseType
+}
+
+// 类型别名定义检测. 名称与消息,枚举等共用命名空间
+func (prog *YTProgram) checkTypeAliasDefine() (err error) {
+	for _, alias := range prog.Aliases {
+		if last, ok := prog.checkUnionName(alias.Name); ok {
+			return NewErrorPos(alias.DefPos, "type alias name repeated [%s] %s", alias.Name, last.String())
+		}
+		prog.addUnionName(alias.Name, alias.DefPos)
+		alias.Package = prog.Pkg.Name
+	}
+	return
+}
+
+// 查找类型别名. 格式为 "别名" 或 "包名(import别名).别名"
+func (prog *YTProgram) lookupAlias(name string) (*YTProgram, *YTTypeAlias) {
+	iprogs := []*YTProgram{prog}
+	if idx := strings.IndexByte(name, '.'); idx >= 0 {
+		var ok bool
+		iprogs, ok = prog.impMap[name[:idx]]
+		if !ok && name[:idx] == prog.Pkg.Name {
+			iprogs = []*YTProgram{prog}
+		}
+		name = name[idx+1:]
+	}
+	for _, iprog := range iprogs {
+		for _, alias := range iprog.Aliases {
+			if alias.Name == name {
+				return iprog, alias
+			}
+		}
+	}
+	return nil, nil
+}
+
+// 解析别名对应的基础类型. 支持别名的别名
+func (prog *YTProgram) resolveAlias(alias *YTTypeAlias, visiting map[*YTTypeAlias]struct{}) (err error) {
+	if alias.Base != nil {
+		return
+	}
+	if _, ok := visiting[alias]; ok {
+		return NewErrorPos(alias.DefPos, "type alias [%s] circular reference", alias.Name)
+	}
+	visiting[alias] = struct{}{}
+	iprog, ref := prog.lookupAlias(alias.Type)
+	if ref == nil {
+		return NewErrorPos(alias.DefPos, "type alias [%s] type [%s] must be basic type or type alias", alias.Name, alias.Type)
+	}
+	if err = iprog.resolveAlias(ref, visiting); err != nil {
+		return
+	}
+	alias.Base = ref.Base
+	return
+}
+
+// 填充类型别名. 别名解析为基础类型, 字段中使用的别名替换为基础类型
+func (prog *YTProgram) resolveTypeAliases() (err error) {
+	for _, alias := range prog.Aliases {
+		if err = prog.resolveAlias(alias, make(map[*YTTypeAlias]struct{})); err != nil {
+			return
+		}
+	}
+	return prog.RangeMessages(func(name string, msg *YTMessage) error {
+		for _, field := range msg.Fields {
+			if err := prog.resolveFieldAlias(field.Type); err != nil {
+				return NewErrorPos(field.DefPos, "message field [%s.%s] %v", name, field.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// 查找并解析类型别名. 未找到时返回nil
+func (prog *YTProgram) findAlias(name string) (*YTTypeAlias, error) {
+	iprog, alias := prog.lookupAlias(name)
+	if alias == nil {
+		return nil, nil
+	}
+	if err := iprog.resolveAlias(alias, make(map[*YTTypeAlias]struct{})); err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+// 字段类型中的别名替换为基础类型
+func (prog *YTProgram) resolveFieldAlias(typ *YTFieldType) (err error) {
+	var alias *YTTypeAlias
+	switch {
+	case typ.YTCustomType != nil:
+		if alias, err = prog.findAlias(typ.YTCustomType.Name); alias != nil {
+			typ.Alias, typ.YTBaseType, typ.YTCustomType = alias, alias.Base, nil
+		}
+	case typ.YTListType != nil:
+		err = prog.resolveListAlias(typ.YTListType)
+	case typ.YTMapTypee != nil:
+		if typ.KeyAlias != nil && typ.Key == nil {
+			name := typ.KeyAlias.Name
+			if alias, err = prog.findAlias(name); err != nil {
+				return
+			}
+			if alias == nil {
+				return fmt.Errorf("map key type [%s] must be basic type or type alias", name)
+			}
+			typ.KeyAlias, typ.Key = alias, alias.Base
+		}
+		err = prog.resolveListAlias(typ.YTMapTypee.Value)
+	}
+	return
+}
+
+func (prog *YTProgram) resolveListAlias(typ *YTListType) (err error) {
+	if typ.YTCustomType == nil {
+		return
+	}
+	alias, err := prog.findAlias(typ.YTCustomType.Name)
+	if alias != nil {
+		typ.Alias, typ.YTBaseType, typ.YTCustomType = alias, alias.Base, nil
+	}
+	return
+}
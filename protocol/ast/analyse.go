@@ -35,16 +35,35 @@ func (f RecursionAnalyseFunc) Analyse(file string) (prog *YTProgram, err error)
 
 var _ RecursionAnalyser = RecursionAnalyseFunc(nil)
 
-// 本地全局.递归解析函数
-var RegisterRecursionAnalyser RecursionAnalyser = nil
-
-// AnalyseProgram 分析检测Program合理性
+// AnalyseProgram 分析检测Program合理性(使用全局分析参数 Flag)
 func (prog *YTProgram) AnalyseProgram() (err error) {
-	// 检测import合理性
-	err = prog.checkImport()
+	return prog.AnalyseProgramWith(Flag)
+}
+
+// AnalyseProgramWith 使用指定分析参数,分析检测Program合理性
+func (prog *YTProgram) AnalyseProgramWith(flag *AnalyseFlag) (err error) {
+	err = prog.AnalyseDefine(flag)
 	if err != nil {
 		return
 	}
+	return prog.AnalyseReference()
+}
+
+// AnalyseDefine 分析本文件内定义(不依赖import文件). 每个Program只能调用一次
+func (prog *YTProgram) AnalyseDefine(flag *AnalyseFlag) (err error) {
+	if flag == nil {
+		flag = Flag
+	}
+	// 未开启方法ID时,忽略方法上的ID定义
+	if !flag.ServiceUseMethodID {
+		for _, svc := range prog.Services {
+			for _, method := range svc.Methods {
+				method.No = nil
+			}
+		}
+	}
+
+	prog.flag = flag
 
 	// 重复定义检测
 	err = prog.checkRepeatedDefine()
@@ -52,15 +71,103 @@ func (prog *YTProgram) AnalyseProgram() (err error) {
 		return
 	}
 
+	// 常量定义检测
+	err = prog.checkConstDefine()
+	if err != nil {
+		return
+	}
+
+	// 类型别名定义检测
+	err = prog.checkTypeAliasDefine()
+	if err != nil {
+		return
+	}
+
+	// 选项定义检测
+	err = prog.checkOptionSchemaDefine()
+	if err != nil {
+		return
+	}
+	return
+}
+
+// AnalyseReference 分析import及引用类型. 需要在import文件 AnalyseDefine 之后调用
+func (prog *YTProgram) AnalyseReference() (err error) {
+	// 检测import合理性
+	err = prog.checkImport()
+	if err != nil {
+		return
+	}
+
+	// 填充常量引用
+	err = prog.resolveConstRefs()
+	if err != nil {
+		return
+	}
+
+	// 选项检测
+	err = prog.checkOptionSchemas()
+	if err != nil {
+		return
+	}
+
+	// 枚举值检测
+	err = prog.checkEnumValues()
+	if err != nil {
+		return
+	}
+
+	// 方法ID检测
+	err = prog.checkMethodNo()
+	if err != nil {
+		return
+	}
+
+	// 方法类型检测
+	err = prog.checkMethodFlag()
+	if err != nil {
+		return
+	}
+
+	// 填充类型别名
+	err = prog.resolveTypeAliases()
+	if err != nil {
+		return
+	}
+
 	// 检查并修复文件引用合理性
 	err = prog.checkFixFileRefrence()
 	if err != nil {
 		return
 	}
 
+	// 可选字段,固定长度数组,最大长度及默认值
+	err = prog.checkFields()
+	if err != nil {
+		return
+	}
+
+	// 展开消息嵌入
+	err = prog.checkIncludes()
+	if err != nil {
+		return
+	}
+
+	// 错误枚举
+	err = prog.checkThrows()
+	if err != nil {
+		return
+	}
+
+	prog.referenced = true
 	return
 }
 
+// 是否是循环导入中尚未完成分析的文件. 只能引用其中的类型
+func (prog *YTProgram) inImportCycle(iprog *YTProgram) bool {
+	return iprog != prog && !iprog.referenced
+}
+
 // 检测import合理性
 func (prog *YTProgram) checkImport() (err error) {
 	imp := make(map[string]*YTImport)
@@ -117,13 +224,12 @@ func (prog *YTProgram) checkRepeatedDefine() (err error) {
 			return NewErrorPos(val.DefPos, "enum define name repeated [%s] %s", val.Name, last.String())
 		}
 		prog.addUnionName(val.Name, val.DefPos)
+		val.FullName = val.Name
 
-		for _, ev := range val.Values {
-			if last, ok := val.checkUnionNo(ev.Value); ok {
-				return NewErrorPos(ev.DefPos, "enum value name repeated [%s.%s] %s", val.Name, ev.Name, last.String())
-			}
-			val.addUnionNo(ev.Value, ev.DefPos)
+		if err = val.Reserved.check("enum "+val.Name, nil); err != nil {
+			return
 		}
+		// 枚举值可能引用常量, 在引用分析阶段检测
 
 		for _, opt := range val.YTOptions.Opts {
 			if last, ok := prog.checkUnionOption(opt.Key); ok {
@@ -136,7 +242,7 @@ func (prog *YTProgram) checkRepeatedDefine() (err error) {
 	prog.msgMap = make(map[string]*YTMessage)
 	for _, val := range prog.Messages {
 		prog.msgMap[val.Name] = val
-		err = prog.checkMsgRepeatedDefine(val)
+		err = prog.checkMsgRepeatedDefine(val, "")
 		if err != nil {
 			return
 		}
@@ -161,15 +267,6 @@ func (prog *YTProgram) checkRepeatedDefine() (err error) {
 			}
 			val.addUionOption(opt.Key, opt.DefPos)
 		}
-		// method no
-		if Flag.ServiceUseMethodID {
-			for _, method := range val.Methods {
-				if last, ok := val.checkUnionNo(*method.No.Value); ok {
-					return NewErrorPos(method.DefPos, "service method id repeated [%s] %s", val.Name, last.String())
-				}
-				val.addUnionNo(*method.No.Value, method.DefPos)
-			}
-		}
 	}
 
 	for _, val := range prog.Projects {
@@ -193,13 +290,92 @@ func (prog *YTProgram) checkRepeatedDefine() (err error) {
 	return
 }
 
-func (prog *YTProgram) checkMsgRepeatedDefine(val *YTMessage) error {
-	if last, ok := prog.checkUnionName(val.Name); ok {
-		return NewErrorPos(val.DefPos, "message define name repeated [%s] %s", val.Name, last.String())
+// 枚举值重复及保留检测
+func (prog *YTProgram) checkEnumValues() (err error) {
+	return prog.RangeEnums(func(_ string, val *YTEnumDef) error {
+		for _, ev := range val.Values {
+			if last, ok := val.checkUnionNo(ev.Value); ok {
+				return NewErrorPos(ev.DefPos, "enum value name repeated [%s.%s] %s", val.Name, ev.Name, last.String())
+			}
+			val.addUnionNo(ev.Value, ev.DefPos)
+			if last, ok := val.Reserved.checkReservedNo(ev.Value); ok {
+				return NewErrorPos(ev.DefPos, "enum value [%s.%s=%d] is reserved %s", val.Name, ev.Name, ev.Value, last.String())
+			}
+			if last, ok := val.Reserved.checkReservedName(ev.Name); ok {
+				return NewErrorPos(ev.DefPos, "enum value name [%s.%s] is reserved %s", val.Name, ev.Name, last.String())
+			}
+		}
+		return nil
+	})
+}
+
+// 方法ID检测
+func (prog *YTProgram) checkMethodNo() (err error) {
+	if !prog.flag.ServiceUseMethodID {
+		return
+	}
+	for _, val := range prog.Services {
+		for _, method := range val.Methods {
+			if method.No == nil || method.No.Value == nil {
+				if prog.flag.AutoMethodID {
+					continue
+				}
+				return NewErrorPos(method.DefPos, "service method id not set [%s.%s]", val.Name, method.Name)
+			}
+			if last, ok := val.checkUnionNo(*method.No.Value); ok {
+				return NewErrorPos(method.DefPos, "service method id repeated [%s] %s", val.Name, last.String())
+			}
+			val.addUnionNo(*method.No.Value, method.DefPos)
+		}
+	}
+	return
+}
+
+// 检测方法类型与请求/回复是否匹配
+func (prog *YTProgram) checkMethodFlag() (err error) {
+	for _, val := range prog.Services {
+		for _, method := range val.Methods {
+			switch method.Flag {
+			case Notify, Broadcast:
+				if method.Reply != nil {
+					return NewErrorPos(method.DefPos, "%s method must not have reply [%s.%s]", method.Flag, val.Name, method.Name)
+				}
+			case ServerStream:
+				if method.Reply == nil {
+					return NewErrorPos(method.DefPos, "%s method must have reply [%s.%s]", method.Flag, val.Name, method.Name)
+				}
+			case ClientStream:
+				if method.Request == nil {
+					return NewErrorPos(method.DefPos, "%s method must have request [%s.%s]", method.Flag, val.Name, method.Name)
+				}
+			case BidiStream:
+				if method.Request == nil || method.Reply == nil {
+					return NewErrorPos(method.DefPos, "%s method must have request and reply [%s.%s]", method.Flag, val.Name, method.Name)
+				}
+			}
+		}
+	}
+	return
+}
+
+func (prog *YTProgram) checkMsgRepeatedDefine(val *YTMessage, prefix string) error {
+	// 嵌套消息使用全名检测, 不同消息内可以定义同名嵌套消息
+	val.FullName = prefix + val.Name
+	if last, ok := prog.checkUnionName(val.FullName); ok {
+		return NewErrorPos(val.DefPos, "message define name repeated [%s] %s", val.FullName, last.String())
+	}
+	prog.addUnionName(val.FullName, val.DefPos)
+	if err := val.Reserved.check("message "+val.Name, CheckFieldNo); err != nil {
+		return err
 	}
-	prog.addUnionName(val.Name, val.DefPos)
 	//
 	for _, field := range val.Fields {
+		if last, ok := val.Reserved.checkReservedNo(int64(field.No)); ok {
+			return NewErrorPos(field.DefPos, "message field id [%s.%s=%d] is reserved %s", val.Name, field.Name, field.No, last.String())
+		}
+		if last, ok := val.Reserved.checkReservedName(field.Name); ok {
+			return NewErrorPos(field.DefPos, "message field name [%s.%s] is reserved %s", val.Name, field.Name, last.String())
+		}
 		//fmt.Println("val:%t field:%t", val != nil, field != nil)
 		if last, ok := val.checkUnionNo(int64(field.No)); ok {
 			return NewErrorPos(field.DefPos, "message field id repeated [%s.%s] %s", val.Name, field.Name, last.String())
@@ -218,12 +394,56 @@ func (prog *YTProgram) checkMsgRepeatedDefine(val *YTMessage) error {
 		}
 		val.addUionOption(opt.Key, opt.DefPos)
 	}
+	// 联合字段. 字段序号及名称已经在消息字段中检测
+	for _, oneof := range val.Oneofs {
+		if last, ok := val.checkUnionName(oneof.Name); ok {
+			return NewErrorPos(oneof.DefPos, "message oneof name repeated [%s.%s] %s", val.Name, oneof.Name, last.String())
+		}
+		val.addUnionName(oneof.Name, oneof.DefPos)
+		if len(oneof.Fields) < 1 {
+			return NewErrorPos(oneof.DefPos, "message oneof [%s.%s] has no field", val.Name, oneof.Name)
+		}
+		for _, field := range oneof.Fields {
+			if field.Type.YTListType != nil || field.Type.YTMapTypee != nil {
+				return NewErrorPos(field.DefPos, "oneof field can not be list or map [%s.%s.%s]", val.Name, oneof.Name, field.Name)
+			}
+		}
+		check := ytCheck{}
+		for _, opt := range oneof.YTOptions.Opts {
+			if last, ok := check.checkUnionOption(opt.Key); ok {
+				return NewErrorPos(opt.DefPos, "message oneof option name repeated [%s.%s %s] %s", val.Name, oneof.Name, opt.Key, last.String())
+			}
+			check.addUionOption(opt.Key, opt.DefPos)
+		}
+	}
 	//
 	for _, sub := range val.SubMsgs {
-		if err := prog.checkMsgRepeatedDefine(sub); err != nil {
+		if err := prog.checkMsgRepeatedDefine(sub, val.FullName+"."); err != nil {
+			return err
+		}
+		prog.msgMap[sub.FullName] = sub
+	}
+	// 嵌套枚举. 名称在消息内唯一
+	nested := ytCheck{}
+	for _, sub := range val.SubMsgs {
+		nested.addUnionName(sub.Name, sub.DefPos)
+	}
+	for _, def := range val.SubEnums {
+		if last, ok := nested.checkUnionName(def.Name); ok {
+			return NewErrorPos(def.DefPos, "message nested enum name repeated [%s.%s] %s", val.Name, def.Name, last.String())
+		}
+		nested.addUnionName(def.Name, def.DefPos)
+		def.FullName = val.FullName + "." + def.Name
+		if err := def.Reserved.check("enum "+def.FullName, nil); err != nil {
 			return err
 		}
-		prog.msgMap[sub.Name] = sub
+		check := ytCheck{}
+		for _, opt := range def.YTOptions.Opts {
+			if last, ok := check.checkUnionOption(opt.Key); ok {
+				return NewErrorPos(opt.DefPos, "enum option define name repeated [%s %s] %s", def.FullName, opt.Key, last.String())
+			}
+			check.addUionOption(opt.Key, opt.DefPos)
+		}
 	}
 
 	return nil
@@ -245,23 +465,32 @@ func (prog *YTProgram) checkFixFileRefrence() (err error) {
 			}
 			// 请求
 			if mtd.Request != nil {
-				err = prog.checkMsg(mtd.Request, fmt.Sprintf("not define in service <%s> methond <%s> request", v.Name, mtd.Name))
+				err = prog.checkMsg(mtd.Request, nil, fmt.Sprintf("not define in service <%s> methond <%s> request", v.Name, mtd.Name))
 				if err != nil {
 					return
 				}
 			}
 			// 回复
 			if mtd.Reply != nil {
-				err = prog.checkMsg(mtd.Reply, fmt.Sprintf("not define in service <%s> methond <%s> reply", v.Name, mtd.Name))
+				err = prog.checkMsg(mtd.Reply, nil, fmt.Sprintf("not define in service <%s> methond <%s> reply", v.Name, mtd.Name))
 				if err != nil {
 					return
 				}
 			}
 		}
 	}
-	// 消息检查
-	for _, v := range prog.Messages {
-		err = prog.checkMsg(v, fmt.Sprintf("not define in message <%s> ", v.Name))
+	// 消息检查. 包含嵌套消息
+	return prog.checkMsgs(prog.Messages, nil)
+}
+
+// 检查消息及嵌套消息字段类型. scopes 为外层消息链
+func (prog *YTProgram) checkMsgs(msgs []*YTMessage, scopes []*YTMessage) (err error) {
+	for _, v := range msgs {
+		err = prog.checkMsg(v, scopes, fmt.Sprintf("not define in message <%s> ", v.Name))
+		if err != nil {
+			return
+		}
+		err = prog.checkMsgs(v.SubMsgs, append(scopes[:len(scopes):len(scopes)], v))
 		if err != nil {
 			return
 		}
@@ -269,31 +498,11 @@ func (prog *YTProgram) checkFixFileRefrence() (err error) {
 	return
 }
 
-// 检查消息内字段类型
-func (prog *YTProgram) checkMsg(msg *YTMessage, tip string) (err error) {
+// 检查消息内字段类型. 字段类型在消息内由内到外查找
+func (prog *YTProgram) checkMsg(msg *YTMessage, scopes []*YTMessage, tip string) (err error) {
+	scopes = append(scopes[:len(scopes):len(scopes)], msg)
 	for _, field := range msg.Fields {
-		if field.Type.YTCustomType != nil {
-			find := false
-			for _, v := range msg.SubEnums {
-				if v.Name == field.Type.Name {
-					find = true
-					break
-				}
-			}
-			if find {
-				continue
-			}
-			for _, v := range msg.SubMsgs {
-				if v.Name == field.Type.Name {
-					find = true
-					break
-				}
-			}
-			if find {
-				continue
-			}
-		}
-		if err = field.Type.checkType(prog, fmt.Sprintf("%s< %s >in %s", tip, field.DefPos.String(), msg.Name)); err != nil {
+		if err = field.Type.checkType(prog, scopes, fmt.Sprintf("%s< %s >in %s", tip, field.DefPos.String(), msg.Name)); err != nil {
 			return
 		}
 	}
@@ -301,7 +510,7 @@ func (prog *YTProgram) checkMsg(msg *YTMessage, tip string) (err error) {
 }
 
 // 字段类型检查.自定义类型
-func (typ *YTFieldType) checkType(prog *YTProgram, tip string) (err error) {
+func (typ *YTFieldType) checkType(prog *YTProgram, scopes []*YTMessage, tip string) (err error) {
 
 	// 忽略基础类型
 	if typ.YTBaseType != nil {
@@ -312,69 +521,31 @@ func (typ *YTFieldType) checkType(prog *YTProgram, tip string) (err error) {
 		if typ.YTListType.YTBaseType != nil {
 			return
 		}
-		err = typ.YTListType.YTCustomType.checkCustom(prog, tip)
+		err = typ.YTListType.YTCustomType.checkCustom(prog, scopes, tip)
 		return
 	}
 	if typ.YTMapTypee != nil {
 		if typ.YTMapTypee.Value.YTBaseType != nil {
 			return
 		}
-		err = typ.YTMapTypee.Value.YTCustomType.checkCustom(prog, tip)
+		err = typ.YTMapTypee.Value.YTCustomType.checkCustom(prog, scopes, tip)
 		return
 	}
-	err = typ.YTCustomType.checkCustom(prog, tip)
+	err = typ.YTCustomType.checkCustom(prog, scopes, tip)
 	return
 }
 
-// 检查自定义类型是否在本地文件包含
-func (cst *YTCustomType) checkCustom(prog *YTProgram, tip string) (err error) {
-	// 不包含"." 不是是当前文件
-	if !strings.Contains(cst.Name, ".") {
-		// 当前文件. 直接查找
-		if msg, ok := prog.msgMap[cst.Name]; ok {
-			cst.Msg = msg
-			return
+// 检查自定义类型是否定义. 支持嵌套类型全名, 例如 outer.inner, pkg.outer.inner
+func (cst *YTCustomType) checkCustom(prog *YTProgram, scopes []*YTMessage, tip string) (err error) {
+	msg, def, full := prog.lookupType(cst.Name, scopes)
+	if msg == nil && def == nil {
+		if strings.Contains(cst.Name, ".") {
+			return fmt.Errorf("import cutsom type [%s] %s", cst.Name, tip)
 		}
-		// 查找enum
-		for _, def := range prog.EnumDefs {
-			if def.Name == cst.Name {
-				return
-			}
-		}
-		err = fmt.Errorf("custom type [%s] %s", cst.Name, tip)
-		return
-	}
-	// 切分
-	list := strings.Split(cst.Name, ".")
-	// len(list) == 2
-	// 查找引用包
-	refName := list[0]
-	iprogs, ok := prog.impMap[refName]
-	if !ok {
-		// 兼容protobuf. 包名是当前的包名.
-		if refName == prog.Pkg.Name {
-			cst.Name = list[1]
-			if err = cst.checkCustom(prog, tip); err == nil {
-				return
-			}
-			cst.Name = strings.Join(list, ".")
-		}
-		err = fmt.Errorf("import cutsom type [%s] %s", cst.Name, tip)
-		return
-	}
-	// 查找类型
-	stName := list[1]
-	// 遍历引用包
-	for _, iprog := range iprogs {
-		if msg, ok := iprog.msgMap[stName]; ok {
-			cst.Msg = msg
-			err = nil
-			return
-		}
-		err = fmt.Errorf("import cutsom type [%s] %s(from ref[%s] pkg[%s] struct[%s])", cst.Name, tip,
-			refName, iprog.Pkg.Name, stName,
-		)
+		return fmt.Errorf("custom type [%s] %s", cst.Name, tip)
 	}
+	cst.Msg, cst.Enum = msg, def
+	cst.FullName = full
 	return
 }
 
@@ -397,40 +568,19 @@ func (method *YTMethod) checkMessageProtobuf(prog *YTProgram, tip string) (pb bo
 	return
 }
 func getProtoMsg(prog *YTProgram, name, tip string) (result *YTMessage, err error) {
-	// 不包含"." 不是是当前文件
-	if !strings.Contains(name, ".") {
-		// 当前文件. 直接查找
-		if msg, ok := prog.msgMap[name]; ok {
-			result = msg
-		} else {
-			err = fmt.Errorf("custom type [%s] %s", name, tip)
+	msg, _, full := prog.lookupType(name, nil)
+	if msg == nil {
+		if strings.Contains(name, ".") {
+			return nil, fmt.Errorf("import cutsom type [%s] %s. not found define", name, tip)
 		}
-		return
+		return nil, fmt.Errorf("custom type [%s] %s", name, tip)
 	}
-	// 切分
-	list := strings.Split(name, ".")
-	// len(list) == 2
-	// 查找引用包
-	refName := list[0] // 查找类型
-	stName := list[1]
-
-	for _, iprogs := range prog.impMap {
-		// 遍历引用包
-		for _, iprog := range iprogs {
-			if refName != iprog.Pkg.Name {
-				continue
-			}
-			if msg, ok := iprog.msgMap[stName]; ok {
-				// 复制一份数据.并修改名称
-				result = &YTMessage{}
-				*result = *msg
-				result.Name = name
-				err = nil
-				return
-			}
-		}
+	// 其他包的消息. 复制一份数据.并修改名称
+	if full != msg.FullName {
+		result = &YTMessage{}
+		*result = *msg
+		result.Name = name
+		return
 	}
-
-	err = fmt.Errorf("import cutsom type [%s] %s. not found define", name, tip)
-	return
+	return msg, nil
 }
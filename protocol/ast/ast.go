@@ -17,6 +17,7 @@ package ast
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -30,6 +31,7 @@ type YTProgram struct {
 	ytCheck                           // 命名检查
 	YTOptions                         // 包 选项
 	impMap    map[string][]*YTProgram // 依赖映射
+	flag      *AnalyseFlag            // 分析参数
 	msgMap    map[string]*YTMessage   // 消息映射
 	Pkg       *YTPackage              // 包定义
 	Imports   []*YTImport             // 导入文件
@@ -37,65 +39,19 @@ type YTProgram struct {
 	Messages  []*YTMessage            // 消息定义
 	Services  []*YTService            // 服务定义
 	Projects  []*YTProject            // 项目定义
+	Consts    []*YTConst              // 常量定义
+	// 选项定义
+	OptionSchemas []*YTOptionSchema
+	// 类型别名
+	Aliases []*YTTypeAlias
 	// File 文件名 - 只有整个文件解析成功才会赋值
 	File string
 	// 解析阶段不使用. 仅用于生成阶段. 放在这做缓存
 	desc *buildpb.FileDesc
-}
-
-// ApplyCmdOptions 应用命令行参数添加的全局选项
-// 全局Options. 格式为 "xx.xxx=66" "xx.x1" "xx.xx2=xxx"
-func (prog *YTProgram) ApplyCmdOptions(opts ...string) {
-	if len(opts) < 1 {
-		return
-	}
-	var index, check int
-	var key, value string
-	for _, opt := range opts {
-		opt = strings.TrimSpace(opt)
-		index = strings.IndexByte(opt, '=')
-		// 无value
-		if index < 0 {
-			// 有效性检测
-			check = strings.IndexByte(opt, '.')
-			if check < 0 {
-				fmt.Println("Error:", opt, "is not valid options. Ignore!!!")
-				continue
-			}
-			// 保存
-			prog.Opts = append(prog.Opts, &YTOption{
-				Key: opt,
-			})
-			continue
-		}
-		key = opt[:index]
-		key = strings.TrimSpace(key)
-		// 有效性检测
-		check = strings.IndexByte(key, '.')
-		if check < 0 {
-			fmt.Println("Error:", opt, "is not valid options. Ignore!!!")
-			continue
-		}
-		value = opt[index+1:]
-		value = strings.TrimSpace(value)
-		num, err := strconv.ParseInt(value, 10, 64)
-		if err != nil {
-			// 保存
-			prog.Opts = append(prog.Opts, &YTOption{
-				Key: opt,
-				Value: &YTOptionValue{
-					Value: &value,
-				},
-			})
-			continue
-		}
-		prog.Opts = append(prog.Opts, &YTOption{
-			Key: opt,
-			Value: &YTOptionValue{
-				IntVal: &num,
-			},
-		})
-	}
+	// 裁剪的目标. 空表示未裁剪
+	target string
+	// 已完成引用分析. 循环导入中未完成分析的文件只能引用类型
+	referenced bool
 }
 
 // YTDoc 文档,注释
@@ -104,6 +60,15 @@ type YTDoc struct {
 	Doc    []string
 	// 尾注释
 	TailDoc string
+	// 已废弃. @deprecated 原因
+	Deprecated       bool
+	DeprecatedReason string
+	// 起始版本. @since 1.4
+	Since string
+	// 示例. @example 之后到下一个标记之前的内容
+	Examples []string
+	// 参考的定义. @see other.msg
+	See []string
 }
 
 // YTPackage 文件包定义.
@@ -130,38 +95,139 @@ type YTOption struct {
 	Value  *YTOptionValue
 }
 
+// OptionValueKind 选项值类型
+type OptionValueKind int8
+
+// 选项值类型
+const (
+	OptionValueString OptionValueKind = iota
+	OptionValueInt
+	// 布尔值. 同时设置 IntVal(0,1) 兼容旧的使用方式
+	OptionValueBool
+	OptionValueFloat
+	OptionValueList
+	OptionValueObject
+)
+
 // YTOptionValue 选项值
 type YTOptionValue struct {
+	Kind   OptionValueKind
 	Value  *string
 	IntVal *int64
+	// 引用的常量. 分析阶段填充 Value 或 IntVal
+	Macro    *YTCustomType
+	BoolVal  *bool
+	FloatVal *float64
+	// 列表值 [v1, v2]
+	List []*YTOptionValue
+	// 对象值 { key: value }. 按定义顺序保存
+	Object []*YTOptionField
+}
+
+// YTOptionField 对象选项值的字段
+type YTOptionField struct {
+	DefPos token.Pos
+	Key    string
+	Value  *YTOptionValue
 }
 
 func (val *YTOptionValue) String() (desc string) {
 	if val == nil {
 		return
 	}
+	switch val.Kind {
+	case OptionValueBool:
+		if val.BoolVal != nil {
+			return strconv.FormatBool(*val.BoolVal)
+		}
+	case OptionValueFloat:
+		if val.FloatVal != nil {
+			return strconv.FormatFloat(*val.FloatVal, 'g', -1, 64)
+		}
+	case OptionValueList:
+		list := make([]string, 0, len(val.List))
+		for _, v := range val.List {
+			list = append(list, v.String())
+		}
+		return "[" + strings.Join(list, ", ") + "]"
+	case OptionValueObject:
+		list := make([]string, 0, len(val.Object))
+		for _, v := range val.Object {
+			list = append(list, v.Key+": "+v.Value.String())
+		}
+		return "{" + strings.Join(list, ", ") + "}"
+	}
 	if val.Value != nil {
 		return *val.Value
 	}
+	if val.IntVal == nil && val.Macro != nil {
+		return val.Macro.Name
+	}
+	if val.IntVal == nil {
+		return
+	}
 	return strconv.FormatInt(*val.IntVal, 10)
 }
 
+// Field 获取对象选项值的字段
+func (val *YTOptionValue) Field(key string) *YTOptionValue {
+	if val == nil {
+		return nil
+	}
+	for _, v := range val.Object {
+		if v.Key == key {
+			return v.Value
+		}
+	}
+	return nil
+}
+
 // YTEnumDef 枚举定义
 type YTEnumDef struct {
 	ytCheck
 	*YTDoc
 	YTOptions
 	DefPos token.Pos
+	// 结束位置(右括号)
+	EndPos token.Pos
 	Name   string
 	Values []*YTEnumValue
+	// 包内全名. 嵌套枚举包含外层消息名, 例如 outer.kind
+	FullName string
+	// 保留的枚举值及名称
+	Reserved YTReserved
 }
 
 // YTEnumValue 枚举值
 type YTEnumValue struct {
 	*YTDoc
+	YTTags
 	DefPos token.Pos
 	Name   string
 	Value  int64
+	// 引用的常量. 分析阶段填充 Value
+	Macro *YTCustomType
+	// 未设置值,自增
+	Auto bool
+}
+
+// YTConst 常量定义
+type YTConst struct {
+	*YTDoc
+	DefPos token.Pos
+	Name   string
+	// 常量类型. 整数类型或 string
+	Type   *YTBaseType
+	Values []*YTConstValue
+}
+
+// YTConstValue 常量值
+type YTConstValue struct {
+	*YTDoc
+	DefPos token.Pos
+	Name   string
+	Value  *string
+	IntVal *int64
 }
 
 // YTMessage 消息定义
@@ -169,21 +235,96 @@ type YTMessage struct {
 	ytCheck
 	*YTDoc
 	DefPos token.Pos
+	// 结束位置(右括号)
+	EndPos token.Pos
 	YTOptions
+	YTTags
 	Name         string
 	Fields       []*YTField
 	ProtobufFlag bool
 	SubMsgs      []*YTMessage
+	// 嵌套枚举
+	SubEnums []*YTEnumDef
+	// 包内全名. 嵌套消息包含外层消息名, 例如 outer.inner
+	FullName string
+	// 联合字段. 字段同时包含在 Fields 中
+	Oneofs []*YTOneof
+	// 保留的字段序号及名称
+	Reserved YTReserved
+	// 消息ID. 用于网络消息分发
+	ID *YTMethodNo
+	// 嵌入的其他消息. 分析阶段将字段展开到 Fields
+	Includes []*YTInclude
+	// 嵌入展开状态
+	includeState int8
+	// 按目标裁剪
+	pruned bool
+}
+
+// ReservedMax 保留区间上限 "max"
+const ReservedMax int64 = math.MaxInt64
+
+// YTReserved 保留的序号及名称. 删除字段(枚举值)后防止被再次使用
+type YTReserved struct {
+	Ranges []*YTReservedRange
+	Names  []*YTReservedName
+}
+
+// YTReservedRange 保留序号区间 [Start,End]
+type YTReservedRange struct {
+	DefPos token.Pos
+	Start  int64
+	End    int64
+}
+
+// YTReservedName 保留名称
+type YTReservedName struct {
+	DefPos token.Pos
+	Name   string
 }
 
 // YTField 字段定义
 type YTField struct {
 	*YTDoc
 	YTOptions
+	YTTags
 	DefPos token.Pos
 	Type   *YTFieldType
-	No     uint8
+	No     int32
 	Name   string
+	// 所属联合字段. nil 表示不属于oneof
+	Oneof *YTOneof
+	// 可选字段. 区分未设置和零值
+	Optional bool
+	// 默认值. 分析阶段按字段类型检测, 枚举字段填充枚举值
+	Default *YTOption
+	// 最大长度. 数组,map,字符串及二进制使用
+	MaxLen *YTOption
+	// 嵌入来源. nil 表示消息自身定义的字段
+	Include *YTInclude
+}
+
+// 字段序号范围(与protobuf一致)
+const (
+	// FieldNoMin 最小字段序号
+	FieldNoMin = 1
+	// FieldNoMax 最大字段序号 2^29-1
+	FieldNoMax = 1<<29 - 1
+	// FieldNoReservedStart protobuf 内部保留序号起始
+	FieldNoReservedStart = 19000
+	// FieldNoReservedEnd protobuf 内部保留序号结束
+	FieldNoReservedEnd = 19999
+)
+
+// YTOneof 联合字段定义. 同时最多只能设置其中一个字段
+type YTOneof struct {
+	*YTDoc
+	YTOptions
+	DefPos token.Pos
+	// 结束位置(右括号)
+	EndPos token.Pos
+	Name   string
+	Fields []*YTField
 }
 
 // YTFieldType 字段类型
@@ -192,24 +333,38 @@ type YTFieldType struct {
 	*YTListType
 	*YTMapTypee
 	*YTCustomType
+	// 类型别名. 分析后 YTBaseType 为别名对应的基础类型
+	Alias *YTTypeAlias
 }
 
 // YTCustomType 自定义类型
 type YTCustomType struct {
 	Name string
 	Msg  *YTMessage
+	// 引用的枚举. 与 Msg 只会设置一个
+	Enum *YTEnumDef
+	// 解析后的全名. 本包类型为包内全名, 其他包类型包含包名
+	FullName string
 }
 
 // YTListType 列表类型
 type YTListType struct {
 	*YTBaseType
 	*YTCustomType
+	// 元素类型别名. 分析后 YTBaseType 为别名对应的基础类型
+	Alias *YTTypeAlias
+	// 固定长度数组长度. 0 表示不定长数组
+	Size int64
+	// 数组长度引用的常量. 分析阶段填充 Size
+	SizeMacro *YTCustomType
 }
 
 // YTMapTypee 映射类型
 type YTMapTypee struct {
 	Key   *YTBaseType
 	Value *YTListType
+	// 键类型别名. 分析后 Key 为别名对应的基础类型
+	KeyAlias *YTTypeAlias
 }
 
 // YTBaseType 基本类型
@@ -302,10 +457,15 @@ type YTService struct {
 	ytCheck
 	*YTDoc
 	DefPos token.Pos
+	// 结束位置(右括号)
+	EndPos token.Pos
 	YTOptions
+	YTTags
 	Flag    MethodFlag
 	Name    string
 	Methods []*YTMethod
+	// 服务内所有方法可能返回的错误枚举
+	Throws []*YTThrows
 }
 
 // MethodFlag 方法标记
@@ -315,6 +475,14 @@ type MethodFlag int8
 const (
 	Call MethodFlag = iota
 	Notify
+	// ServerStream 服务器流. 一个请求, 多个回复
+	ServerStream
+	// ClientStream 客户端流. 多个请求, 一个回复
+	ClientStream
+	// BidiStream 双向流
+	BidiStream
+	// Broadcast 广播通知. 服务器推送给多个客户端, 没有回复
+	Broadcast
 )
 
 // String 文字描述
@@ -324,21 +492,54 @@ func (flag MethodFlag) String() string {
 		return "Call"
 	case Notify:
 		return "Notify"
+	case ServerStream:
+		return "ServerStream"
+	case ClientStream:
+		return "ClientStream"
+	case BidiStream:
+		return "BidiStream"
+	case Broadcast:
+		return "Broadcast"
 	default:
 		return "unsupport"
 	}
 }
 
+// ParseMethodFlag 解析服务内方法标记. call, notify, server_stream, client_stream, bidi_stream, broadcast
+func ParseMethodFlag(name string) (MethodFlag, error) {
+	switch name {
+	case "call":
+		return Call, nil
+	case "notify":
+		return Notify, nil
+	case "server_stream":
+		return ServerStream, nil
+	case "client_stream":
+		return ClientStream, nil
+	case "bidi_stream":
+		return BidiStream, nil
+	case "broadcast":
+		return Broadcast, nil
+	}
+	return Call, fmt.Errorf("service flag invalid [%s]", name)
+}
+
 // YTMethod 函数,方法定义
 type YTMethod struct {
 	*YTDoc
 	YTOptions
+	YTTags
 	DefPos  token.Pos
 	Flag    MethodFlag
 	Name    string
 	Request *YTMessage
 	Reply   *YTMessage
 	No      *YTMethodNo
+	// 参数列表生成的请求/回复消息. 未使用参数列表时为nil
+	ParamRequest *YTMessage
+	ParamReply   *YTMessage
+	// 可能返回的错误枚举
+	Throws []*YTThrows
 }
 
 // YTMethodNo 方法ID
@@ -346,6 +547,8 @@ type YTMethodNo struct {
 	DefPos token.Pos
 	Macro  *YTCustomType
 	Value  *int64
+	// 自动分配的序号
+	Auto bool
 }
 
 // YTProject 项目定义
@@ -413,3 +616,53 @@ func (opts *YTOptions) GetOptionString(key string) (val string) {
 	}
 	return
 }
+
+// GetOptionInt 获取整数选项值
+func (opts *YTOptions) GetOptionInt(key string) (val int64) {
+	if v := opts.GetOptionValue(key); v != nil && v.IntVal != nil {
+		return *v.IntVal
+	}
+	return
+}
+
+// GetOptionBool 获取布尔选项值. 整数选项值非0为true
+func (opts *YTOptions) GetOptionBool(key string) (val bool) {
+	v := opts.GetOptionValue(key)
+	switch {
+	case v == nil:
+	case v.BoolVal != nil:
+		return *v.BoolVal
+	case v.IntVal != nil:
+		return *v.IntVal != 0
+	}
+	return
+}
+
+// GetOptionFloat 获取浮点数选项值. 整数选项值转换为浮点数
+func (opts *YTOptions) GetOptionFloat(key string) (val float64) {
+	v := opts.GetOptionValue(key)
+	switch {
+	case v == nil:
+	case v.FloatVal != nil:
+		return *v.FloatVal
+	case v.Kind == OptionValueInt && v.IntVal != nil:
+		return float64(*v.IntVal)
+	}
+	return
+}
+
+// GetOptionList 获取列表选项值
+func (opts *YTOptions) GetOptionList(key string) (val []*YTOptionValue) {
+	if v := opts.GetOptionValue(key); v != nil {
+		return v.List
+	}
+	return
+}
+
+// GetOptionObject 获取对象选项值
+func (opts *YTOptions) GetOptionObject(key string) (val []*YTOptionField) {
+	if v := opts.GetOptionValue(key); v != nil {
+		return v.Object
+	}
+	return
+}
@@ -1,6 +1,9 @@
 package ast
 
-import "github.com/walleframe/wctl/builder/buildpb"
+import (
+	"github.com/walleframe/wctl/builder/buildpb"
+	"github.com/walleframe/wctl/protocol/token"
+)
 
 // GetFileDesc 获取文件描述
 func (prog *YTProgram) GetFileDesc() *buildpb.FileDesc {
@@ -42,7 +45,17 @@ func (prog *YTProgram) buildFileDesc() {
 	for _, v := range prog.Projects {
 		desc.Projects = append(desc.Projects, v.toDesc())
 	}
+
+	for _, v := range prog.Consts {
+		desc.Consts = append(desc.Consts, v.toDesc())
+	}
+
+	for _, v := range prog.Aliases {
+		desc.Aliases = append(desc.Aliases, v.toDesc())
+	}
 	desc.Pkg = prog.Pkg.toDesc()
+	desc.Target = prog.target
+	setDescPaths(desc)
 
 	prog.desc = desc
 	return
@@ -71,11 +84,17 @@ func (method *YTMethod) toDesc() (desc *buildpb.MethodDesc) {
 	desc.Name = method.Name
 	if method.No != nil {
 		desc.MethodID = *method.No.Value
+		if method.No.Macro != nil {
+			desc.MethodMacro = method.No.Macro.Name
+		}
 	}
 	desc.Options = method.YTOptions.toDesc()
 	desc.Request = method.Request.toDesc()
 	desc.Reply = method.Reply.toDesc()
 	desc.MethodFlag = int32(method.Flag)
+	desc.Type = buildpb.MethodType(method.Flag)
+	desc.Tags = method.TagNames()
+	desc.Pos = sourcePos(method.DefPos, token.Pos{})
 	return
 }
 
@@ -84,8 +103,15 @@ func (service *YTService) toDesc() (desc *buildpb.ServiceDesc) {
 	desc.Doc = service.YTDoc.toDesc()
 	desc.Name = service.Name
 	desc.Options = service.YTOptions.toDesc()
+	desc.Tags = service.TagNames()
+	desc.Pos = sourcePos(service.DefPos, service.EndPos)
 	for _, v := range service.Methods {
-		desc.Methods = append(desc.Methods, v.toDesc())
+		method := v.toDesc()
+		method.Throws = v.throwsDesc(service)
+		desc.Methods = append(desc.Methods, method)
+	}
+	for _, v := range service.Throws {
+		desc.Throws = append(desc.Throws, v.toDesc())
 	}
 	return
 }
@@ -97,47 +123,90 @@ func (typ *YTFieldType) toDesc() (desc *buildpb.TypeDesc) {
 		desc.Type = buildpb.FieldType_BaseType
 		desc.KeyBase = buildpb.BaseTypeDesc(*typ.YTBaseType)
 		desc.Key = typ.YTBaseType.String()
+		desc.KeyAlias = typ.Alias.toDesc()
 	case typ.YTCustomType != nil:
 		desc.Type = buildpb.FieldType_CustomType
 		desc.Key = typ.YTCustomType.Name
-		desc.Msg = typ.Msg.toDesc()
 		desc.ElemCustom = true
+		typ.YTCustomType.fillDesc(desc)
 	case typ.YTListType != nil:
 		desc.Type = buildpb.FieldType_ListType
+		desc.Size = typ.YTListType.Size
 		if typ.YTListType.YTBaseType != nil {
 			desc.ElemCustom = false
 			desc.Key = typ.YTListType.YTBaseType.String()
 			desc.KeyBase = buildpb.BaseTypeDesc(*typ.YTListType.YTBaseType)
+			desc.KeyAlias = typ.YTListType.Alias.toDesc()
 		} else if typ.YTListType.YTCustomType != nil {
 			desc.ElemCustom = true
 			desc.Key = typ.YTListType.YTCustomType.Name
-			desc.Msg = typ.YTListType.YTCustomType.Msg.toDesc()
+			typ.YTListType.YTCustomType.fillDesc(desc)
 		}
 	case typ.YTMapTypee != nil:
 		desc.Type = buildpb.FieldType_MapType
 		desc.Key = typ.YTMapTypee.Key.String()
 		desc.KeyBase = buildpb.BaseTypeDesc(*typ.YTMapTypee.Key)
+		desc.KeyAlias = typ.YTMapTypee.KeyAlias.toDesc()
 
 		if typ.YTMapTypee.Value.YTBaseType != nil {
 			desc.ElemCustom = false
 			desc.Value = typ.YTMapTypee.Value.YTBaseType.String()
 			desc.ValueBase = buildpb.BaseTypeDesc(*typ.YTMapTypee.Value.YTBaseType)
+			desc.ValueAlias = typ.YTMapTypee.Value.Alias.toDesc()
 		} else if typ.YTMapTypee.Value.YTCustomType != nil {
 			desc.ElemCustom = true
 			desc.Value = typ.YTMapTypee.Value.YTCustomType.Name
-			desc.Msg = typ.YTMapTypee.Value.YTCustomType.Msg.toDesc()
+			typ.YTMapTypee.Value.YTCustomType.fillDesc(desc)
 		}
 	}
 	return
 }
 
+// 填充自定义类型关联的消息或枚举
+func (cst *YTCustomType) fillDesc(desc *buildpb.TypeDesc) {
+	desc.FullName = cst.FullName
+	desc.Msg = cst.Msg.toDesc()
+	if cst.Enum != nil {
+		desc.ElemEnum = true
+		desc.Enum = cst.Enum.toDesc()
+	}
+}
+
 func (field *YTField) toDesc() (desc *buildpb.Field) {
 	desc = &buildpb.Field{}
 	desc.Doc = field.YTDoc.toDesc()
 	desc.Name = field.Name
-	desc.No = int32(field.No)
+	desc.No = field.No
 	desc.Options = field.YTOptions.toDesc()
 	desc.Type = field.Type.toDesc()
+	if field.Oneof != nil {
+		desc.Oneof = field.Oneof.Name
+	}
+	desc.Optional = field.Optional
+	desc.Tags = field.TagNames()
+	desc.Pos = sourcePos(field.DefPos, token.Pos{})
+	if field.MaxLen != nil && field.MaxLen.Value.IntVal != nil {
+		desc.Type.MaxLen = *field.MaxLen.Value.IntVal
+	}
+	if field.Default != nil {
+		desc.Default = field.Default.Value.toDesc()
+	}
+	if field.Include != nil {
+		desc.Embed = field.Include.toDesc()
+		desc.EmbedOffset = field.Include.Offset
+	}
+	return
+}
+
+func (oneof *YTOneof) toDesc() (desc *buildpb.OneofDesc) {
+	desc = &buildpb.OneofDesc{}
+	desc.Doc = oneof.YTDoc.toDesc()
+	desc.Name = oneof.Name
+	desc.Options = oneof.YTOptions.toDesc()
+	desc.Pos = sourcePos(oneof.DefPos, oneof.EndPos)
+	for _, v := range oneof.Fields {
+		desc.Fields = append(desc.Fields, v.Name)
+	}
 	return
 }
 
@@ -148,7 +217,10 @@ func (msg *YTMessage) toDesc() (desc *buildpb.MsgDesc) {
 	desc = &buildpb.MsgDesc{}
 	desc.Doc = msg.YTDoc.toDesc()
 	desc.Name = msg.Name
+	desc.FullName = msg.FullName
 	desc.Options = msg.YTOptions.toDesc()
+	desc.Tags = msg.TagNames()
+	desc.Pos = sourcePos(msg.DefPos, msg.EndPos)
 	for _, v := range msg.Fields {
 		desc.Fields = append(desc.Fields, v.toDesc())
 	}
@@ -156,6 +228,22 @@ func (msg *YTMessage) toDesc() (desc *buildpb.MsgDesc) {
 	for _, sub := range msg.SubMsgs {
 		desc.SubMsgs = append(desc.SubMsgs, sub.toDesc())
 	}
+	// 嵌套枚举
+	for _, sub := range msg.SubEnums {
+		desc.SubEnums = append(desc.SubEnums, sub.toDesc())
+	}
+	// 联合字段
+	for _, oneof := range msg.Oneofs {
+		desc.Oneofs = append(desc.Oneofs, oneof.toDesc())
+	}
+	desc.ReservedRanges, desc.ReservedNames = msg.Reserved.toDesc()
+	// 消息ID
+	if msg.ID != nil && msg.ID.Value != nil {
+		desc.MsgID = *msg.ID.Value
+		if msg.ID.Macro != nil {
+			desc.MsgMacro = msg.ID.Macro.Name
+		}
+	}
 	return
 }
 
@@ -163,12 +251,50 @@ func (enum *YTEnumDef) toDesc() (desc *buildpb.EnumDesc) {
 	desc = &buildpb.EnumDesc{}
 	desc.Doc = enum.YTDoc.toDesc()
 	desc.Name = enum.Name
+	desc.FullName = enum.FullName
 	desc.Options = enum.YTOptions.toDesc()
+	desc.Pos = sourcePos(enum.DefPos, enum.EndPos)
 	for _, v := range enum.Values {
 		val := &buildpb.EnumValue{}
 		val.Doc = v.YTDoc.toDesc()
 		val.Name = v.Name
 		val.Value = v.Value
+		if v.Macro != nil {
+			val.Macro = v.Macro.Name
+		}
+		val.Tags = v.TagNames()
+		val.Pos = sourcePos(v.DefPos, token.Pos{})
+		desc.Values = append(desc.Values, val)
+	}
+	desc.ReservedRanges, desc.ReservedNames = enum.Reserved.toDesc()
+	return
+}
+
+func (rs *YTReserved) toDesc() (ranges []*buildpb.ReservedRange, names []string) {
+	for _, v := range rs.Ranges {
+		ranges = append(ranges, &buildpb.ReservedRange{Start: v.Start, End: v.End})
+	}
+	for _, v := range rs.Names {
+		names = append(names, v.Name)
+	}
+	return
+}
+
+func (def *YTConst) toDesc() (desc *buildpb.ConstDesc) {
+	desc = &buildpb.ConstDesc{}
+	desc.Doc = def.YTDoc.toDesc()
+	desc.Name = def.Name
+	desc.Type = buildpb.BaseTypeDesc(*def.Type)
+	for _, v := range def.Values {
+		val := &buildpb.ConstValue{}
+		val.Doc = v.YTDoc.toDesc()
+		val.Name = v.Name
+		if v.Value != nil {
+			val.Value = *v.Value
+		}
+		if v.IntVal != nil {
+			val.IntValue = *v.IntVal
+		}
 		desc.Values = append(desc.Values, val)
 	}
 	return
@@ -186,7 +312,15 @@ func (doc *YTDoc) toDesc() (desc *buildpb.DocDesc) {
 	if doc == nil {
 		return
 	}
-	desc = &buildpb.DocDesc{Doc: doc.Doc, TailDoc: doc.TailDoc}
+	desc = &buildpb.DocDesc{
+		Doc:              doc.Doc,
+		TailDoc:          doc.TailDoc,
+		Deprecated:       doc.Deprecated,
+		DeprecatedReason: doc.DeprecatedReason,
+		Since:            doc.Since,
+		Examples:         doc.Examples,
+		See:              doc.See,
+	}
 	return
 }
 
@@ -197,16 +331,55 @@ func (opts *YTOptions) toDesc() (desc *buildpb.OptionDesc) {
 	desc = &buildpb.OptionDesc{}
 	desc.Options = make(map[string]*buildpb.OptionValue, len(opts.Opts))
 	for _, v := range opts.Opts {
-		val := &buildpb.OptionValue{}
+		val := v.Value.toDesc()
 		val.Doc = v.YTDoc.toDesc()
-		if v.Value != nil {
-			if v.Value.Value != nil {
-				val.Value = *v.Value.Value
-			} else if v.Value.IntVal != nil {
-				val.IntValue = *v.Value.IntVal
-			}
-		}
+		val.Pos = sourcePos(v.DefPos, token.Pos{})
 		desc.Options[v.Key] = val
 	}
 	return
 }
+
+func (v *YTOptionValue) toDesc() (desc *buildpb.OptionValue) {
+	desc = &buildpb.OptionValue{}
+	if v == nil {
+		return
+	}
+	desc.Kind = buildpb.OptionKind(v.Kind)
+	if v.Value != nil {
+		desc.Value = *v.Value
+	}
+	if v.IntVal != nil {
+		desc.IntValue = *v.IntVal
+	}
+	if v.Macro != nil {
+		desc.Macro = v.Macro.Name
+	}
+	if v.BoolVal != nil {
+		desc.BoolValue = *v.BoolVal
+	}
+	if v.FloatVal != nil {
+		desc.FloatValue = *v.FloatVal
+	}
+	for _, item := range v.List {
+		desc.List = append(desc.List, item.toDesc())
+	}
+	if v.Kind == OptionValueObject {
+		desc.Object = make(map[string]*buildpb.OptionValue, len(v.Object))
+		for _, field := range v.Object {
+			desc.Object[field.Key] = field.Value.toDesc()
+		}
+	}
+	return
+}
+
+func (alias *YTTypeAlias) toDesc() (desc *buildpb.AliasDesc) {
+	if alias == nil {
+		return nil
+	}
+	desc = &buildpb.AliasDesc{}
+	desc.Doc = alias.YTDoc.toDesc()
+	desc.Name = alias.Name
+	desc.Package = alias.Package
+	desc.Type = buildpb.BaseTypeDesc(*alias.Base)
+	return
+}
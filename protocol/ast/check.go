@@ -15,7 +15,12 @@ limitations under the License.
 */
 package ast
 
-import "github.com/walleframe/wctl/protocol/token"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/walleframe/wctl/protocol/token"
+)
 
 // 检查命名是否重定义
 type ytCheck struct {
@@ -68,3 +73,78 @@ func (ck *ytCheck) addUnionNo(no int64, pos token.Pos) {
 	}
 	ck.no[no] = pos
 }
+
+// 检查保留定义是否合法及重复. checkNo 不为空时检测区间端点序号范围
+func (rs *YTReserved) check(tip string, checkNo func(no int64) error) error {
+	for k, v := range rs.Ranges {
+		if v.Start > v.End {
+			return NewErrorPos(v.DefPos, "%s reserved range [%d to %d] start greater than end", tip, v.Start, v.End)
+		}
+		if checkNo != nil {
+			if err := checkNo(v.Start); err != nil {
+				return NewErrorPos(v.DefPos, "%s reserved range [%s] %s", tip, v, err.Error())
+			}
+			if v.End != ReservedMax {
+				if err := checkNo(v.End); err != nil {
+					return NewErrorPos(v.DefPos, "%s reserved range [%s] %s", tip, v, err.Error())
+				}
+			}
+		}
+		for _, last := range rs.Ranges[:k] {
+			if v.Start <= last.End && last.Start <= v.End {
+				return NewErrorPos(v.DefPos, "%s reserved range [%s] overlap with [%s] %s", tip, v, last, last.DefPos.String())
+			}
+		}
+	}
+	for k, v := range rs.Names {
+		for _, last := range rs.Names[:k] {
+			if v.Name == last.Name {
+				return NewErrorPos(v.DefPos, "%s reserved name [%s] repeated %s", tip, v.Name, last.DefPos.String())
+			}
+		}
+	}
+	return nil
+}
+
+// 序号是否被保留
+func (rs *YTReserved) checkReservedNo(no int64) (last token.Pos, reserved bool) {
+	for _, v := range rs.Ranges {
+		if no >= v.Start && no <= v.End {
+			return v.DefPos, true
+		}
+	}
+	return
+}
+
+// 名称是否被保留
+func (rs *YTReserved) checkReservedName(name string) (last token.Pos, reserved bool) {
+	for _, v := range rs.Names {
+		if v.Name == name {
+			return v.DefPos, true
+		}
+	}
+	return
+}
+
+// String protobuf 格式描述
+func (r *YTReservedRange) String() string {
+	switch {
+	case r.Start == r.End:
+		return strconv.FormatInt(r.Start, 10)
+	case r.End == ReservedMax:
+		return strconv.FormatInt(r.Start, 10) + " to max"
+	default:
+		return strconv.FormatInt(r.Start, 10) + " to " + strconv.FormatInt(r.End, 10)
+	}
+}
+
+// CheckFieldNo 检测字段序号是否在protobuf合法范围内
+func CheckFieldNo(no int64) error {
+	if no < FieldNoMin || no > FieldNoMax {
+		return fmt.Errorf("field no [%d] must between %d and %d", no, FieldNoMin, FieldNoMax)
+	}
+	if no >= FieldNoReservedStart && no <= FieldNoReservedEnd {
+		return fmt.Errorf("field no [%d] in protobuf reserved range [%d,%d]", no, FieldNoReservedStart, FieldNoReservedEnd)
+	}
+	return nil
+}
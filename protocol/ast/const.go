@@ -0,0 +1,319 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// 常量定义检测. 名称重复及数值范围
+func (prog *YTProgram) checkConstDefine() (err error) {
+	for _, val := range prog.Consts {
+		if last, ok := prog.checkUnionName(val.Name); ok {
+			return NewErrorPos(val.DefPos, "const define name repeated [%s] %s", val.Name, last.String())
+		}
+		prog.addUnionName(val.Name, val.DefPos)
+		check := ytCheck{}
+		for _, cv := range val.Values {
+			if last, ok := check.checkUnionName(cv.Name); ok {
+				return NewErrorPos(cv.DefPos, "const value name repeated [%s.%s] %s", val.Name, cv.Name, last.String())
+			}
+			check.addUnionName(cv.Name, cv.DefPos)
+			if err = val.checkValue(cv); err != nil {
+				return NewErrorPos2(cv.DefPos, err)
+			}
+		}
+	}
+	return
+}
+
+// 检测常量值是否符合常量类型
+func (def *YTConst) checkValue(cv *YTConstValue) error {
+	if def.Type == BaseTypeString {
+		if cv.Value == nil {
+			return fmt.Errorf("const [%s.%s] need string value", def.Name, cv.Name)
+		}
+		return nil
+	}
+	if cv.IntVal == nil {
+		return fmt.Errorf("const [%s.%s] need integer value", def.Name, cv.Name)
+	}
+	var min, max int64
+	switch def.Type {
+	case BaseTypeInt8:
+		min, max = math.MinInt8, math.MaxInt8
+	case BaseTypeUint8:
+		min, max = 0, math.MaxUint8
+	case BaseTypeInt16:
+		min, max = math.MinInt16, math.MaxInt16
+	case BaseTypeUint16:
+		min, max = 0, math.MaxUint16
+	case BaseTypeInt32:
+		min, max = math.MinInt32, math.MaxInt32
+	case BaseTypeUint32:
+		min, max = 0, math.MaxUint32
+	case BaseTypeInt64:
+		min, max = math.MinInt64, math.MaxInt64
+	case BaseTypeUint64:
+		min, max = 0, math.MaxInt64
+	default:
+		return fmt.Errorf("const [%s] type [%s] not support", def.Name, def.Type)
+	}
+	if *cv.IntVal < min || *cv.IntVal > max {
+		return fmt.Errorf("const [%s.%s=%d] overflow %s", def.Name, cv.Name, *cv.IntVal, def.Type)
+	}
+	return nil
+}
+
+// LookupConst 查找常量. 格式为 "常量名.值名" 或 "包名(import别名).常量名.值名"
+func (prog *YTProgram) LookupConst(name string) (def *YTConst, cv *YTConstValue, err error) {
+	list := strings.Split(name, ".")
+	switch len(list) {
+	case 2:
+		def, cv = prog.findConst(list[0], list[1])
+	case 3:
+		iprogs, ok := prog.impMap[list[0]]
+		if !ok && list[0] == prog.Pkg.Name {
+			iprogs = []*YTProgram{prog}
+		}
+		for _, iprog := range iprogs {
+			if def, cv = iprog.findConst(list[1], list[2]); cv != nil {
+				if prog.inImportCycle(iprog) {
+					return nil, nil, fmt.Errorf("const [%s] defined in import cycle file [%s], only types can be referenced", name, iprog.File)
+				}
+				break
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("const reference [%s] invalid, need const.value or pkg.const.value", name)
+	}
+	if cv == nil {
+		return nil, nil, fmt.Errorf("const [%s] not found", name)
+	}
+	return
+}
+
+func (prog *YTProgram) findConst(name, value string) (*YTConst, *YTConstValue) {
+	for _, def := range prog.Consts {
+		if def.Name != name {
+			continue
+		}
+		for _, cv := range def.Values {
+			if cv.Name == value {
+				return def, cv
+			}
+		}
+	}
+	return nil, nil
+}
+
+// 查找整数常量
+func (prog *YTProgram) lookupIntConst(ref *YTCustomType) (int64, error) {
+	_, cv, err := prog.LookupConst(ref.Name)
+	if err != nil {
+		return 0, err
+	}
+	if cv.IntVal == nil {
+		return 0, fmt.Errorf("const [%s] is not integer", ref.Name)
+	}
+	return *cv.IntVal, nil
+}
+
+// 填充常量引用. 方法ID,消息ID,枚举值,选项值
+func (prog *YTProgram) resolveConstRefs() (err error) {
+	// 方法ID
+	for _, svc := range prog.Services {
+		for _, method := range svc.Methods {
+			if method.No == nil || method.No.Macro == nil {
+				continue
+			}
+			v, err := prog.lookupIntConst(method.No.Macro)
+			if err != nil {
+				return NewErrorPos(method.No.DefPos, "service method id [%s.%s] %v", svc.Name, method.Name, err)
+			}
+			method.No.Value = &v
+		}
+	}
+	// 消息ID
+	err = prog.RangeMessages(func(name string, msg *YTMessage) error {
+		if msg.ID == nil || msg.ID.Macro == nil {
+			return nil
+		}
+		v, err := prog.lookupIntConst(msg.ID.Macro)
+		if err != nil {
+			return NewErrorPos(msg.ID.DefPos, "message id [%s] %v", name, err)
+		}
+		msg.ID.Value = &v
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	// 枚举值. 引用常量后的自增值需要重新计算
+	err = prog.RangeEnums(func(name string, def *YTEnumDef) error {
+		enumValue := int64(-1)
+		for _, ev := range def.Values {
+			switch {
+			case ev.Macro != nil:
+				v, err := prog.lookupIntConst(ev.Macro)
+				if err != nil {
+					return NewErrorPos(ev.DefPos, "enum value [%s.%s] %v", name, ev.Name, err)
+				}
+				ev.Value = v
+			case ev.Auto:
+				ev.Value = enumValue + 1
+			}
+			enumValue = ev.Value
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	// 选项值
+	return prog.rangeOptions(func(scope OptionScope, opt *YTOption) error {
+		if err := prog.resolveOptionValue(opt.Value); err != nil {
+			return NewErrorPos(opt.DefPos, "option [%s] value %v", opt.Key, err)
+		}
+		return nil
+	})
+}
+
+// 填充选项值(包含列表及对象内)引用的常量
+func (prog *YTProgram) resolveOptionValue(val *YTOptionValue) error {
+	if val == nil {
+		return nil
+	}
+	for _, v := range val.List {
+		if err := prog.resolveOptionValue(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range val.Object {
+		if err := prog.resolveOptionValue(v.Value); err != nil {
+			return err
+		}
+	}
+	if val.Macro == nil {
+		return nil
+	}
+	_, cv, err := prog.LookupConst(val.Macro.Name)
+	if err != nil {
+		return err
+	}
+	val.Value, val.IntVal = cv.Value, cv.IntVal
+	if cv.IntVal != nil {
+		val.Kind = OptionValueInt
+	} else {
+		val.Kind = OptionValueString
+	}
+	return nil
+}
+
+// RangeMessages 遍历文件内所有消息(包含子消息). name 为包含父消息的名称, 例如 outer.inner
+func (prog *YTProgram) RangeMessages(f func(name string, msg *YTMessage) error) error {
+	var eachMsg func(prefix string, msgs []*YTMessage) error
+	eachMsg = func(prefix string, msgs []*YTMessage) error {
+		for _, msg := range msgs {
+			name := prefix + msg.Name
+			if err := f(name, msg); err != nil {
+				return err
+			}
+			if err := eachMsg(name+".", msg.SubMsgs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return eachMsg("", prog.Messages)
+}
+
+// 遍历文件内所有选项
+func (prog *YTProgram) rangeOptions(f func(scope OptionScope, opt *YTOption) error) (err error) {
+	return prog.rangeOptionSets(func(scope OptionScope, opts *YTOptions) error {
+		for _, opt := range opts.Opts {
+			if err := f(scope, opt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 遍历文件内所有可以设置选项的定义
+func (prog *YTProgram) rangeOptionSets(each func(scope OptionScope, opts *YTOptions) error) (err error) {
+	var eachMsg func(msg *YTMessage) error
+	eachMsg = func(msg *YTMessage) error {
+		if err := each(ScopeMessage, &msg.YTOptions); err != nil {
+			return err
+		}
+		for _, def := range msg.SubEnums {
+			if err := each(ScopeEnum, &def.YTOptions); err != nil {
+				return err
+			}
+		}
+		for _, field := range msg.Fields {
+			if err := each(ScopeField, &field.YTOptions); err != nil {
+				return err
+			}
+		}
+		for _, oneof := range msg.Oneofs {
+			if err := each(ScopeOneof, &oneof.YTOptions); err != nil {
+				return err
+			}
+		}
+		for _, sub := range msg.SubMsgs {
+			if err := eachMsg(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err = each(ScopeFile, &prog.YTOptions); err != nil {
+		return
+	}
+	for _, def := range prog.EnumDefs {
+		if err = each(ScopeEnum, &def.YTOptions); err != nil {
+			return
+		}
+	}
+	for _, msg := range prog.Messages {
+		if err = eachMsg(msg); err != nil {
+			return
+		}
+	}
+	for _, svc := range prog.Services {
+		if err = each(ScopeService, &svc.YTOptions); err != nil {
+			return
+		}
+		for _, method := range svc.Methods {
+			if err = each(ScopeMethod, &method.YTOptions); err != nil {
+				return
+			}
+		}
+	}
+	for _, proj := range prog.Projects {
+		for _, opts := range proj.Conf {
+			if err = each(ScopeProject, opts); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
@@ -12,6 +12,10 @@ type Context struct {
 	//Doc         *YTDoc
 	LastElement interface{}
 	Docs        []*token.Token
+	// 枚举值的目标标记. 枚举值在解析枚举定义时由选项拆分
+	EnumTags map[*YTOption][]*YTTag
+	// 递归分析接口. 解析import时获取依赖文件, 未获取的依赖文件由调用方分析
+	Analyser RecursionAnalyser
 }
 
 func (ctx *Context) Range(tok *token.Token, tm *token.TokenMap) {
@@ -32,8 +36,8 @@ func (ctx *Context) PreDoc(tokLine int) *YTDoc {
 
 	for k, d := range ctx.Docs {
 		preDocs = append(preDocs, d)
-		// 当前token前的最后一条注释
-		if d.Line+1 == tokLine {
+		// 当前token前的最后一条注释. 多行注释使用结束行
+		if docEndLine(d)+1 == tokLine {
 			trimIdx = k
 		}
 		// 尾注释
@@ -52,7 +56,7 @@ func (ctx *Context) PreDoc(tokLine int) *YTDoc {
 		preIdx, preLine := trimIdx, preDocs[trimIdx].Line
 		for i := trimIdx - 1; i >= 0; i-- {
 			d := preDocs[i]
-			if d.Line+1 >= preLine {
+			if docEndLine(d)+1 >= preLine {
 				preLine = d.Line
 				preIdx = i
 			}
@@ -67,7 +71,13 @@ func (ctx *Context) PreDoc(tokLine int) *YTDoc {
 	}
 
 	if pre || tail {
+		doc.parseTags()
 		return doc
 	}
 	return nil
 }
+
+// 注释结束的行号
+func docEndLine(d *token.Token) int {
+	return d.Line + strings.Count(strings.TrimSpace(d.IDValue()), "\n")
+}
@@ -0,0 +1,187 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 文档标记
+const (
+	DocTagDeprecated = "@deprecated"
+	DocTagSince      = "@since"
+	DocTagExample    = "@example"
+	DocTagSee        = "@see"
+)
+
+// IsDeprecated 是否已废弃
+func (doc *YTDoc) IsDeprecated() bool {
+	return doc != nil && doc.Deprecated
+}
+
+// 解析注释中的文档标记. 原始注释保留在 Doc 及 TailDoc 中
+func (doc *YTDoc) parseTags() {
+	example := -1
+	for _, raw := range append(doc.Doc[:len(doc.Doc):len(doc.Doc)], doc.TailDoc) {
+		for _, line := range docLines(raw) {
+			name, value := splitDocTag(line)
+			switch name {
+			case "":
+				// @example 之后的内容都属于示例
+				if example >= 0 {
+					doc.Examples[example] = strings.TrimSpace(doc.Examples[example] + "\n" + line)
+				}
+				continue
+			case DocTagDeprecated:
+				doc.Deprecated, doc.DeprecatedReason = true, value
+			case DocTagSince:
+				doc.Since = value
+			case DocTagExample:
+				doc.Examples = append(doc.Examples, value)
+				example = len(doc.Examples) - 1
+				continue
+			case DocTagSee:
+				doc.See = append(doc.See, strings.Fields(strings.ReplaceAll(value, ",", " "))...)
+			}
+			example = -1
+		}
+	}
+}
+
+// 去除注释符号, 按行拆分
+func docLines(raw string) (lines []string) {
+	raw = strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(raw, "//"):
+		return []string{strings.TrimSpace(strings.TrimLeft(raw, "/"))}
+	case strings.HasPrefix(raw, "/*"):
+		raw = strings.TrimSuffix(strings.TrimPrefix(raw, "/*"), "*/")
+		for _, line := range strings.Split(raw, "\n") {
+			line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*"))
+			lines = append(lines, line)
+		}
+	}
+	return
+}
+
+// 拆分文档标记. 不是 @ 开头时 name 为空
+func splitDocTag(line string) (name, value string) {
+	if !strings.HasPrefix(line, "@") {
+		return
+	}
+	name, value, _ = strings.Cut(line, " ")
+	return name, strings.TrimSpace(value)
+}
+
+// 废弃警告. 包含废弃原因
+func deprecatedWarn(pos fmt.Stringer, doc *YTDoc, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if doc.DeprecatedReason != "" {
+		msg += ": " + doc.DeprecatedReason
+	}
+	return fmt.Errorf("%s: %s", pos.String(), msg)
+}
+
+// CheckDeprecated 未废弃的定义引用了废弃的定义时返回警告
+func (prog *YTProgram) CheckDeprecated() (warns []error) {
+	warns = checkMsgsDeprecated(prog.Messages, false, warns)
+	for _, svc := range prog.Services {
+		if svc.IsDeprecated() {
+			continue
+		}
+		warns = checkThrowsDeprecated(svc.Throws, "service "+svc.Name, warns)
+		for _, method := range svc.Methods {
+			if method.IsDeprecated() {
+				continue
+			}
+			for _, body := range []*YTMessage{method.Request, method.Reply} {
+				if body == nil || len(body.Fields) == 0 {
+					continue
+				}
+				if cst := body.Fields[0].Type.YTCustomType; cst != nil && cst.Msg != nil && cst.Msg.IsDeprecated() {
+					warns = append(warns, deprecatedWarn(method.DefPos, cst.Msg.YTDoc,
+						"method [%s.%s] reference deprecated message [%s]", svc.Name, method.Name, cst.Name))
+				}
+			}
+			warns = checkThrowsDeprecated(method.Throws, "method "+svc.Name+"."+method.Name, warns)
+		}
+	}
+	return
+}
+
+// 废弃消息内的字段及嵌套消息不检测
+func checkMsgsDeprecated(msgs []*YTMessage, deprecated bool, warns []error) []error {
+	for _, msg := range msgs {
+		msgDeprecated := deprecated || msg.IsDeprecated()
+		if !msgDeprecated {
+			for _, field := range msg.Fields {
+				if err := field.checkDeprecated(msg); err != nil {
+					warns = append(warns, err)
+				}
+			}
+		}
+		warns = checkMsgsDeprecated(msg.SubMsgs, msgDeprecated, warns)
+	}
+	return warns
+}
+
+func (field *YTField) checkDeprecated(msg *YTMessage) error {
+	if field.IsDeprecated() {
+		return nil
+	}
+	if inc := field.Include; inc != nil {
+		if inc.Msg.IsDeprecated() {
+			return deprecatedWarn(inc.DefPos, inc.Msg.YTDoc, "message [%s] include deprecated message [%s]", msg.Name, inc.Name)
+		}
+		return nil
+	}
+	cst := field.Type.elemCustom()
+	switch {
+	case cst == nil:
+	case cst.Msg != nil && cst.Msg.IsDeprecated():
+		return deprecatedWarn(field.DefPos, cst.Msg.YTDoc, "field [%s.%s] reference deprecated message [%s]", msg.Name, field.Name, cst.Name)
+	case cst.Enum != nil && cst.Enum.IsDeprecated():
+		return deprecatedWarn(field.DefPos, cst.Enum.YTDoc, "field [%s.%s] reference deprecated enum [%s]", msg.Name, field.Name, cst.Name)
+	case cst.Enum != nil && field.Default != nil && field.Default.Value.Value != nil:
+		for _, ev := range cst.Enum.Values {
+			if ev.Name == *field.Default.Value.Value && ev.IsDeprecated() {
+				return deprecatedWarn(field.DefPos, ev.YTDoc, "field [%s.%s] default deprecated enum value [%s]", msg.Name, field.Name, ev.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func checkThrowsDeprecated(list []*YTThrows, tip string, warns []error) []error {
+	for _, v := range list {
+		if v.Enum != nil && v.Enum.IsDeprecated() {
+			warns = append(warns, deprecatedWarn(v.DefPos, v.Enum.YTDoc, "%s throws deprecated enum [%s]", tip, v.Name))
+		}
+	}
+	return warns
+}
+
+// 字段引用的自定义类型. 列表及map使用元素类型
+func (typ *YTFieldType) elemCustom() *YTCustomType {
+	switch {
+	case typ.YTListType != nil:
+		return typ.YTListType.YTCustomType
+	case typ.YTMapTypee != nil:
+		return typ.YTMapTypee.Value.YTCustomType
+	}
+	return typ.YTCustomType
+}
@@ -0,0 +1,216 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// 字段选项中的特殊选项, 解析时从选项中取出
+const (
+	// FieldDefaultKey 默认值. int32 hp = 1 { default = 100 }
+	FieldDefaultKey = "default"
+	// FieldMaxLenKey 最大长度. string name = 1 { max_len = 32 }
+	FieldMaxLenKey = "max_len"
+)
+
+// SplitFieldOptions 从字段选项中取出默认值及最大长度
+func (field *YTField) SplitFieldOptions() error {
+	opts := field.Opts[:0]
+	for _, opt := range field.Opts {
+		var dst **YTOption
+		switch opt.Key {
+		case FieldDefaultKey:
+			dst = &field.Default
+		case FieldMaxLenKey:
+			dst = &field.MaxLen
+		default:
+			opts = append(opts, opt)
+			continue
+		}
+		if *dst != nil {
+			return NewErrorPos(opt.DefPos, "field [%s] option [%s] repeated %s", field.Name, opt.Key, (*dst).DefPos.String())
+		}
+		*dst = opt
+	}
+	field.Opts = opts
+	return nil
+}
+
+// 检测可选字段,固定长度数组,最大长度及字段默认值
+func (prog *YTProgram) checkFields() error {
+	return prog.RangeMessages(func(name string, msg *YTMessage) error {
+		for _, field := range msg.Fields {
+			if field.Optional {
+				switch {
+				case field.Oneof != nil:
+					return NewErrorPos(field.DefPos, "message field [%s.%s] in oneof can not be optional", name, field.Name)
+				case field.Type.YTListType != nil, field.Type.YTMapTypee != nil:
+					return NewErrorPos(field.DefPos, "message field [%s.%s] list or map can not be optional", name, field.Name)
+				}
+			}
+			if err := prog.checkFieldSize(field); err != nil {
+				return NewErrorPos(field.DefPos, "message field [%s.%s] %v", name, field.Name, err)
+			}
+			if field.MaxLen != nil {
+				if err := prog.checkMaxLen(field.Type, field.MaxLen.Value); err != nil {
+					return NewErrorPos(field.MaxLen.DefPos, "message field [%s.%s] max_len %v", name, field.Name, err)
+				}
+			}
+			if field.Default == nil {
+				continue
+			}
+			if err := prog.checkDefault(field.Type, field.Default.Value); err != nil {
+				return NewErrorPos(field.Default.DefPos, "message field [%s.%s] default value %v", name, field.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// 固定长度数组. 填充引用常量的长度
+func (prog *YTProgram) checkFieldSize(field *YTField) (err error) {
+	list := field.Type.YTListType
+	if list == nil || list.SizeMacro == nil {
+		return
+	}
+	if list.Size, err = prog.lookupIntConst(list.SizeMacro); err != nil {
+		return fmt.Errorf("array size %v", err)
+	}
+	if list.Size < 1 {
+		return fmt.Errorf("array size [%d] invalid, must be positive", list.Size)
+	}
+	return
+}
+
+// 最大长度. 只能用于不定长数组,map,字符串及二进制
+func (prog *YTProgram) checkMaxLen(typ *YTFieldType, val *YTOptionValue) (err error) {
+	switch {
+	case typ.YTListType != nil:
+		if typ.YTListType.Size > 0 {
+			return errors.New("can not use in fixed array")
+		}
+	case typ.YTMapTypee != nil:
+	case typ.YTBaseType != nil && (*typ.YTBaseType == *BaseTypeString || *typ.YTBaseType == *BaseTypeBinary):
+	default:
+		return errors.New("only support list, map, string and bytes")
+	}
+	if err = prog.resolveOptionValue(val); err != nil {
+		return
+	}
+	if val.Kind != OptionValueInt {
+		return fmt.Errorf("need integer, got [%s]", val.Kind)
+	}
+	if *val.IntVal < 1 {
+		return fmt.Errorf("[%d] invalid, must be positive", *val.IntVal)
+	}
+	return
+}
+
+// 按字段类型检测默认值. 枚举字段使用 枚举名.值名, 填充枚举值
+func (prog *YTProgram) checkDefault(typ *YTFieldType, val *YTOptionValue) (err error) {
+	switch {
+	case typ.YTListType != nil, typ.YTMapTypee != nil:
+		return errors.New("only support basic type and enum")
+	case typ.YTCustomType != nil:
+		if typ.Enum == nil {
+			return errors.New("only support basic type and enum")
+		}
+		return checkEnumDefault(typ.Enum, val)
+	}
+	if err = prog.resolveOptionValue(val); err != nil {
+		return
+	}
+	base := *typ.YTBaseType
+	switch base {
+	case *BaseTypeBool:
+		if val.Kind != OptionValueBool {
+			return fmt.Errorf("need bool, got [%s]", val.Kind)
+		}
+	case *BaseTypeString, *BaseTypeBinary:
+		if val.Kind != OptionValueString {
+			return fmt.Errorf("need string, got [%s]", val.Kind)
+		}
+	case *BaseTypeFloat32, *BaseTypeFloat64:
+		switch val.Kind {
+		case OptionValueInt:
+			v := float64(*val.IntVal)
+			val.Kind, val.FloatVal, val.IntVal = OptionValueFloat, &v, nil
+		case OptionValueFloat:
+		default:
+			return fmt.Errorf("need float, got [%s]", val.Kind)
+		}
+		if base == *BaseTypeFloat32 && math.Abs(*val.FloatVal) > math.MaxFloat32 {
+			return fmt.Errorf("[%v] out of range %s", *val.FloatVal, typ.YTBaseType)
+		}
+	default:
+		if val.Kind != OptionValueInt {
+			return fmt.Errorf("need integer, got [%s]", val.Kind)
+		}
+		min, max := intRange(typ.YTBaseType)
+		if v := *val.IntVal; v < min || (max >= 0 && v > max) {
+			return fmt.Errorf("[%d] out of range %s", v, typ.YTBaseType)
+		}
+	}
+	return
+}
+
+// 整数类型取值范围. uint64 上限超出 int64 时 max 为 -1
+func intRange(typ *YTBaseType) (min, max int64) {
+	switch *typ {
+	case *BaseTypeInt8:
+		return math.MinInt8, math.MaxInt8
+	case *BaseTypeUint8:
+		return 0, math.MaxUint8
+	case *BaseTypeInt16:
+		return math.MinInt16, math.MaxInt16
+	case *BaseTypeUint16:
+		return 0, math.MaxUint16
+	case *BaseTypeInt32:
+		return math.MinInt32, math.MaxInt32
+	case *BaseTypeUint32:
+		return 0, math.MaxUint32
+	case *BaseTypeUint64:
+		return 0, -1
+	}
+	return math.MinInt64, math.MaxInt64
+}
+
+// 枚举默认值. 引用的枚举名需要与字段枚举一致
+func checkEnumDefault(def *YTEnumDef, val *YTOptionValue) error {
+	if val.Macro == nil {
+		return fmt.Errorf("need enum value of [%s]", def.Name)
+	}
+	name := val.Macro.Name
+	if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
+		prefix := name[:idx]
+		if prefix != def.Name && !strings.HasSuffix(prefix, "."+def.Name) {
+			return fmt.Errorf("[%s] is not value of enum [%s]", name, def.Name)
+		}
+		name = name[idx+1:]
+	}
+	for _, ev := range def.Values {
+		if ev.Name == name {
+			v, n := ev.Value, ev.Name
+			val.Kind, val.IntVal, val.Value = OptionValueInt, &v, &n
+			return nil
+		}
+	}
+	return fmt.Errorf("enum [%s] value [%s] not found", def.Name, name)
+}
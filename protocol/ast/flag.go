@@ -16,10 +16,15 @@ limitations under the License.
 
 package ast
 
-type astFlag struct {
+// AnalyseFlag 分析参数
+type AnalyseFlag struct {
 	// ServiceUseMethodID  命令参数
 	ServiceUseMethodID bool
+	// AutoMethodID 自动分配接口序号. 未设置序号的接口不报错,由加载器分配
+	AutoMethodID bool
+	// OptionSchemas 外部选项定义(例如插件提供的选项定义文件)
+	OptionSchemas []*YTOptionSchema
 }
 
-// Flag ast包导出标记
-var Flag = &astFlag{}
+// Flag ast包导出标记. AnalyseProgram 使用的默认分析参数
+var Flag = &AnalyseFlag{}
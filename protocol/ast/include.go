@@ -0,0 +1,211 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"strings"
+
+	"github.com/walleframe/wctl/builder/buildpb"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// YTInclude 消息内嵌入的其他消息. include header = 100
+type YTInclude struct {
+	DefPos token.Pos
+	// 引用的消息. 分析阶段填充 Msg 及 FullName
+	*YTCustomType
+	// 字段序号偏移
+	Offset int32
+}
+
+// 嵌入展开状态
+const (
+	includeNone int8 = iota
+	includeExpanding
+	includeDone
+)
+
+// 展开消息嵌入. 需要在字段检测之后调用, 展开的字段已在来源消息中检测
+func (prog *YTProgram) checkIncludes() (err error) {
+	err = prog.resolveIncludes(prog.Messages, nil)
+	if err != nil {
+		return
+	}
+	return expandIncludes(prog.Messages)
+}
+
+// 查找嵌入的消息. 与字段类型相同, 在消息内由内到外查找
+func (prog *YTProgram) resolveIncludes(msgs []*YTMessage, scopes []*YTMessage) (err error) {
+	for _, msg := range msgs {
+		inner := append(scopes[:len(scopes):len(scopes)], msg)
+		for _, inc := range msg.Includes {
+			iprog, src, _, full := prog.lookupTypeProg(inc.Name, inner)
+			if src == nil {
+				return NewErrorPos(inc.DefPos, "message %s include [%s] must be message", msg.Name, inc.Name)
+			}
+			if prog.inImportCycle(iprog) {
+				return NewErrorPos(inc.DefPos, "message %s include [%s] defined in import cycle file [%s], only types can be referenced", msg.Name, inc.Name, iprog.File)
+			}
+			inc.Msg, inc.FullName = src, full
+		}
+		err = prog.resolveIncludes(msg.SubMsgs, inner)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func expandIncludes(msgs []*YTMessage) (err error) {
+	for _, msg := range msgs {
+		if err = msg.expandIncludes(); err != nil {
+			return
+		}
+		if err = expandIncludes(msg.SubMsgs); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// 展开嵌入消息的字段. 嵌入的字段按声明顺序放在消息自身字段之前
+func (msg *YTMessage) expandIncludes() (err error) {
+	switch msg.includeState {
+	case includeDone:
+		return
+	case includeExpanding:
+		return NewErrorPos(msg.DefPos, "message include cycle [%s]", msg.Name)
+	}
+	msg.includeState = includeExpanding
+	var fields, list []*YTField
+	for _, inc := range msg.Includes {
+		if inc.Msg == nil {
+			return NewErrorPos(inc.DefPos, "message %s include [%s] not resolved", msg.Name, inc.Name)
+		}
+		// 先展开被嵌入的消息. 其他包的消息已经展开
+		if err = inc.Msg.expandIncludes(); err != nil {
+			return
+		}
+		if list, err = msg.includeFields(inc); err != nil {
+			return
+		}
+		fields = append(fields, list...)
+	}
+	msg.Fields = append(fields, msg.Fields...)
+	msg.includeState = includeDone
+	return
+}
+
+// 复制嵌入消息的字段及联合字段, 并检测序号及名称冲突
+func (msg *YTMessage) includeFields(inc *YTInclude) (fields []*YTField, err error) {
+	// 其他包的消息. 本包类型名称需要添加包前缀
+	prefix := strings.TrimSuffix(inc.FullName, inc.Msg.FullName)
+	copied := make(map[*YTField]*YTField, len(inc.Msg.Fields))
+	for _, src := range inc.Msg.Fields {
+		no := int64(src.No) + int64(inc.Offset)
+		if err = CheckFieldNo(no); err != nil {
+			return nil, NewErrorPos(inc.DefPos, "message %s include [%s] field [%s] %v", msg.Name, inc.Name, src.Name, err)
+		}
+		if last, ok := msg.Reserved.checkReservedNo(no); ok {
+			return nil, NewErrorPos(inc.DefPos, "message field id [%s.%s=%d] is reserved %s", msg.Name, src.Name, no, last.String())
+		}
+		if last, ok := msg.Reserved.checkReservedName(src.Name); ok {
+			return nil, NewErrorPos(inc.DefPos, "message field name [%s.%s] is reserved %s", msg.Name, src.Name, last.String())
+		}
+		if last, ok := msg.checkUnionNo(no); ok {
+			return nil, NewErrorPos(inc.DefPos, "message field id repeated [%s.%s=%d] include [%s] %s", msg.Name, src.Name, no, inc.Name, last.String())
+		}
+		msg.addUnionNo(no, inc.DefPos)
+		if last, ok := msg.checkUnionName(src.Name); ok {
+			return nil, NewErrorPos(inc.DefPos, "message field name repeated [%s.%s] include [%s] %s", msg.Name, src.Name, inc.Name, last.String())
+		}
+		msg.addUnionName(src.Name, inc.DefPos)
+
+		field := &YTField{}
+		*field = *src
+		field.No = int32(no)
+		field.Type = src.Type.withPackage(prefix)
+		field.Include = inc
+		field.Oneof = nil
+		copied[src] = field
+		fields = append(fields, field)
+	}
+	for _, src := range inc.Msg.Oneofs {
+		for _, last := range msg.Oneofs {
+			if last.Name == src.Name {
+				return nil, NewErrorPos(inc.DefPos, "message oneof name repeated [%s.%s] include [%s] %s", msg.Name, src.Name, inc.Name, last.DefPos.String())
+			}
+		}
+		oneof := &YTOneof{}
+		*oneof = *src
+		oneof.Fields = make([]*YTField, 0, len(src.Fields))
+		for _, v := range src.Fields {
+			field := copied[v]
+			field.Oneof = oneof
+			oneof.Fields = append(oneof.Fields, field)
+		}
+		msg.Oneofs = append(msg.Oneofs, oneof)
+	}
+	return
+}
+
+// 其他包消息字段的类型. 引用本包的类型添加包前缀
+func (typ *YTFieldType) withPackage(prefix string) *YTFieldType {
+	if prefix == "" {
+		return typ
+	}
+	clone := *typ
+	switch {
+	case typ.YTListType != nil:
+		if typ.YTListType.YTCustomType != nil {
+			list := *typ.YTListType
+			list.YTCustomType = list.YTCustomType.withPackage(prefix)
+			clone.YTListType = &list
+		}
+	case typ.YTMapTypee != nil:
+		if typ.YTMapTypee.Value.YTCustomType != nil {
+			value := *typ.YTMapTypee.Value
+			value.YTCustomType = value.YTCustomType.withPackage(prefix)
+			kv := *typ.YTMapTypee
+			kv.Value = &value
+			clone.YTMapTypee = &kv
+		}
+	case typ.YTCustomType != nil:
+		clone.YTCustomType = typ.YTCustomType.withPackage(prefix)
+	}
+	return &clone
+}
+
+func (cst *YTCustomType) withPackage(prefix string) *YTCustomType {
+	clone := *cst
+	// 引用其他包的类型已包含包名. 名称同时使用全名, 保证生成的描述引用正确
+	if (cst.Msg != nil && cst.FullName == cst.Msg.FullName) ||
+		(cst.Enum != nil && cst.FullName == cst.Enum.FullName) {
+		clone.FullName = prefix + cst.FullName
+		clone.Name = clone.FullName
+	}
+	return &clone
+}
+
+func (inc *YTInclude) toDesc() (desc *buildpb.TypeDesc) {
+	desc = &buildpb.TypeDesc{
+		Type:       buildpb.FieldType_CustomType,
+		Key:        inc.Name,
+		ElemCustom: true,
+	}
+	inc.fillDesc(desc)
+	return
+}
@@ -0,0 +1,122 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import "strings"
+
+// RangeEnums 遍历文件内所有枚举(包含嵌套枚举). name 为包内全名, 例如 outer.kind
+func (prog *YTProgram) RangeEnums(f func(name string, def *YTEnumDef) error) error {
+	for _, def := range prog.EnumDefs {
+		if err := f(def.Name, def); err != nil {
+			return err
+		}
+	}
+	return prog.RangeMessages(func(name string, msg *YTMessage) error {
+		for _, def := range msg.SubEnums {
+			if err := f(name+"."+def.Name, def); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 按路径查找嵌套类型. 最后一级可以是消息或枚举
+func findNested(msgs []*YTMessage, enums []*YTEnumDef, path []string) (*YTMessage, *YTEnumDef) {
+	for _, msg := range msgs {
+		if msg.Name != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return msg, nil
+		}
+		return findNested(msg.SubMsgs, msg.SubEnums, path[1:])
+	}
+	if len(path) == 1 {
+		for _, def := range enums {
+			if def.Name == path[0] {
+				return nil, def
+			}
+		}
+	}
+	return nil, nil
+}
+
+// 查找类型. scopes 为由外到内的消息嵌套链, 由内到外逐层查找, 然后查找文件顶层及引用包.
+// 返回类型的全名: 本包类型为包内全名, 其他包类型包含包名
+func (prog *YTProgram) lookupType(name string, scopes []*YTMessage) (msg *YTMessage, def *YTEnumDef, full string) {
+	_, msg, def, full = prog.lookupTypeProg(name, scopes)
+	return
+}
+
+// 查找类型及类型所在的文件
+func (prog *YTProgram) lookupTypeProg(name string, scopes []*YTMessage) (iprog *YTProgram, msg *YTMessage, def *YTEnumDef, full string) {
+	path := strings.Split(name, ".")
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if msg, def = findNested(scopes[i].SubMsgs, scopes[i].SubEnums, path); msg != nil || def != nil {
+			return prog, msg, def, fullName(msg, def)
+		}
+	}
+	if msg, def = findNested(prog.Messages, prog.EnumDefs, path); msg != nil || def != nil {
+		return prog, msg, def, fullName(msg, def)
+	}
+	// 兼容: 嵌套消息名称在文件内唯一时, 可以直接使用
+	if len(path) == 1 {
+		if msg = prog.findMsgByName(name); msg != nil {
+			return prog, msg, nil, msg.FullName
+		}
+		return
+	}
+	// 引用包
+	iprogs, ok := prog.impMap[path[0]]
+	if !ok && path[0] == prog.Pkg.Name {
+		iprogs = []*YTProgram{prog}
+	}
+	for _, iprog = range iprogs {
+		msg, def = findNested(iprog.Messages, iprog.EnumDefs, path[1:])
+		if msg == nil && def == nil && len(path) == 2 {
+			msg = iprog.findMsgByName(path[1])
+		}
+		if msg != nil || def != nil {
+			if iprog == prog {
+				return iprog, msg, def, fullName(msg, def)
+			}
+			return iprog, msg, def, iprog.Pkg.Name + "." + fullName(msg, def)
+		}
+	}
+	return nil, nil, nil, ""
+}
+
+// 使用名称查找消息(包含嵌套消息). 名称不唯一时返回nil
+func (prog *YTProgram) findMsgByName(name string) (msg *YTMessage) {
+	for _, v := range prog.msgMap {
+		if v.Name != name {
+			continue
+		}
+		if msg != nil {
+			return nil
+		}
+		msg = v
+	}
+	return
+}
+
+func fullName(msg *YTMessage, def *YTEnumDef) string {
+	if msg != nil {
+		return msg.FullName
+	}
+	return def.FullName
+}
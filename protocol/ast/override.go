@@ -0,0 +1,262 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// OptionOverride 选项覆盖. 格式 [选择器:]key[=value]
+//
+// 选择器格式 [类型/]名称, 名称支持 * ? 通配符(不跨越 . 分隔的层级), 匹配全名(包含包名)或不包含包名的名称.
+// 类型: file, message, field, oneof, enum, service, method. 未设置类型时匹配除文件外的所有类型.
+// 未设置选择器时为文件级选项.
+type OptionOverride struct {
+	// 原始参数
+	Raw string
+	// 作用范围
+	Scope OptionScope
+	// 名称匹配模式
+	Pattern string
+	Key     string
+	Value   *YTOptionValue
+	// 值原始文本. 存在选项定义时按定义的类型转换
+	text string
+}
+
+// 未设置类型时的作用范围
+const overrideAnyScope = ScopeMessage | ScopeField | ScopeOneof | ScopeEnum | ScopeService | ScopeMethod
+
+// 选项覆盖位置
+type overrideSource string
+
+func (s overrideSource) Source() string {
+	return "option [" + string(s) + "]"
+}
+
+// ParseOptionOverride 解析选项覆盖参数
+func ParseOptionOverride(raw string) (ov *OptionOverride, err error) {
+	opt := strings.TrimSpace(raw)
+	ov = &OptionOverride{Raw: opt, Scope: ScopeFile}
+	head := opt
+	if index := strings.IndexByte(opt, '='); index >= 0 {
+		head = opt[:index]
+		ov.text = strings.TrimSpace(opt[index+1:])
+		ov.Value = parseOverrideValue(ov.text)
+	}
+	ov.Key = strings.TrimSpace(head)
+	// 选择器
+	if index := strings.LastIndexByte(head, ':'); index >= 0 {
+		selector := strings.TrimSpace(head[:index])
+		ov.Key = strings.TrimSpace(head[index+1:])
+		ov.Scope, ov.Pattern = overrideAnyScope, selector
+		if kind := strings.IndexByte(selector, '/'); kind >= 0 {
+			ov.Scope, err = ParseOptionScope(selector[:kind])
+			if err != nil || ov.Scope == ScopeProject {
+				return nil, fmt.Errorf("option [%s] selector type [%s] invalid", opt, selector[:kind])
+			}
+			ov.Pattern = selector[kind+1:]
+		}
+		if ov.Pattern == "" {
+			return nil, fmt.Errorf("option [%s] selector empty", opt)
+		}
+		if _, err = path.Match(ov.Pattern, ""); err != nil {
+			return nil, fmt.Errorf("option [%s] selector invalid, %w", opt, err)
+		}
+	}
+	if !strings.Contains(ov.Key, ".") {
+		return nil, fmt.Errorf("option [%s] is not valid options, key must be aaa.bbb", opt)
+	}
+	return
+}
+
+// 解析选项值. 依次尝试 bool, 整数, 浮点数, 否则为字符串. 没有选项定义时使用
+func parseOverrideValue(value string) *YTOptionValue {
+	switch value {
+	case "true", "false":
+		b, v := value == "true", int64(0)
+		if b {
+			v = 1
+		}
+		return &YTOptionValue{Kind: OptionValueBool, BoolVal: &b, IntVal: &v}
+	}
+	if num, err := strconv.ParseInt(value, 0, 64); err == nil {
+		return &YTOptionValue{Kind: OptionValueInt, IntVal: &num}
+	}
+	if num, err := strconv.ParseFloat(value, 64); err == nil {
+		return &YTOptionValue{Kind: OptionValueFloat, FloatVal: &num}
+	}
+	return &YTOptionValue{Kind: OptionValueString, Value: &value}
+}
+
+// 按选项定义的类型转换选项值. 列表及对象值不支持覆盖
+func convertOverrideValue(value string, kind OptionValueKind) (*YTOptionValue, error) {
+	switch kind {
+	case OptionValueString:
+		return &YTOptionValue{Kind: OptionValueString, Value: &value}, nil
+	case OptionValueInt:
+		num, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value [%s] is not int", value)
+		}
+		return &YTOptionValue{Kind: OptionValueInt, IntVal: &num}, nil
+	case OptionValueFloat:
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value [%s] is not float", value)
+		}
+		return &YTOptionValue{Kind: OptionValueFloat, FloatVal: &num}, nil
+	case OptionValueBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value [%s] is not bool", value)
+		}
+		v := int64(0)
+		if b {
+			v = 1
+		}
+		return &YTOptionValue{Kind: OptionValueBool, BoolVal: &b, IntVal: &v}, nil
+	}
+	return nil, fmt.Errorf("%s value can not override", kind)
+}
+
+// 是否匹配. 未设置选择器时只匹配文件
+func (ov *OptionOverride) match(scope OptionScope, names ...string) bool {
+	if ov.Pattern == "" {
+		return scope == ScopeFile
+	}
+	if ov.Scope&scope == 0 {
+		return false
+	}
+	// 通配符不跨越名称层级
+	pattern := strings.ReplaceAll(ov.Pattern, ".", "/")
+	for _, name := range names {
+		if ok, _ := path.Match(pattern, strings.ReplaceAll(name, ".", "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// 设置选项. 替换同名选项
+func (opts *YTOptions) setOption(opt *YTOption) {
+	opts.opm = nil
+	for k, v := range opts.Opts {
+		if v.Key == opt.Key {
+			opts.Opts[k] = opt
+			return
+		}
+	}
+	opts.Opts = append(opts.Opts, opt)
+}
+
+// ApplyOptionOverrides 应用选项覆盖. 覆盖源文件中同名的选项, 后面的覆盖前面的
+func (prog *YTProgram) ApplyOptionOverrides(list ...*OptionOverride) (err error) {
+	if len(list) < 1 {
+		return
+	}
+	pkg := ""
+	if prog.Pkg != nil {
+		pkg = prog.Pkg.Name
+	}
+	// 存在选项定义时按定义的类型转换值, 否则使用推断的类型
+	values := make([]*YTOptionValue, len(list))
+	var schemas map[string]*YTOptionSchema
+	if prog.flag != nil {
+		schemas = prog.optionSchemas()
+	}
+	for k, ov := range list {
+		values[k] = ov.Value
+		idx := strings.IndexByte(ov.Key, '.')
+		if ov.Value == nil || ov.text == "" || idx < 0 {
+			continue
+		}
+		schema, ok := schemas[ov.Key[:idx]]
+		if !ok {
+			continue
+		}
+		item := schema.Lookup(ov.Key[idx+1:])
+		if item == nil {
+			continue
+		}
+		values[k], err = convertOverrideValue(ov.text, item.Type)
+		if err != nil {
+			return NewErrorPos(token.Pos{Context: overrideSource(ov.Raw)}, "option [%s] %v", ov.Key, err)
+		}
+	}
+	apply := func(scope OptionScope, opts *YTOptions, names ...string) {
+		for k, ov := range list {
+			if ov.match(scope, names...) {
+				opts.setOption(&YTOption{
+					DefPos: token.Pos{Context: overrideSource(ov.Raw)},
+					Key:    ov.Key,
+					Value:  values[k],
+				})
+			}
+		}
+	}
+	// 文件匹配包名或文件路径, 其他匹配全名或不包含包名的名称
+	apply(ScopeFile, &prog.YTOptions, pkg, prog.File)
+	prog.RangeEnums(func(name string, def *YTEnumDef) error {
+		apply(ScopeEnum, &def.YTOptions, name, pkg+"."+name)
+		return nil
+	})
+	prog.RangeMessages(func(name string, msg *YTMessage) error {
+		apply(ScopeMessage, &msg.YTOptions, name, pkg+"."+name)
+		for _, field := range msg.Fields {
+			full := name + "." + field.Name
+			apply(ScopeField, &field.YTOptions, full, pkg+"."+full)
+		}
+		for _, oneof := range msg.Oneofs {
+			full := name + "." + oneof.Name
+			apply(ScopeOneof, &oneof.YTOptions, full, pkg+"."+full)
+		}
+		return nil
+	})
+	for _, svc := range prog.Services {
+		apply(ScopeService, &svc.YTOptions, svc.Name, pkg+"."+svc.Name)
+		for _, method := range svc.Methods {
+			full := svc.Name + "." + method.Name
+			apply(ScopeMethod, &method.YTOptions, full, pkg+"."+full)
+		}
+	}
+	// 选项已变化, 重新生成文件描述
+	prog.desc = nil
+	// 使用选项定义检测覆盖后的选项
+	if prog.flag != nil {
+		err = prog.checkOptionSchemas()
+	}
+	return
+}
+
+// ApplyCmdOptions 应用命令行参数添加的选项
+// 格式为 "xx.xxx=66" "xx.x1" "pkg.Msg.field:xx.xx2=xxx" "service/*:xx.x3=5"
+func (prog *YTProgram) ApplyCmdOptions(opts ...string) (err error) {
+	list := make([]*OptionOverride, 0, len(opts))
+	for _, opt := range opts {
+		ov, err := ParseOptionOverride(opt)
+		if err != nil {
+			return err
+		}
+		list = append(list, ov)
+	}
+	return prog.ApplyOptionOverrides(list...)
+}
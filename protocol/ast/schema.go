@@ -0,0 +1,376 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// OptionScope 选项作用域
+type OptionScope uint16
+
+// 选项作用域
+const (
+	ScopeFile OptionScope = 1 << iota
+	ScopeMessage
+	ScopeField
+	ScopeOneof
+	ScopeEnum
+	ScopeService
+	ScopeMethod
+	ScopeProject
+)
+
+var scopeNames = []struct {
+	name  string
+	scope OptionScope
+}{
+	{"file", ScopeFile},
+	{"message", ScopeMessage},
+	{"field", ScopeField},
+	{"oneof", ScopeOneof},
+	{"enum", ScopeEnum},
+	{"service", ScopeService},
+	{"method", ScopeMethod},
+	{"project", ScopeProject},
+}
+
+// ParseOptionScope 解析作用域名称
+func ParseOptionScope(name string) (OptionScope, error) {
+	for _, v := range scopeNames {
+		if v.name == name {
+			return v.scope, nil
+		}
+	}
+	list := make([]string, 0, len(scopeNames))
+	for _, v := range scopeNames {
+		list = append(list, v.name)
+	}
+	return 0, fmt.Errorf("option scope [%s] invalid, support %v", name, list)
+}
+
+func (scope OptionScope) String() string {
+	list := make([]string, 0, len(scopeNames))
+	for _, v := range scopeNames {
+		if scope&v.scope != 0 {
+			list = append(list, v.name)
+		}
+	}
+	return strings.Join(list, "|")
+}
+
+var kindNames = []string{
+	OptionValueString: "string",
+	OptionValueInt:    "int",
+	OptionValueBool:   "bool",
+	OptionValueFloat:  "float",
+	OptionValueList:   "list",
+	OptionValueObject: "object",
+}
+
+// ParseOptionValueKind 解析选项值类型名称
+func ParseOptionValueKind(name string) (OptionValueKind, error) {
+	for k, v := range kindNames {
+		if v == name {
+			return OptionValueKind(k), nil
+		}
+	}
+	return 0, fmt.Errorf("option type [%s] invalid, support %v", name, kindNames)
+}
+
+func (kind OptionValueKind) String() string {
+	if int(kind) < len(kindNames) {
+		return kindNames[kind]
+	}
+	return "unkown"
+}
+
+// 选项值是否符合类型. 浮点数类型可以使用整数值
+func (kind OptionValueKind) match(val *YTOptionValue) bool {
+	if val == nil {
+		return false
+	}
+	return val.Kind == kind || (kind == OptionValueFloat && val.Kind == OptionValueInt)
+}
+
+// YTOptionSchema 选项定义. 声明一个命名空间下所有的选项
+type YTOptionSchema struct {
+	*YTDoc
+	DefPos    token.Pos
+	Namespace string
+	Items     []*YTOptionSchemaItem
+}
+
+// YTOptionSchemaItem 选项定义项
+type YTOptionSchemaItem struct {
+	*YTDoc
+	DefPos token.Pos
+	// 选项名(不包含命名空间)
+	Key  string
+	Type OptionValueKind
+	// 允许的作用域. 0 为不限制
+	Scope OptionScope
+	// 默认值
+	Default *YTOptionValue
+}
+
+// Lookup 查找选项定义项
+func (schema *YTOptionSchema) Lookup(key string) *YTOptionSchemaItem {
+	for _, v := range schema.Items {
+		if v.Key == key {
+			return v
+		}
+	}
+	return nil
+}
+
+// Check 检测选项定义合理性
+func (schema *YTOptionSchema) Check() error {
+	if schema.Namespace == "" || strings.Contains(schema.Namespace, ".") {
+		return NewErrorPos(schema.DefPos, "option schema namespace [%s] invalid", schema.Namespace)
+	}
+	keys := make(map[string]*YTOptionSchemaItem, len(schema.Items))
+	for _, v := range schema.Items {
+		if last, ok := keys[v.Key]; ok {
+			return NewErrorPos(v.DefPos, "option schema [%s.%s] repeated with %s", schema.Namespace, v.Key, last.DefPos.String())
+		}
+		keys[v.Key] = v
+		if v.Default != nil && !v.Type.match(v.Default) {
+			return NewErrorPos(v.DefPos, "option schema [%s.%s] default value type [%s] not match [%s]", schema.Namespace, v.Key, v.Default.Kind, v.Type)
+		}
+	}
+	return nil
+}
+
+// 本文件定义的选项定义检测
+func (prog *YTProgram) checkOptionSchemaDefine() error {
+	ns := make(map[string]*YTOptionSchema, len(prog.OptionSchemas))
+	for _, v := range prog.OptionSchemas {
+		if last, ok := ns[v.Namespace]; ok {
+			return NewErrorPos(v.DefPos, "option schema namespace [%s] repeated with %s", v.Namespace, last.DefPos.String())
+		}
+		ns[v.Namespace] = v
+		if err := v.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 收集可用的选项定义. 分析参数, 本文件及import文件(包含间接import)
+func (prog *YTProgram) optionSchemas() map[string]*YTOptionSchema {
+	schemas := make(map[string]*YTOptionSchema)
+	add := func(list []*YTOptionSchema) {
+		for _, v := range list {
+			if _, ok := schemas[v.Namespace]; !ok {
+				schemas[v.Namespace] = v
+			}
+		}
+	}
+	add(prog.flag.OptionSchemas)
+	visited := make(map[*YTProgram]struct{})
+	var each func(p *YTProgram)
+	each = func(p *YTProgram) {
+		if _, ok := visited[p]; ok {
+			return
+		}
+		visited[p] = struct{}{}
+		add(p.OptionSchemas)
+		for _, imp := range p.Imports {
+			if imp.Prog != nil {
+				each(imp.Prog)
+			}
+		}
+	}
+	each(prog)
+	return schemas
+}
+
+// 使用选项定义检测所有选项. 只检测已定义的命名空间, 相近的命名空间提示拼写错误
+func (prog *YTProgram) checkOptionSchemas() error {
+	schemas := prog.optionSchemas()
+	if len(schemas) == 0 {
+		return nil
+	}
+	err := prog.rangeOptions(func(scope OptionScope, opt *YTOption) error {
+		ns, key := opt.Key, ""
+		if idx := strings.IndexByte(opt.Key, '.'); idx >= 0 {
+			ns, key = opt.Key[:idx], opt.Key[idx+1:]
+		}
+		schema, ok := schemas[ns]
+		if !ok {
+			names := make([]string, 0, len(schemas))
+			for k := range schemas {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			if near := nearName(ns, names); near != "" {
+				return NewErrorPos(opt.DefPos, "unknown option [%s], did you mean [%s.%s]?", opt.Key, near, key)
+			}
+			return nil
+		}
+		item := schema.Lookup(key)
+		if item == nil {
+			names := make([]string, 0, len(schema.Items))
+			for _, v := range schema.Items {
+				names = append(names, v.Key)
+			}
+			if near := nearName(key, names); near != "" {
+				return NewErrorPos(opt.DefPos, "unknown option [%s], did you mean [%s.%s]?", opt.Key, ns, near)
+			}
+			return NewErrorPos(opt.DefPos, "unknown option [%s]", opt.Key)
+		}
+		if item.Scope != 0 && item.Scope&scope == 0 {
+			return NewErrorPos(opt.DefPos, "option [%s] can not use in %s, only allowed in %s", opt.Key, scope, item.Scope)
+		}
+		if opt.Value != nil && !item.Type.match(opt.Value) {
+			return NewErrorPos(opt.DefPos, "option [%s] value type [%s] invalid, need [%s]", opt.Key, opt.Value.Kind, item.Type)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	prog.applyOptionDefaults(schemas)
+	return nil
+}
+
+// 未设置的选项使用选项定义的默认值. 只填充声明的作用域, 未限制作用域的选项不填充
+func (prog *YTProgram) applyOptionDefaults(schemas map[string]*YTOptionSchema) {
+	names := make([]string, 0, len(schemas))
+	for k := range schemas {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	prog.rangeOptionSets(func(scope OptionScope, opts *YTOptions) error {
+		for _, ns := range names {
+			for _, item := range schemas[ns].Items {
+				if item.Default == nil || item.Scope&scope == 0 {
+					continue
+				}
+				key := ns + "." + item.Key
+				if opts.GetOption(key) != nil {
+					continue
+				}
+				opts.Opts = append(opts.Opts, &YTOption{
+					DefPos: item.DefPos,
+					Key:    key,
+					Value:  item.Default.clone(),
+				})
+			}
+		}
+		return nil
+	})
+}
+
+// 复制选项值. 默认值填充到多个定义时互不影响
+func (val *YTOptionValue) clone() *YTOptionValue {
+	if val == nil {
+		return nil
+	}
+	v := *val
+	if val.Value != nil {
+		s := *val.Value
+		v.Value = &s
+	}
+	if val.IntVal != nil {
+		i := *val.IntVal
+		v.IntVal = &i
+	}
+	if val.BoolVal != nil {
+		b := *val.BoolVal
+		v.BoolVal = &b
+	}
+	if val.FloatVal != nil {
+		f := *val.FloatVal
+		v.FloatVal = &f
+	}
+	if val.Macro != nil {
+		m := *val.Macro
+		v.Macro = &m
+	}
+	if val.List != nil {
+		v.List = make([]*YTOptionValue, 0, len(val.List))
+		for _, item := range val.List {
+			v.List = append(v.List, item.clone())
+		}
+	}
+	if val.Object != nil {
+		v.Object = make([]*YTOptionField, 0, len(val.Object))
+		for _, field := range val.Object {
+			f := *field
+			f.Value = field.Value.clone()
+			v.Object = append(v.Object, &f)
+		}
+	}
+	return &v
+}
+
+// 查找相近的名称. 用于拼写错误提示
+func nearName(name string, names []string) (near string) {
+	best := len(name)/3 + 1
+	if best < 2 {
+		best = 2
+	}
+	for _, v := range names {
+		if v == name {
+			continue
+		}
+		if d := editDistance(name, v); d < best {
+			best, near = d, v
+		}
+	}
+	return
+}
+
+// 编辑距离. 相邻字符交换计为一次编辑
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min3(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && d[i-2][j-2]+1 < d[i][j] {
+				d[i][j] = d[i-2][j-2] + 1
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"github.com/walleframe/wctl/builder/buildpb"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// 源码位置. 未知时为0, 命令行设置的选项没有位置
+func sourcePos(pos, end token.Pos) *buildpb.SourcePos {
+	desc := &buildpb.SourcePos{}
+	if pos.Line > 0 {
+		if src, ok := pos.Context.(token.Sourcer); ok {
+			desc.File = src.Source()
+		}
+		desc.Line, desc.Column = int32(pos.Line), int32(pos.Column)
+	}
+	if end.Line > 0 {
+		desc.EndLine, desc.EndColumn = int32(end.Line), int32(end.Column)
+	}
+	return desc
+}
+
+// 填充元素路径. 路径由 FileDesc 中的字段名及定义名组成, 例如 Msgs/outer/SubMsgs/inner/Fields/uid
+func setDescPaths(desc *buildpb.FileDesc) {
+	setOptionPaths(desc.Options, "Options")
+	for _, v := range desc.Enums {
+		setEnumPaths(v, "Enums/"+v.Name)
+	}
+	for _, v := range desc.Msgs {
+		setMsgPaths(v, "Msgs/"+v.Name)
+	}
+	for _, svc := range desc.Services {
+		path := "Services/" + svc.Name
+		setPath(&svc.Pos, path)
+		setOptionPaths(svc.Options, path+"/Options")
+		for _, method := range svc.Methods {
+			path := path + "/Methods/" + method.Name
+			setPath(&method.Pos, path)
+			setOptionPaths(method.Options, path+"/Options")
+			setMsgPaths(method.Request, path+"/Request")
+			setMsgPaths(method.Reply, path+"/Reply")
+		}
+	}
+	for _, proj := range desc.Projects {
+		for k, v := range proj.Conf {
+			setOptionPaths(v, "Projects/"+proj.Name+"/Conf/"+k)
+		}
+	}
+}
+
+func setPath(pos **buildpb.SourcePos, path string) {
+	if *pos == nil {
+		*pos = &buildpb.SourcePos{}
+	}
+	(*pos).Path = path
+}
+
+func setOptionPaths(opts *buildpb.OptionDesc, path string) {
+	if opts == nil {
+		return
+	}
+	for k, v := range opts.Options {
+		setPath(&v.Pos, path+"/"+k)
+	}
+}
+
+func setEnumPaths(enum *buildpb.EnumDesc, path string) {
+	setPath(&enum.Pos, path)
+	setOptionPaths(enum.Options, path+"/Options")
+	for _, v := range enum.Values {
+		setPath(&v.Pos, path+"/Values/"+v.Name)
+	}
+}
+
+func setMsgPaths(msg *buildpb.MsgDesc, path string) {
+	if msg == nil {
+		return
+	}
+	setPath(&msg.Pos, path)
+	setOptionPaths(msg.Options, path+"/Options")
+	for _, v := range msg.Fields {
+		setPath(&v.Pos, path+"/Fields/"+v.Name)
+		setOptionPaths(v.Options, path+"/Fields/"+v.Name+"/Options")
+	}
+	for _, v := range msg.Oneofs {
+		setPath(&v.Pos, path+"/Oneofs/"+v.Name)
+		setOptionPaths(v.Options, path+"/Oneofs/"+v.Name+"/Options")
+	}
+	for _, v := range msg.SubEnums {
+		setEnumPaths(v, path+"/SubEnums/"+v.Name)
+	}
+	for _, v := range msg.SubMsgs {
+		setMsgPaths(v, path+"/SubMsgs/"+v.Name)
+	}
+}
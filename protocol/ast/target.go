@@ -0,0 +1,242 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"fmt"
+
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// YTTag 目标标记. @server
+type YTTag struct {
+	DefPos token.Pos
+	Name   string
+}
+
+// YTTags 目标标记列表. 未设置标记时生成到所有目标
+type YTTags struct {
+	Tags []*YTTag
+}
+
+// AddTags 追加目标标记
+func (tags *YTTags) AddTags(list ...*YTTag) {
+	tags.Tags = append(tags.Tags, list...)
+}
+
+// HasTag 是否设置了标记
+func (tags *YTTags) HasTag(name string) bool {
+	for _, v := range tags.Tags {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchTarget 是否生成到目标. 目标为空或未设置标记时总是生成
+func (tags *YTTags) MatchTarget(target string) bool {
+	return target == "" || len(tags.Tags) == 0 || tags.HasTag(target)
+}
+
+// TagNames 标记名称列表
+func (tags *YTTags) TagNames() (names []string) {
+	for _, v := range tags.Tags {
+		names = append(names, v.Name)
+	}
+	return
+}
+
+// Target 裁剪的目标. 空表示未裁剪
+func (prog *YTProgram) Target() string {
+	return prog.target
+}
+
+// FilterTarget 按目标裁剪消息,字段,枚举值,服务及方法, 包含import文件.
+// 裁剪后检测保留的定义不能引用被裁剪的定义. 生成代码前调用
+func (prog *YTProgram) FilterTarget(target string) (err error) {
+	if target == "" {
+		return
+	}
+	var progs []*YTProgram
+	prog.collectTarget(target, &progs)
+	for _, v := range progs {
+		v.pruneTarget(target)
+	}
+	for _, v := range progs {
+		if err = v.checkTargetRefs(target); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// 收集需要裁剪的文件. 已裁剪的文件忽略
+func (prog *YTProgram) collectTarget(target string, progs *[]*YTProgram) {
+	if prog.target != "" {
+		return
+	}
+	prog.target = target
+	*progs = append(*progs, prog)
+	for _, imp := range prog.Imports {
+		if imp.Prog != nil {
+			imp.Prog.collectTarget(target, progs)
+		}
+	}
+}
+
+func (prog *YTProgram) pruneTarget(target string) {
+	prog.Messages = pruneMsgs(prog.Messages, target)
+	for _, def := range prog.EnumDefs {
+		def.pruneTarget(target)
+	}
+	services := prog.Services[:0]
+	for _, svc := range prog.Services {
+		if !svc.MatchTarget(target) {
+			continue
+		}
+		methods := svc.Methods[:0]
+		for _, method := range svc.Methods {
+			if method.MatchTarget(target) {
+				methods = append(methods, method)
+			}
+		}
+		svc.Methods = methods
+		services = append(services, svc)
+	}
+	prog.Services = services
+	prog.desc = nil
+}
+
+func pruneMsgs(msgs []*YTMessage, target string) []*YTMessage {
+	list := msgs[:0]
+	for _, msg := range msgs {
+		if !msg.MatchTarget(target) {
+			msg.markPruned()
+			continue
+		}
+		fields := msg.Fields[:0]
+		for _, field := range msg.Fields {
+			if field.MatchTarget(target) {
+				fields = append(fields, field)
+			}
+		}
+		msg.Fields = fields
+		// 联合字段只保留未裁剪的字段, 没有字段时删除
+		oneofs := msg.Oneofs[:0]
+		for _, oneof := range msg.Oneofs {
+			fields := oneof.Fields[:0]
+			for _, field := range oneof.Fields {
+				if field.MatchTarget(target) {
+					fields = append(fields, field)
+				}
+			}
+			oneof.Fields = fields
+			if len(fields) > 0 {
+				oneofs = append(oneofs, oneof)
+			}
+		}
+		msg.Oneofs = oneofs
+		for _, def := range msg.SubEnums {
+			def.pruneTarget(target)
+		}
+		msg.SubMsgs = pruneMsgs(msg.SubMsgs, target)
+		list = append(list, msg)
+	}
+	return list
+}
+
+// 嵌套消息同时裁剪
+func (msg *YTMessage) markPruned() {
+	msg.pruned = true
+	for _, sub := range msg.SubMsgs {
+		sub.markPruned()
+	}
+}
+
+func (def *YTEnumDef) pruneTarget(target string) {
+	values := def.Values[:0]
+	for _, ev := range def.Values {
+		if ev.MatchTarget(target) {
+			values = append(values, ev)
+		}
+	}
+	def.Values = values
+}
+
+// 检测保留的定义是否引用了被裁剪的定义
+func (prog *YTProgram) checkTargetRefs(target string) (err error) {
+	if err = checkMsgsTarget(prog.Messages, target); err != nil {
+		return
+	}
+	for _, svc := range prog.Services {
+		for _, method := range svc.Methods {
+			for _, body := range []*YTMessage{method.Request, method.Reply} {
+				if body == nil {
+					continue
+				}
+				for _, field := range body.Fields {
+					if err = field.checkTarget(target); err != nil {
+						return NewErrorPos(method.DefPos, "method [%s.%s] %v", svc.Name, method.Name, err)
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func checkMsgsTarget(msgs []*YTMessage, target string) (err error) {
+	for _, msg := range msgs {
+		for _, field := range msg.Fields {
+			if err = field.checkTarget(target); err != nil {
+				pos := field.DefPos
+				if field.Include != nil {
+					pos = field.Include.DefPos
+				}
+				return NewErrorPos(pos, "field [%s.%s] %v", msg.Name, field.Name, err)
+			}
+		}
+		if err = checkMsgsTarget(msg.SubMsgs, target); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (field *YTField) checkTarget(target string) error {
+	if field.Include != nil && field.Include.Msg != nil && field.Include.Msg.pruned {
+		return fmt.Errorf("include message [%s] not generate for target [%s]", field.Include.Name, target)
+	}
+	cst := field.Type.elemCustom()
+	if cst == nil {
+		return nil
+	}
+	if cst.Msg != nil && cst.Msg.pruned {
+		return fmt.Errorf("reference message [%s] not generate for target [%s]", cst.Name, target)
+	}
+	// 枚举默认值
+	if cst.Enum != nil && field.Default != nil && field.Default.Value.Value != nil {
+		name := *field.Default.Value.Value
+		for _, ev := range cst.Enum.Values {
+			if ev.Name == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("default enum value [%s] not generate for target [%s]", name, target)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2023 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ast
+
+import (
+	"github.com/walleframe/wctl/builder/buildpb"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// YTThrows 服务或方法声明的错误枚举. login(rq) rs throws login_err
+type YTThrows struct {
+	DefPos token.Pos
+	// 引用的枚举. 分析阶段填充 Enum 及 FullName
+	*YTCustomType
+}
+
+// 检测声明的错误枚举
+func (prog *YTProgram) checkThrows() (err error) {
+	for _, svc := range prog.Services {
+		if err = prog.resolveThrows(svc.Throws, "service "+svc.Name); err != nil {
+			return
+		}
+		for _, method := range svc.Methods {
+			if err = prog.resolveThrows(method.Throws, "method "+svc.Name+"."+method.Name); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (prog *YTProgram) resolveThrows(list []*YTThrows, tip string) error {
+	check := make(map[*YTEnumDef]*YTThrows, len(list))
+	for _, v := range list {
+		_, def, full := prog.lookupType(v.Name, nil)
+		if def == nil {
+			return NewErrorPos(v.DefPos, "%s throws [%s] must be enum", tip, v.Name)
+		}
+		if last, ok := check[def]; ok {
+			return NewErrorPos(v.DefPos, "%s throws [%s] repeated %s", tip, v.Name, last.DefPos.String())
+		}
+		check[def] = v
+		v.Enum, v.FullName = def, full
+	}
+	return nil
+}
+
+// 方法可能返回的错误枚举. 服务声明的在前, 重复的只保留一个
+func (method *YTMethod) throwsDesc(svc *YTService) (list []*buildpb.TypeDesc) {
+	exists := make(map[*YTEnumDef]struct{})
+	for _, throws := range [][]*YTThrows{svc.Throws, method.Throws} {
+		for _, v := range throws {
+			if _, ok := exists[v.Enum]; ok {
+				continue
+			}
+			exists[v.Enum] = struct{}{}
+			list = append(list, v.toDesc())
+		}
+	}
+	return
+}
+
+func (throws *YTThrows) toDesc() (desc *buildpb.TypeDesc) {
+	desc = &buildpb.TypeDesc{
+		Type:       buildpb.FieldType_CustomType,
+		Key:        throws.Name,
+		ElemCustom: true,
+	}
+	throws.fillDesc(desc)
+	return
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/walleframe/wctl/protocol/ast"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// 正在分析的文件
+type loadingFile struct {
+	full string
+	prog *ast.YTProgram
+	// 当前正在解析的import
+	imp *ast.YTImport
+}
+
+// ImportStep import链中的一步
+type ImportStep struct {
+	// 导入方文件
+	File string
+	// import 定义位置
+	Pos token.Pos
+	// 被导入文件
+	Import string
+}
+
+// ImportCycleError 循环导入错误. 包含完整的import链
+type ImportCycleError struct {
+	Chain []ImportStep
+}
+
+func (e *ImportCycleError) Error() string {
+	w := &strings.Builder{}
+	w.WriteString("import cycle not allowed: ")
+	for _, v := range e.Chain {
+		w.WriteString(v.File)
+		w.WriteString(" -> ")
+	}
+	if len(e.Chain) > 0 {
+		w.WriteString(e.Chain[0].File)
+	}
+	for _, v := range e.Chain {
+		fmt.Fprintf(w, "\n\t%s:%d:%d: import %q", v.File, v.Pos.Line, v.Pos.Column, v.Import)
+	}
+	return w.String()
+}
+
+// 查找正在分析的文件
+func (l *Loader) findLoading(full string) int {
+	for k, v := range l.loading {
+		if v.full == full {
+			return k
+		}
+	}
+	return -1
+}
+
+// 发现循环导入. 允许循环导入时返回尚未完成分析的文件(已完成本文件定义分析,可以引用类型)
+func (l *Loader) importCycle(idx int) (prog *ast.YTProgram, err error) {
+	if l.cc.AllowImportCycle {
+		return l.loading[idx].prog, nil
+	}
+	cycle := &ImportCycleError{}
+	for _, v := range l.loading[idx:] {
+		cycle.Chain = append(cycle.Chain, ImportStep{
+			File:   v.prog.File,
+			Pos:    v.imp.DefPos,
+			Import: v.imp.File,
+		})
+	}
+	return nil, cycle
+}
@@ -0,0 +1,162 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 锁定文件分段
+const (
+	lockSectionMethod  = "method"
+	lockSectionMessage = "message"
+)
+
+// 序号锁定文件. 保存已分配的接口序号及消息ID, 保证重新生成时不变
+type idLock struct {
+	file string
+	// 是否需要更新
+	dirty bool
+	// 接口序号 package.service.method => id
+	methods map[string]int64
+	// 消息ID package.message => id
+	messages map[string]int64
+}
+
+// 加载锁定文件. 未设置锁定文件时只在内存中记录
+func (l *Loader) loadIDLock() (err error) {
+	if l.lock != nil {
+		return
+	}
+	lock := &idLock{
+		methods:  make(map[string]int64),
+		messages: make(map[string]int64),
+	}
+	if l.cc.MethodIDLock != "" {
+		lock.file = l.cc.MethodIDLock
+		if !filepath.IsAbs(lock.file) {
+			lock.file = filepath.Join(l.cc.BasePath, lock.file)
+		}
+		data, err := os.ReadFile(lock.file)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("read id lock file failed. %w", err)
+		}
+		if err = lock.parse(data); err != nil {
+			return err
+		}
+	}
+	l.lock = lock
+	return
+}
+
+// 锁定文件格式: [method] [message] 分段, 每行 name = id, # 开头为注释. 未分段时为接口序号
+func (lock *idLock) parse(data []byte) error {
+	scan := bufio.NewScanner(bytes.NewReader(data))
+	section := lock.methods
+	line := 0
+	for scan.Scan() {
+		line++
+		text := strings.TrimSpace(scan.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			switch text[1 : len(text)-1] {
+			case lockSectionMethod:
+				section = lock.methods
+			case lockSectionMessage:
+				section = lock.messages
+			default:
+				return fmt.Errorf("%s:%d: invalid id lock section [%s]", lock.file, line, text)
+			}
+			continue
+		}
+		kv := strings.SplitN(text, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("%s:%d: invalid id lock line [%s]", lock.file, line, text)
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(kv[1]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s:%d: invalid id [%s]", lock.file, line, kv[1])
+		}
+		section[strings.TrimSpace(kv[0])] = id
+	}
+	return scan.Err()
+}
+
+// 查找使用该序号的其他名称
+func lockedBy(locked map[string]int64, id int64, name string) (names []string) {
+	for k, v := range locked {
+		if v == id && k != name {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return
+}
+
+func (lock *idLock) bytes() []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("# Generate by wctl. DO NOT EDIT.\n")
+	buf.WriteString("# id lock file. name = id\n")
+	for _, section := range []struct {
+		name   string
+		locked map[string]int64
+	}{
+		{lockSectionMethod, lock.methods},
+		{lockSectionMessage, lock.messages},
+	} {
+		if len(section.locked) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(section.locked))
+		for k := range section.locked {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(buf, "[%s]\n", section.name)
+		for _, k := range names {
+			fmt.Fprintf(buf, "%s = %d\n", k, section.locked[k])
+		}
+	}
+	return buf.Bytes()
+}
+
+// SaveMethodIDLock 保存序号锁定文件(接口序号及消息ID). 未设置锁定文件或没有变化时不写入
+func (l *Loader) SaveMethodIDLock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lock == nil || l.lock.file == "" || !l.lock.dirty {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(l.lock.file), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.lock.file, l.lock.bytes(), 0644); err != nil {
+		return err
+	}
+	l.lock.dirty = false
+	return nil
+}
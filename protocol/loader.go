@@ -0,0 +1,214 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/walleframe/wctl/protocol/ast"
+)
+
+//go:generate gogen option -n LoaderOption -o options.go
+func generateLoaderOptions() interface{} {
+	return map[string]interface{}{
+		// 基础目录. 所有输入将基于这个目录进行查找(默认当前运行目录)
+		"BasePath": "",
+		// 是否使用数值做请求ID
+		"ServiceUseMethodID": false,
+		// 文件系统. 设置后从该文件系统读取文件(忽略BasePath), 例如 embed.FS, zip.Reader
+		"FS": fs.FS(nil),
+		// 内存覆盖文件. 优先于磁盘(文件系统)上的同名文件, 也可以是不存在的虚拟文件
+		"Overlay": map[string][]byte(nil),
+		// 导入文件查找目录. 在BasePath之后按顺序查找
+		"ImportPaths": []string(nil),
+		// 是否允许循环导入. 循环导入的文件之间只能引用类型
+		"AllowImportCycle": false,
+		// 自动分配接口序号方式. 空:不自动分配, hash:使用 package.service.method 哈希值, seq:顺序分配
+		"MethodIDAlloc": "",
+		// 序号锁定文件(相对BasePath). 保存已分配的接口序号及消息ID,保证重新生成时不变
+		"MethodIDLock": "",
+		// 消息ID自动分配区间. 格式 package=min-max, 未设置消息ID的消息在所属包的区间内分配
+		"MsgIDRanges": []string(nil),
+		// 选项定义文件(yaml, 相对BasePath). 例如插件提供的选项定义
+		"OptionSchemaFiles": []string(nil),
+	}
+}
+
+// Loader 协议加载器. 持有独立的基础目录,解析器,分析参数及已分析文件缓存.
+// 多个Loader之间互不影响, 同一个Loader可以并发使用.
+type Loader struct {
+	mu      sync.Mutex
+	cc      *LoaderOptions
+	flag    *ast.AnalyseFlag
+	parsers map[string]Parser
+	overlay map[string][]byte
+	roots   []string
+	cache   *warehouse
+	// 正在分析的文件(import链)
+	loading []*loadingFile
+	// 分析过程中的警告信息
+	warnings []error
+	// 序号锁定文件
+	lock *idLock
+	// 接口序号分配
+	ids *methodIDs
+	// 消息ID注册表
+	msgIDs *msgIDs
+	// 选项定义文件是否已加载
+	schemaLoaded bool
+	// 解析import时的递归分析接口
+	analyser ast.RecursionAnalyser
+	// 初始化错误(获取运行目录失败). 分析时返回
+	err error
+}
+
+// NewLoader 新建协议加载器
+func NewLoader(opts ...LoaderOption) *Loader {
+	l := &Loader{
+		cc:      NewLoaderOptions(opts...),
+		parsers: make(map[string]Parser, len(builtinParsers)),
+		overlay: make(map[string][]byte),
+		cache:   newWarehouse(),
+	}
+	if l.cc.BasePath == "" {
+		path, err := os.Getwd()
+		if err != nil {
+			l.err = fmt.Errorf("获取运行目录出错.%w", err)
+		}
+		l.cc.BasePath = path
+	}
+	l.analyser = ast.RecursionAnalyseFunc(l.analysedImport)
+	l.flag = &ast.AnalyseFlag{
+		ServiceUseMethodID: l.cc.ServiceUseMethodID || l.cc.MethodIDAlloc != "",
+		AutoMethodID:       l.cc.MethodIDAlloc != "",
+	}
+	for suffix, parser := range builtinParsers {
+		l.parsers[suffix] = parser
+	}
+	for file, data := range l.cc.Overlay {
+		l.overlay[cleanPath(file)] = data
+	}
+	l.roots = l.searchRoots()
+	return l
+}
+
+// Warnings 获取分析过程中产生的警告信息
+func (l *Loader) Warnings() []error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]error(nil), l.warnings...)
+}
+
+func (l *Loader) warn(err error) {
+	l.warnings = append(l.warnings, err)
+}
+
+// SetOverlay 设置内存覆盖文件(例如编辑器中未保存的内容). 会清空已分析文件缓存
+func (l *Loader) SetOverlay(file string, data []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.overlay[cleanPath(file)] = data
+	l.resetCache()
+}
+
+// RemoveOverlay 移除内存覆盖文件. 会清空已分析文件缓存
+func (l *Loader) RemoveOverlay(file string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.overlay, cleanPath(file))
+	l.resetCache()
+}
+
+// 清空已分析文件缓存
+func (l *Loader) resetCache() {
+	l.cache = newWarehouse()
+	if l.ids != nil {
+		l.ids.used = make(map[int64]*idRef)
+		l.ids.pending = nil
+	}
+	if l.msgIDs != nil {
+		l.msgIDs.used = make(map[int64]*MessageID)
+		l.msgIDs.pending = nil
+	}
+}
+
+// 统一路径格式. 用于内存覆盖文件及文件系统查找
+func cleanPath(file string) string {
+	return path.Clean(filepath.ToSlash(file))
+}
+
+// RegisterParser 注册解析器(仅对当前加载器生效)
+func (l *Loader) RegisterParser(suffix string, parser Parser) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.parsers[suffix] = parser
+}
+
+// GetParser 获取解析器
+func (l *Loader) GetParser(suffix string) Parser {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.getParser(suffix)
+}
+
+func (l *Loader) getParser(suffix string) Parser {
+	if val, ok := l.parsers[suffix]; ok {
+		return val
+	}
+	return nil
+}
+
+// BasePath 基础目录
+func (l *Loader) BasePath() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cc.BasePath
+}
+
+// 解析import时获取已分析的依赖文件. 未分析的文件在解析后按import链分析(检测循环导入)
+func (l *Loader) analysedImport(file string) (*ast.YTProgram, error) {
+	if item, ok := l.cache.file[file]; ok {
+		return item.Ast, nil
+	}
+	return nil, nil
+}
+
+// 默认加载器. 兼容包级别接口
+var defaultLoader = NewLoader()
+
+// SetBasePath 设置默认加载器基础目录. 所有输入将基于这个目录进行查找.
+func SetBasePath(path string) {
+	defaultLoader.mu.Lock()
+	defer defaultLoader.mu.Unlock()
+	defaultLoader.cc.BasePath = path
+	defaultLoader.err = nil
+	defaultLoader.roots = defaultLoader.searchRoots()
+}
+
+// RegisterParser 注册默认加载器的解析器
+func RegisterParser(suffix string, parser Parser) {
+	defaultLoader.RegisterParser(suffix, parser)
+}
+
+// GetParser 获取默认加载器的解析器
+func GetParser(suffix string) Parser {
+	return defaultLoader.GetParser(suffix)
+}
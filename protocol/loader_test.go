@@ -0,0 +1,692 @@
+package protocol
+
+import (
+	"hash/fnv"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/walleframe/wctl/protocol/ast"
+)
+
+func writeFiles(t testing.TB, dir string, files map[string]string) {
+	for name, data := range files {
+		full := filepath.Join(dir, name)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(full), 0755), "mkdir")
+		assert.Nil(t, os.WriteFile(full, []byte(data), 0644), "write file")
+	}
+}
+
+func TestLoaderIsolation(t *testing.T) {
+	v1, v2 := t.TempDir(), t.TempDir()
+	writeFiles(t, v1, map[string]string{
+		"base.wproto": "package base\nmessage user { int64 uid = 1; }\n",
+		"api.wproto":  "package api\nimport \"base.wproto\"\nmessage login_rq { base.user u = 1; }\n",
+	})
+	writeFiles(t, v2, map[string]string{
+		"base.wproto": "package base\nmessage user { int64 uid = 1; string name = 2; }\n",
+		"api.wproto":  "package api\nimport \"base.wproto\"\nmessage login_rq { base.user u = 1; }\n",
+	})
+
+	l1 := NewLoader(WithBasePath(v1))
+	l2 := NewLoader(WithBasePath(v2))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			prog, err := l1.AnalyseFile("api.wproto")
+			if assert.Nil(t, err, "loader 1") {
+				assert.Len(t, prog.Imports[0].Prog.Messages[0].Fields, 1, "loader 1 base.user")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			prog, err := l2.AnalyseFile("api.wproto")
+			if assert.Nil(t, err, "loader 2") {
+				assert.Len(t, prog.Imports[0].Prog.Messages[0].Fields, 2, "loader 2 base.user")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 缓存: 同一加载器多次加载返回同一个结果
+	p1, _ := l1.AnalyseFile("base.wproto")
+	p2, _ := l1.AnalyseFile("base.wproto")
+	assert.Same(t, p1, p2, "cached program")
+}
+
+func TestLoaderMethodID(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"svc.wproto": "package svc\nmessage rq {}\nmessage rs {}\nservice s { call: f1(rq) rs = 1; f2(rq) rs = 2 }\n",
+	})
+
+	prog, err := NewLoader(WithBasePath(dir)).AnalyseFile("svc.wproto")
+	assert.Nil(t, err, "without method id")
+	assert.Nil(t, prog.Services[0].Methods[0].No, "method id ignored")
+
+	prog, err = NewLoader(WithBasePath(dir), WithServiceUseMethodID(true)).AnalyseFile("svc.wproto")
+	assert.Nil(t, err, "with method id")
+	assert.EqualValues(t, 2, *prog.Services[0].Methods[1].No.Value, "method id")
+}
+
+func TestLoaderFSAndOverlay(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proto/base.wproto": {Data: []byte("package base\nmessage user { int64 uid = 1; }\n")},
+		"proto/api.wproto":  {Data: []byte("package api\nimport \"proto/base.wproto\"\nmessage login_rq { base.user u = 1; }\n")},
+	}
+	l := NewLoader(WithFS(fsys), WithOverlay(map[string][]byte{
+		// 覆盖文件系统中的文件
+		"proto/base.wproto": []byte("package base\nmessage user { int64 uid = 1; string name = 2; }\n"),
+		// 虚拟文件
+		"proto/virtual.wproto": []byte("package virtual\nmessage v {}\n"),
+	}))
+
+	progs, err := l.AnalysePath("proto", ".wproto")
+	assert.Nil(t, err, "analyse fs path")
+	assert.Len(t, progs, 3, "fs files and virtual file")
+
+	prog, err := l.AnalyseFile("proto/api.wproto")
+	assert.Nil(t, err, "analyse fs file")
+	assert.Len(t, prog.Imports[0].Prog.Messages[0].Fields, 2, "overlay shadow fs file")
+
+	// 移除覆盖文件后重新读取文件系统
+	l.RemoveOverlay("proto/base.wproto")
+	prog, err = l.AnalyseFile("proto/api.wproto")
+	assert.Nil(t, err, "analyse fs file")
+	assert.Len(t, prog.Imports[0].Prog.Messages[0].Fields, 1, "fs file")
+
+	// 未保存的编辑内容
+	l.SetOverlay("proto/api.wproto", []byte("package api\nmessage login_rq { int32 broken = 1 "))
+	_, err = l.AnalyseFile("proto/api.wproto")
+	assert.NotNil(t, err, "overlay parse error")
+}
+
+func TestLoaderImportPaths(t *testing.T) {
+	base, inc1, inc2 := t.TempDir(), t.TempDir(), t.TempDir()
+	writeFiles(t, base, map[string]string{
+		"api/api.wproto": "package api\nimport \"common/base.wproto\"\nimport \"third.wproto\"\nmessage login_rq { base.user u = 1; third.t v = 2; }\n",
+	})
+	writeFiles(t, inc1, map[string]string{
+		"common/base.wproto": "package base\nmessage user { int64 uid = 1; }\n",
+	})
+	writeFiles(t, inc2, map[string]string{
+		"common/base.wproto": "package base\nmessage user { int64 uid = 1; string name = 2; }\n",
+		"third.wproto":       "package third\nmessage t {}\n",
+	})
+
+	// 未设置查找目录
+	_, err := NewLoader(WithBasePath(base)).AnalyseFile("api/api.wproto")
+	assert.NotNil(t, err, "import not found")
+
+	l := NewLoader(WithBasePath(base), WithImportPaths(inc1, inc2))
+	prog, err := l.AnalyseFile("api/api.wproto")
+	if !assert.Nil(t, err, "analyse with import paths") {
+		return
+	}
+	// 按顺序查找, 使用第一个
+	assert.Len(t, prog.Imports[0].Prog.Messages[0].Fields, 1, "first import path")
+	// 文件路径基于所在查找目录
+	assert.Equal(t, "common/base.wproto", prog.Imports[0].Prog.File, "root relative file")
+	assert.Equal(t, "third.wproto", prog.Imports[1].Prog.File, "root relative file")
+	// 重复文件警告
+	assert.Len(t, l.Warnings(), 1, "duplicate file warning")
+
+	// 绝对路径输入
+	prog, err = NewLoader(WithBasePath(base), WithImportPaths(inc2)).AnalyseFile(filepath.Join(inc2, "common/base.wproto"))
+	assert.Nil(t, err, "absolute path")
+	assert.Equal(t, "common/base.wproto", prog.File, "absolute path relative to import path")
+}
+
+func TestLoaderImportCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.wproto": "package a\nimport \"b.wproto\"\nmessage ma { b.mb v = 1; }\n",
+		"b.wproto": "package b\nimport \"c.wproto\"\nmessage mb { c.mc v = 1; }\n",
+		"c.wproto": "package c\n\nimport \"a.wproto\"\nmessage mc { a.ma v = 1; }\n",
+	})
+
+	_, err := NewLoader(WithBasePath(dir)).AnalyseFile("a.wproto")
+	var cycle *ImportCycleError
+	if assert.ErrorAs(t, err, &cycle, "import cycle") {
+		assert.Equal(t, []string{"a.wproto", "b.wproto", "c.wproto"}, []string{
+			cycle.Chain[0].File, cycle.Chain[1].File, cycle.Chain[2].File,
+		}, "cycle chain")
+		assert.Equal(t, 3, cycle.Chain[2].Pos.Line, "import position")
+		assert.Contains(t, err.Error(), "a.wproto -> b.wproto -> c.wproto -> a.wproto", "error message")
+	}
+
+	// 允许循环导入
+	prog, err := NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("a.wproto")
+	if assert.Nil(t, err, "allow import cycle") {
+		mc := prog.Imports[0].Prog.Imports[0].Prog
+		assert.Same(t, prog.Messages[0], mc.Messages[0].Fields[0].Type.YTCustomType.Msg, "cycle type reference")
+	}
+}
+
+func TestLoaderImportCycleReference(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.wproto": "package a\nimport \"b.wproto\"\nconst ids int32 { one = 1 }\nmessage ma { int32 v = 1 }\n",
+		"b.wproto": "package b\nimport \"a.wproto\"\nmessage mb { a.ma v = 1 }\nenum e {\n\tv = a.ids.one\n}\n",
+		"c.wproto": "package c\nimport \"d.wproto\"\nmessage mc { int32 v = 1 }\n",
+		"d.wproto": "package d\nimport \"c.wproto\"\nmessage md {\n\tinclude c.mc = 10\n}\n",
+	})
+
+	// 循环导入中未完成分析的文件只能引用类型
+	_, err := NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("a.wproto")
+	if assert.NotNil(t, err, "cycle const reference") {
+		assert.Contains(t, err.Error(), "b.wproto:5:", "const reference position")
+		assert.Contains(t, err.Error(), "import cycle", "const reference error")
+	}
+	_, err = NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("c.wproto")
+	if assert.NotNil(t, err, "cycle include") {
+		assert.Contains(t, err.Error(), "d.wproto:4:", "include position")
+		assert.Contains(t, err.Error(), "import cycle", "include error")
+	}
+
+	// 从另一端进入时依赖文件已完成分析
+	_, err = NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("b.wproto")
+	assert.Nil(t, err, "analysed cycle file const reference")
+}
+
+func TestLoaderConst(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"ids.wproto": "package common\nconst MsgID int32 {\n\tLogin = 1001\n\tLogout = 0x3ea\n}\nconst Names string { Server = \"game\" }\n",
+		"svc.wproto": `package svc
+import "ids.wproto"
+const Local uint8 { Base = 10; }
+svc.server = common.Names.Server
+enum code {
+	ok = 0
+	first = Local.Base
+}
+message rq {}
+message rs {}
+service s {
+	svc.timeout = Local.Base
+	call:
+	login(rq) rs = common.MsgID.Login
+	logout(rq) rs = common.MsgID.Logout
+}
+`,
+		"overflow.wproto":  "package bad\nconst C uint8 { V = 256 }\n",
+		"string_id.wproto": "package bad\nconst C string { V = \"x\" }\nmessage rq {}\nservice s { f(rq) rq = C.V }\n",
+		"not_found.wproto": "package bad\nenum e { v = C.V }\n",
+		"repeat_id.wproto": "package bad\nconst C int32 { V = 1 }\nmessage rq {}\nservice s { f1(rq) rq = C.V; f2(rq) rq = 1 }\n",
+	})
+
+	l := NewLoader(WithBasePath(dir), WithServiceUseMethodID(true))
+	prog, err := l.AnalyseFile("svc.wproto")
+	if !assert.Nil(t, err, "analyse const") {
+		return
+	}
+	assert.EqualValues(t, 1001, *prog.Services[0].Methods[0].No.Value, "method id from imported const")
+	assert.EqualValues(t, 1002, *prog.Services[0].Methods[1].No.Value, "hex const")
+	assert.EqualValues(t, 10, prog.EnumDefs[0].Values[1].Value, "enum value from const")
+	assert.Equal(t, "game", prog.GetOptionString("svc.server"), "string option from const")
+	assert.EqualValues(t, 10, *prog.Services[0].GetOptionValue("svc.timeout").IntVal, "int option from const")
+
+	desc := prog.GetFileDesc()
+	assert.Equal(t, "common.MsgID.Login", desc.Services[0].Methods[0].MethodMacro, "method macro desc")
+	assert.EqualValues(t, 1001, desc.Services[0].Methods[0].MethodID, "method id desc")
+	assert.Equal(t, "Local.Base", desc.Enums[0].Values[1].Macro, "enum macro desc")
+	assert.Equal(t, "common.Names.Server", desc.Options.Options["svc.server"].Macro, "option macro desc")
+	assert.Equal(t, "Base", desc.Consts[0].Values[0].Name, "const desc")
+
+	for _, file := range []string{"overflow.wproto", "string_id.wproto", "not_found.wproto", "repeat_id.wproto"} {
+		_, err = l.AnalyseFile(file)
+		assert.NotNil(t, err, file)
+	}
+}
+
+func TestLoaderAutoMethodID(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.wproto": "package a\nmessage rq {}\nservice s { f1(rq) rq; f2(rq) rq = 5 }\n",
+		"b.wproto": "package b\nimport \"a.wproto\"\nservice s { f1(a.rq) a.rq; f2(a.rq) a.rq }\n",
+		"c.wproto": "package c\nmessage rq {}\nservice s { f(rq) rq = 5 }\n",
+	})
+
+	// 未开启自动分配
+	_, err := NewLoader(WithBasePath(dir), WithServiceUseMethodID(true)).AnalyseFile("a.wproto")
+	assert.NotNil(t, err, "method id not set")
+
+	// 顺序分配
+	l := NewLoader(WithBasePath(dir), WithMethodIDAlloc(MethodIDAllocSeq), WithMethodIDLock("method.lock"))
+	prog, err := l.AnalyseFile("b.wproto")
+	if !assert.Nil(t, err, "seq alloc") {
+		return
+	}
+	a := prog.Imports[0].Prog
+	assert.EqualValues(t, 6, *a.Services[0].Methods[0].No.Value, "seq id after explicit id")
+	assert.True(t, a.Services[0].Methods[0].No.Auto, "auto id")
+	assert.EqualValues(t, 5, *a.Services[0].Methods[1].No.Value, "explicit id")
+	assert.EqualValues(t, 7, *prog.Services[0].Methods[0].No.Value, "seq id")
+	assert.EqualValues(t, 8, *prog.Services[0].Methods[1].No.Value, "seq id")
+	// 全局唯一
+	_, err = l.AnalyseFile("c.wproto")
+	assert.NotNil(t, err, "global repeated method id")
+	assert.Nil(t, l.SaveMethodIDLock(), "save lock")
+
+	// 锁定文件保证序号不变
+	writeFiles(t, dir, map[string]string{
+		"b.wproto": "package b\nimport \"a.wproto\"\nservice s { f0(a.rq) a.rq; f2(a.rq) a.rq; f1(a.rq) a.rq }\n",
+	})
+	l = NewLoader(WithBasePath(dir), WithMethodIDAlloc(MethodIDAllocSeq), WithMethodIDLock("method.lock"))
+	prog, err = l.AnalyseFile("b.wproto")
+	if assert.Nil(t, err, "locked alloc") {
+		assert.EqualValues(t, 9, *prog.Services[0].Methods[0].No.Value, "new method id")
+		assert.EqualValues(t, 8, *prog.Services[0].Methods[1].No.Value, "locked id")
+		assert.EqualValues(t, 7, *prog.Services[0].Methods[2].No.Value, "locked id")
+	}
+
+	// 显式序号定义在自动分配的接口之后
+	writeFiles(t, dir, map[string]string{
+		"d.wproto": "package d\nmessage rq {}\nservice s { f1(rq) rq; f2(rq) rq = 1 }\n",
+		"e.wproto": "package e\nimport \"d.wproto\"\nservice s { f(d.rq) d.rq = 2 }\n",
+		"f.wproto": "package f\nimport \"d.wproto\"\nservice s { f(d.rq) d.rq = 3 }\n",
+	})
+	l = NewLoader(WithBasePath(dir), WithMethodIDAlloc(MethodIDAllocSeq))
+	prog, err = l.AnalyseFile("e.wproto")
+	if assert.Nil(t, err, "explicit id after auto method") {
+		d := prog.Imports[0].Prog
+		assert.EqualValues(t, 3, *d.Services[0].Methods[0].No.Value, "auto id allocated after explicit ids")
+		assert.EqualValues(t, 1, *d.Services[0].Methods[1].No.Value, "explicit id")
+		// 后续分析的文件占用自动分配的序号时重新分配
+		_, err = l.AnalyseFile("f.wproto")
+		assert.Nil(t, err, "explicit id replace auto id")
+		assert.EqualValues(t, 4, *d.Services[0].Methods[0].No.Value, "auto id reallocated")
+	}
+
+	// 哈希分配
+	prog, err = NewLoader(WithBasePath(dir), WithMethodIDAlloc(MethodIDAllocHash)).AnalyseFile("a.wproto")
+	if assert.Nil(t, err, "hash alloc") {
+		h := fnv.New32a()
+		h.Write([]byte("a.s.f1"))
+		assert.EqualValues(t, h.Sum32()&math.MaxInt32, *prog.Services[0].Methods[0].No.Value, "hash id")
+	}
+
+	_, err = NewLoader(WithBasePath(dir), WithMethodIDAlloc("x")).AnalyseFile("a.wproto")
+	assert.NotNil(t, err, "invalid alloc mode")
+}
+
+func TestLoaderMsgID(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"ids.wproto":    "package common\nconst MsgID int32 { Logout = 1002 }\n",
+		"login.wproto":  "package login\nimport \"ids.wproto\"\nmessage login_rq = 1001 {}\nmessage logout_rq = common.MsgID.Logout { message inner = 1003 {} }\n",
+		"game.wproto":   "package game\nmessage enter_rq {}\nmessage leave_rq = 2005 {}\nmessage move_rq {}\n",
+		"repeat.wproto": "package repeat\nmessage rq = 1001 {}\n",
+	})
+
+	l := NewLoader(WithBasePath(dir), WithMsgIDRanges("game=2000-2999"), WithMethodIDLock("id.lock"))
+	prog, err := l.AnalyseFile("login.wproto")
+	if !assert.Nil(t, err, "explicit message id") {
+		return
+	}
+	assert.EqualValues(t, 1001, *prog.Messages[0].ID.Value, "message id")
+	assert.EqualValues(t, 1002, *prog.Messages[1].ID.Value, "message id from const")
+	desc := prog.GetFileDesc()
+	assert.EqualValues(t, 1002, desc.Msgs[1].MsgID, "message id desc")
+	assert.Equal(t, "common.MsgID.Logout", desc.Msgs[1].MsgMacro, "message macro desc")
+	assert.EqualValues(t, 1003, desc.Msgs[1].SubMsgs[0].MsgID, "sub message id desc")
+
+	// 按包区间分配
+	prog, err = l.AnalyseFile("game.wproto")
+	if assert.Nil(t, err, "range message id") {
+		assert.EqualValues(t, 2000, *prog.Messages[0].ID.Value, "range id")
+		assert.True(t, prog.Messages[0].ID.Auto, "auto id")
+		assert.EqualValues(t, 2001, *prog.Messages[2].ID.Value, "range id")
+	}
+	// 全局唯一
+	_, err = l.AnalyseFile("repeat.wproto")
+	assert.NotNil(t, err, "global repeated message id")
+
+	list := l.MessageIDs()
+	if assert.Len(t, list, 6, "registry") {
+		assert.Equal(t, "logout_rq.inner", list[2].Name, "sub message name")
+		assert.Equal(t, "game", list[3].Package, "sorted by id")
+	}
+	assert.Nil(t, l.SaveMethodIDLock(), "save lock")
+
+	// 锁定文件保证消息ID不变
+	writeFiles(t, dir, map[string]string{
+		"game.wproto": "package game\nmessage new_rq {}\nmessage move_rq {}\nmessage enter_rq {}\n",
+	})
+	prog, err = NewLoader(WithBasePath(dir), WithMsgIDRanges("game=2000-2999"), WithMethodIDLock("id.lock")).AnalyseFile("game.wproto")
+	if assert.Nil(t, err, "locked message id") {
+		assert.EqualValues(t, 2002, *prog.Messages[0].ID.Value, "new message id")
+		assert.EqualValues(t, 2001, *prog.Messages[1].ID.Value, "locked id")
+		assert.EqualValues(t, 2000, *prog.Messages[2].ID.Value, "locked id")
+	}
+
+	_, err = NewLoader(WithBasePath(dir), WithMsgIDRanges("a=1-10", "b=5-20")).AnalyseFile("game.wproto")
+	assert.NotNil(t, err, "range overlap")
+
+	// 显式ID定义在自动分配的消息之后
+	writeFiles(t, dir, map[string]string{
+		"shop.wproto": "package shop\nmessage buy_rq {}\nmessage sell_rq = 3000 {}\n",
+		"bag.wproto":  "package bag\nimport \"shop.wproto\"\nmessage use_rq = 3001 {}\n",
+	})
+	l = NewLoader(WithBasePath(dir), WithMsgIDRanges("shop=3000-3999"))
+	prog, err = l.AnalyseFile("bag.wproto")
+	if assert.Nil(t, err, "explicit id after auto message") {
+		shop := prog.Imports[0].Prog
+		assert.EqualValues(t, 3002, *shop.Messages[0].ID.Value, "auto id allocated after explicit ids")
+		assert.EqualValues(t, 3000, *shop.Messages[1].ID.Value, "explicit id")
+		assert.EqualValues(t, 3002, l.MessageIDs()[2].ID, "registry auto id")
+	}
+}
+
+func TestLoaderOptionSchema(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"plugin.yaml": `namespace: plug
+doc: 插件选项
+options:
+  - key: enable
+    type: bool
+    scope: [file, message]
+    default: false
+    doc: 是否开启
+  - key: limit
+    type: object
+    scope: [method]
+    default: {max: 10, rate: 0.5}
+  - key: tag
+    type: string
+    default: x
+`,
+		"ok.wproto":    "package ok\nplug.enable = true\nmessage rq {}\nmessage rs { plug.enable = true }\nservice s { f(rq) rs }\nmessage other {}\n",
+		"scope.wproto": "package scope\nmessage rq { plug.enable = true; int32 a = 1 { plug.enable = true } }\n",
+		"key.wproto":   "package key\nplug.enabled = true\n",
+		"bad.yaml":     "namespace: bad\noptions:\n  - key: a\n    type: int\n    default: x\n",
+	})
+
+	l := NewLoader(WithBasePath(dir), WithOptionSchemaFiles("plugin.yaml"))
+	prog, err := l.AnalyseFile("ok.wproto")
+	if !assert.Nil(t, err, "yaml option schema") {
+		return
+	}
+	// 默认值
+	assert.True(t, prog.GetOptionBool("plug.enable"), "file option not replaced by default")
+	if assert.NotNil(t, prog.Messages[0].GetOptionValue("plug.enable"), "message default") {
+		assert.Equal(t, ast.OptionValueBool, prog.Messages[0].GetOptionValue("plug.enable").Kind, "default kind")
+		assert.False(t, prog.Messages[0].GetOptionBool("plug.enable"), "message default value")
+	}
+	assert.True(t, prog.Messages[1].GetOptionBool("plug.enable"), "message option not replaced by default")
+	assert.EqualValues(t, 10, *prog.Services[0].Methods[0].GetOptionValue("plug.limit").Field("max").IntVal, "method default")
+	assert.Nil(t, prog.Services[0].GetOptionValue("plug.limit"), "default out of scope")
+	assert.Nil(t, prog.GetOptionValue("plug.tag"), "unscoped default")
+	assert.Nil(t, prog.Messages[0].GetOptionValue("plug.tag"), "unscoped default")
+	assert.NotSame(t, prog.Messages[0].GetOptionValue("plug.enable"), prog.Messages[2].GetOptionValue("plug.enable"), "default value copied")
+	desc := prog.GetFileDesc()
+	assert.False(t, desc.Msgs[0].Options.Options["plug.enable"].BoolValue, "default desc")
+	assert.NotNil(t, desc.Services[0].Methods[0].Options.Options["plug.limit"], "method default desc")
+	if assert.Len(t, l.flag.OptionSchemas, 1, "schema loaded") {
+		item := l.flag.OptionSchemas[0].Lookup("limit")
+		assert.Equal(t, ast.ScopeMethod, item.Scope, "scope")
+		assert.Equal(t, 0.5, *item.Default.Field("rate").FloatVal, "object default")
+		assert.Equal(t, 9, item.DefPos.Line, "position")
+	}
+	// 选项覆盖按定义的类型转换
+	if assert.Nil(t, prog.ApplyCmdOptions("plug.tag=123", "message/rq:plug.enable=false"), "override") {
+		assert.Equal(t, "123", prog.GetOptionString("plug.tag"), "override string value")
+		assert.Equal(t, ast.OptionValueString, prog.GetOptionValue("plug.tag").Kind, "override string kind")
+		assert.False(t, prog.Messages[0].GetOptionBool("plug.enable"), "override bool value")
+	}
+	err = prog.ApplyCmdOptions("plug.enable=yes")
+	if assert.NotNil(t, err, "override type") {
+		assert.Contains(t, err.Error(), "is not bool", "override type")
+	}
+	_, err = l.AnalyseFile("scope.wproto")
+	if assert.NotNil(t, err, "wrong scope") {
+		assert.Contains(t, err.Error(), "can not use in field", "wrong scope")
+	}
+	_, err = l.AnalyseFile("key.wproto")
+	if assert.NotNil(t, err, "unknown key") {
+		assert.Contains(t, err.Error(), "did you mean [plug.enable]", "unknown key")
+	}
+
+	_, err = NewLoader(WithBasePath(dir), WithOptionSchemaFiles("bad.yaml")).AnalyseFile("ok.wproto")
+	if assert.NotNil(t, err, "default type") {
+		assert.Contains(t, err.Error(), "bad.yaml:3", "error position")
+	}
+}
+
+func TestLoaderTypeAlias(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\ntype player_id = int64\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\ntype owner_id = common.player_id\nmessage rq { common.player_id id = 1; map<common.player_id, owner_id> v = 2 }\n",
+		"bad.wproto":    "package bad\nimport \"common.wproto\"\nmessage rq { common.item_id id = 1 }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "imported alias") {
+		return
+	}
+	assert.Equal(t, ast.BaseTypeInt64, prog.Aliases[0].Base, "alias of imported alias")
+	fields := prog.Messages[0].Fields
+	assert.Equal(t, ast.BaseTypeInt64, fields[0].Type.YTBaseType, "imported alias field")
+	typ := prog.GetFileDesc().Msgs[0].Fields[1].Type
+	assert.Equal(t, "common", typ.KeyAlias.Package, "alias package")
+	assert.Equal(t, "owner_id", typ.ValueAlias.Name, "alias name")
+
+	_, err = l.AnalyseFile("bad.wproto")
+	assert.NotNil(t, err, "unknown imported type")
+}
+
+func TestLoaderNestedType(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\nmessage item { message attr { int32 v = 1 } attr a = 1 }\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq { common.item.attr a = 1; []common.item.attr l = 2 }\n",
+		"bad.wproto":    "package bad\nimport \"common.wproto\"\nmessage rq { common.item.none a = 1 }\n",
+		"same.wproto":   "package same\nmessage a { message item { int32 v = 1 } item i = 1 }\nmessage b { message item { string s = 1 } item i = 1 }\n",
+		"repeat.wproto": "package repeat\nmessage a { message item {} message item {} }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "imported nested message") {
+		return
+	}
+	fields := prog.Messages[0].Fields
+	assert.Equal(t, "common.item.attr", fields[0].Type.FullName, "full name with package")
+	assert.Equal(t, "attr", fields[0].Type.Msg.Name, "imported nested message")
+	assert.Equal(t, "common.item.attr", prog.GetFileDesc().Msgs[0].Fields[1].Type.FullName, "list full name")
+
+	_, err = l.AnalyseFile("bad.wproto")
+	assert.NotNil(t, err, "unknown nested type")
+
+	// 不同消息内的同名嵌套消息
+	prog, err = l.AnalyseFile("same.wproto")
+	if assert.Nil(t, err, "same nested name in different message") {
+		assert.Same(t, prog.Messages[0].SubMsgs[0], prog.Messages[0].Fields[0].Type.Msg, "a.item")
+		assert.Same(t, prog.Messages[1].SubMsgs[0], prog.Messages[1].Fields[0].Type.Msg, "b.item")
+	}
+	_, err = l.AnalyseFile("repeat.wproto")
+	if assert.NotNil(t, err, "nested message repeated") {
+		assert.Contains(t, err.Error(), "message define name repeated [a.item]", "nested message repeated")
+	}
+}
+
+func TestLoaderImportEnum(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\nenum color { red = 1 }\nmessage item { enum kind { none = 0 } }\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq { common.color c = 1; []common.item.kind l = 2; map<int32, common.color> m = 3 }\n",
+		"bad.wproto":    "package bad\nimport \"common.wproto\"\nmessage rq { common.shape s = 1 }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "imported enum") {
+		return
+	}
+	fields := prog.Messages[0].Fields
+	assert.Equal(t, "color", fields[0].Type.Enum.Name, "imported enum")
+	assert.Nil(t, fields[0].Type.Msg, "enum is not message")
+	assert.Equal(t, "common.item.kind", fields[1].Type.YTListType.FullName, "imported nested enum")
+
+	desc := prog.GetFileDesc().Msgs[0].Fields
+	for k, field := range desc {
+		assert.True(t, field.Type.ElemEnum, "enum type desc %d", k)
+		assert.Nil(t, field.Type.Msg, "enum type desc %d", k)
+	}
+	assert.Equal(t, "common.color", desc[0].Type.FullName, "enum full name")
+	assert.EqualValues(t, 1, desc[0].Type.Enum.Values[0].Value, "enum desc")
+	assert.Equal(t, "kind", desc[1].Type.Enum.Name, "nested enum desc")
+	assert.Equal(t, "color", desc[2].Type.Enum.Name, "map value enum desc")
+
+	_, err = l.AnalyseFile("bad.wproto")
+	assert.NotNil(t, err, "unknown imported enum")
+}
+
+func TestLoaderMethodThrows(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\nenum err { ok = 0; busy = 1 }\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq {}\nservice svc { f(rq) rq throws common.err }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "imported throws") {
+		return
+	}
+	throws := prog.GetFileDesc().Services[0].Methods[0].Throws
+	if assert.Len(t, throws, 1, "throws desc") {
+		assert.Equal(t, "common.err", throws[0].FullName, "throws full name")
+		assert.Len(t, throws[0].Enum.Values, 2, "throws enum values")
+	}
+}
+
+func TestLoaderInclude(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\nenum zone { cn = 0 }\nmessage base { int64 uid = 1 }\nmessage header { include base; zone z = 2; map<int32, zone> m = 3 }\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq { include common.header = 100; int32 a = 1 }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "imported include %v", err) {
+		return
+	}
+	fields := prog.Messages[0].Fields
+	if !assert.Len(t, fields, 4, "include fields") {
+		return
+	}
+	assert.EqualValues(t, 101, fields[0].No, "transitive include offset")
+	assert.Equal(t, "common.zone", fields[1].Type.FullName, "imported field type")
+	assert.Equal(t, "common.zone", fields[2].Type.YTMapTypee.Value.FullName, "imported map value type")
+	// 来源消息不变
+	header := prog.Imports[0].Prog.Messages[1]
+	assert.Equal(t, "zone", header.Fields[1].Type.FullName, "source field type")
+	assert.EqualValues(t, 1, header.Fields[0].No, "source field no")
+
+	desc := prog.GetFileDesc().Msgs[0].Fields
+	assert.Equal(t, "common.header", desc[0].Embed.FullName, "embed desc")
+	assert.Equal(t, "header", desc[0].Embed.Msg.Name, "embed msg desc")
+	assert.EqualValues(t, 100, desc[0].EmbedOffset, "embed offset")
+	assert.Nil(t, desc[3].Embed, "own field")
+	assert.Equal(t, "common.zone", desc[1].Type.Key, "imported field type key")
+	assert.Equal(t, "common.zone", desc[2].Type.Value, "imported map value type key")
+	assert.Equal(t, "zone", prog.Imports[0].Prog.GetFileDesc().Msgs[1].Fields[1].Type.Key, "source field type key")
+}
+
+func TestLoaderIncludeImportCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.wproto": "package a\nimport \"b.wproto\"\nmessage other { int32 o = 1 }\nmessage base {\n\tinclude other\n\tint32 v = 2\n}\n",
+		"b.wproto": "package b\nimport \"a.wproto\"\nmessage y {\n\tinclude a.base\n}\n",
+	})
+	// a 未完成分析时 b 嵌入 a 的消息
+	_, err := NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("a.wproto")
+	if assert.NotNil(t, err, "include from unfinished cycle file") {
+		assert.Contains(t, err.Error(), "b.wproto:4:", "include position")
+	}
+	// a 已完成分析
+	prog, err := NewLoader(WithBasePath(dir), WithAllowImportCycle(true)).AnalyseFile("b.wproto")
+	if assert.Nil(t, err, "include from analysed cycle file") {
+		fields := prog.Messages[0].Fields
+		if assert.Len(t, fields, 2, "include fields") {
+			assert.Equal(t, "o", fields[0].Name, "transitive include field")
+			assert.Equal(t, "v", fields[1].Name, "include field")
+		}
+	}
+}
+
+func TestLoaderFilterTarget(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\nmessage user { int64 uid = 1; @server string ip = 2 }\n@server message load { int32 v = 1 }\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq { common.user u = 1 }\n",
+		"bad.wproto":    "package bad\nimport \"common.wproto\"\nmessage rq { common.load l = 1 }\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	prog, err := l.AnalyseFile("game.wproto")
+	if !assert.Nil(t, err, "analyse") {
+		return
+	}
+	if !assert.Nil(t, prog.FilterTarget("client"), "filter client") {
+		return
+	}
+	common := prog.Imports[0].Prog
+	assert.Equal(t, "client", common.Target(), "import filtered")
+	assert.Len(t, common.Messages, 1, "import messages")
+	assert.Len(t, common.Messages[0].Fields, 1, "import fields")
+	// 已裁剪的文件不重复裁剪
+	assert.Nil(t, common.FilterTarget("client"), "filter again")
+
+	prog, err = NewLoader(WithBasePath(dir)).AnalyseFile("bad.wproto")
+	if assert.Nil(t, err, "analyse") {
+		assert.NotNil(t, prog.FilterTarget("client"), "reference pruned imported message")
+	}
+}
+
+func TestLoaderDeprecatedWarning(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"common.wproto": "package common\n// @deprecated use user2\nmessage user {\nint64 uid = 1\n}\n",
+		"game.wproto":   "package game\nimport \"common.wproto\"\nmessage rq { common.user u = 1 }\n",
+		"old.wproto":    "package old\nimport \"common.wproto\"\nmessage rq {\n// @deprecated\ncommon.user u = 1\n}\n",
+	})
+	l := NewLoader(WithBasePath(dir))
+	_, err := l.AnalyseFile("game.wproto")
+	if assert.Nil(t, err, "analyse") && assert.Len(t, l.Warnings(), 1, "warn reference deprecated") {
+		assert.Contains(t, l.Warnings()[0].Error(), "use user2", "warn reason")
+	}
+
+	l = NewLoader(WithBasePath(dir))
+	_, err = l.AnalyseFile("old.wproto")
+	if assert.Nil(t, err, "analyse") {
+		assert.Empty(t, l.Warnings(), "deprecated field reference deprecated message")
+	}
+}
+
+func TestLoaderGetwdError(t *testing.T) {
+	wd, err := os.Getwd()
+	if !assert.Nil(t, err, "getwd") {
+		return
+	}
+	dir, err := os.MkdirTemp("", "wctl")
+	if !assert.Nil(t, err, "temp dir") {
+		return
+	}
+	defer os.Chdir(wd)
+	assert.Nil(t, os.Chdir(dir), "chdir")
+	assert.Nil(t, os.Remove(dir), "remove work dir")
+	// 获取运行目录失败时不panic, 分析时返回错误
+	l := NewLoader()
+	_, err = l.AnalyseFile("a.wproto")
+	assert.NotNil(t, err, "analyse file")
+	_, err = l.AnalysePath(".", ".wproto")
+	assert.NotNil(t, err, "analyse path")
+}
@@ -0,0 +1,176 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/walleframe/wctl/protocol/ast"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// 接口序号分配方式
+const (
+	// MethodIDAllocHash 使用 package.service.method 哈希值
+	MethodIDAllocHash = "hash"
+	// MethodIDAllocSeq 顺序分配
+	MethodIDAllocSeq = "seq"
+)
+
+// 已使用的序号
+type idRef struct {
+	name string
+	pos  token.Pos
+	// 自动分配序号的接口. 序号被显式设置的接口占用时重新分配
+	method *ast.YTMethod
+}
+
+// 接口序号分配. 保证加载器内所有服务的接口序号全局唯一
+type methodIDs struct {
+	mode string
+	// 已分析文件使用的接口序号
+	used map[int64]*idRef
+	// 等待自动分配序号的接口. 显式及锁定序号全部登记后再分配
+	pending []*idRef
+}
+
+func (l *Loader) loadMethodIDs() (err error) {
+	if l.ids != nil || l.cc.MethodIDAlloc == "" {
+		return
+	}
+	switch l.cc.MethodIDAlloc {
+	case MethodIDAllocHash, MethodIDAllocSeq:
+	default:
+		return fmt.Errorf("invalid method id alloc mode [%s], support [%s,%s]", l.cc.MethodIDAlloc, MethodIDAllocHash, MethodIDAllocSeq)
+	}
+	if err = l.loadIDLock(); err != nil {
+		return
+	}
+	l.ids = &methodIDs{
+		mode: l.cc.MethodIDAlloc,
+		used: make(map[int64]*idRef),
+	}
+	return
+}
+
+// 检查接口序号是否可用(未被其他接口使用)
+func (ids *methodIDs) free(locked map[string]int64, id int64, name string) bool {
+	if ref, ok := ids.used[id]; ok && ref.name != name {
+		return false
+	}
+	return len(lockedBy(locked, id, name)) == 0
+}
+
+// 分配新的接口序号
+func (ids *methodIDs) alloc(locked map[string]int64, name string) (id int64) {
+	switch ids.mode {
+	case MethodIDAllocHash:
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		id = int64(h.Sum32() & math.MaxInt32)
+		// 哈希冲突顺延
+		for id == 0 || !ids.free(locked, id, name) {
+			id = (id + 1) & math.MaxInt32
+		}
+	default:
+		for _, v := range locked {
+			if v > id {
+				id = v
+			}
+		}
+		for v := range ids.used {
+			if v > id {
+				id = v
+			}
+		}
+		id++
+	}
+	return
+}
+
+// 登记并检查文件内显式设置及锁定的接口序号. 未设置序号的接口等待分析完成后统一分配
+func (l *Loader) assignMethodIDs(prog *ast.YTProgram) (err error) {
+	if err = l.loadMethodIDs(); err != nil || l.ids == nil {
+		return
+	}
+	ids, lock := l.ids, l.lock
+	for _, svc := range prog.Services {
+		for _, method := range svc.Methods {
+			name := prog.Pkg.Name + "." + svc.Name + "." + method.Name
+			if method.No == nil || method.No.Value == nil {
+				ref := &idRef{name: name, pos: method.DefPos, method: method}
+				id, ok := lock.methods[name]
+				if last, used := ids.used[id]; ok && (!used || last.name == name) {
+					ids.set(method, id)
+					ids.used[id] = ref
+				} else {
+					ids.pending = append(ids.pending, ref)
+				}
+				continue
+			}
+			id := *method.No.Value
+			if ref, ok := ids.used[id]; ok && ref.name != name {
+				if ref.method == nil {
+					return ast.NewErrorPos(method.DefPos, "service method id [%d] of [%s] repeated with [%s] %s", id, name, ref.name, ref.pos.String())
+				}
+				// 显式设置的序号优先, 自动分配的接口重新分配
+				ids.pending = append(ids.pending, ref)
+			}
+			l.relock(lock.methods, method.DefPos, id, name)
+			ids.used[id] = &idRef{name: name, pos: method.DefPos}
+		}
+	}
+	return
+}
+
+// 设置自动分配的接口序号
+func (ids *methodIDs) set(method *ast.YTMethod, id int64) {
+	method.No = &ast.YTMethodNo{
+		DefPos: method.DefPos,
+		Value:  &id,
+		Auto:   true,
+	}
+}
+
+// 为等待中的接口分配序号
+func (l *Loader) allocMethodIDs() {
+	if l.ids == nil {
+		return
+	}
+	ids := l.ids
+	for _, ref := range ids.pending {
+		id := ids.alloc(l.lock.methods, ref.name)
+		ids.set(ref.method, id)
+		l.relock(l.lock.methods, ref.pos, id, ref.name)
+		ids.used[id] = ref
+	}
+	ids.pending = nil
+}
+
+// 更新锁定序号. 显式设置的序号与其他锁定序号冲突时, 移除旧的锁定记录
+func (l *Loader) relock(locked map[string]int64, pos token.Pos, id int64, name string) {
+	for _, k := range lockedBy(locked, id, name) {
+		l.warn(fmt.Errorf("%s: id [%d] of [%s] replace locked [%s]", pos.String(), id, name, k))
+		delete(locked, k)
+		l.lock.dirty = true
+	}
+	if last, ok := locked[name]; !ok || last != id {
+		locked[name] = id
+		l.lock.dirty = true
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/walleframe/wctl/protocol/ast"
+	"github.com/walleframe/wctl/protocol/token"
+)
+
+// MessageID 消息ID注册信息
+type MessageID struct {
+	ID int64
+	// 包名
+	Package string
+	// 消息名. 子消息包含父消息名, 例如 outer.inner
+	Name string
+	// 定义文件
+	File string
+	Pos  token.Pos
+	// 引用的常量名
+	Macro string
+	// 是否自动分配
+	Auto bool
+	// 自动分配ID的消息. ID被显式设置的消息占用时重新分配
+	msg *ast.YTMessage
+}
+
+// 消息ID分配区间 [Min,Max]
+type msgIDRange struct {
+	Min, Max int64
+}
+
+// 消息ID注册表. 保证加载器内所有消息ID全局唯一
+type msgIDs struct {
+	// 按包名配置的自动分配区间
+	ranges map[string]msgIDRange
+	// 已分析文件使用的消息ID
+	used map[int64]*MessageID
+	// 等待自动分配ID的消息. 显式及锁定ID全部登记后再分配
+	pending []*MessageID
+}
+
+// 解析消息ID分配区间. 格式 package=min-max
+func parseMsgIDRanges(list []string) (ranges map[string]msgIDRange, err error) {
+	ranges = make(map[string]msgIDRange, len(list))
+	for _, v := range list {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid message id range [%s], need package=min-max", v)
+		}
+		mm := strings.SplitN(kv[1], "-", 2)
+		if len(mm) != 2 {
+			return nil, fmt.Errorf("invalid message id range [%s], need package=min-max", v)
+		}
+		var r msgIDRange
+		r.Min, err = strconv.ParseInt(strings.TrimSpace(mm[0]), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message id range [%s], %w", v, err)
+		}
+		r.Max, err = strconv.ParseInt(strings.TrimSpace(mm[1]), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message id range [%s], %w", v, err)
+		}
+		if r.Min <= 0 || r.Min > r.Max {
+			return nil, fmt.Errorf("invalid message id range [%s], need 0 < min <= max", v)
+		}
+		pkg := strings.TrimSpace(kv[0])
+		if _, ok := ranges[pkg]; ok {
+			return nil, fmt.Errorf("message id range of package [%s] repeated", pkg)
+		}
+		// 不同包的区间不能重叠
+		for name, last := range ranges {
+			if r.Min <= last.Max && last.Min <= r.Max {
+				return nil, fmt.Errorf("message id range [%s] overlap with package [%s]", v, name)
+			}
+		}
+		ranges[pkg] = r
+	}
+	return
+}
+
+func (l *Loader) loadMsgIDs() (err error) {
+	if l.msgIDs != nil {
+		return
+	}
+	ranges, err := parseMsgIDRanges(l.cc.MsgIDRanges)
+	if err != nil {
+		return
+	}
+	// 自动分配时才使用锁定文件
+	if len(ranges) > 0 {
+		if err = l.loadIDLock(); err != nil {
+			return
+		}
+	}
+	l.msgIDs = &msgIDs{
+		ranges: ranges,
+		used:   make(map[int64]*MessageID),
+	}
+	return
+}
+
+// 在包的分配区间内分配最小的可用消息ID
+func (ids *msgIDs) alloc(locked map[string]int64, pkg, name string, r msgIDRange) (int64, error) {
+	for id := r.Min; id <= r.Max; id++ {
+		if _, ok := ids.used[id]; ok {
+			continue
+		}
+		if len(lockedBy(locked, id, name)) > 0 {
+			continue
+		}
+		return id, nil
+	}
+	return 0, fmt.Errorf("message id range [%d-%d] of package [%s] exhausted", r.Min, r.Max, pkg)
+}
+
+// 登记并检查文件内显式设置及锁定的消息ID. 未设置ID的消息等待分析完成后统一分配
+func (l *Loader) assignMsgIDs(prog *ast.YTProgram) (err error) {
+	if err = l.loadMsgIDs(); err != nil {
+		return
+	}
+	ids := l.msgIDs
+	_, auto := ids.ranges[prog.Pkg.Name]
+	return prog.RangeMessages(func(name string, msg *ast.YTMessage) error {
+		full := prog.Pkg.Name + "." + name
+		reg := &MessageID{
+			Package: prog.Pkg.Name,
+			Name:    name,
+			File:    prog.File,
+			Pos:     msg.DefPos,
+		}
+		if msg.ID == nil || msg.ID.Value == nil {
+			if !auto {
+				return nil
+			}
+			reg.Auto = true
+			reg.msg = msg
+			id, ok := l.lock.messages[full]
+			if last, used := ids.used[id]; ok && (!used || last.fullName() == full) {
+				ids.set(reg, id)
+			} else {
+				ids.pending = append(ids.pending, reg)
+			}
+			return nil
+		}
+		id := *msg.ID.Value
+		if ref, ok := ids.used[id]; ok && ref.fullName() != full {
+			if !ref.Auto {
+				return ast.NewErrorPos(msg.ID.DefPos, "message id [%d] of [%s] repeated with [%s] %s", id, full, ref.fullName(), ref.Pos.String())
+			}
+			// 显式设置的ID优先, 自动分配的消息重新分配
+			ids.pending = append(ids.pending, ref)
+		}
+		if auto {
+			l.relock(l.lock.messages, msg.ID.DefPos, id, full)
+		}
+		reg.ID = id
+		if msg.ID.Macro != nil {
+			reg.Macro = msg.ID.Macro.Name
+		}
+		ids.used[id] = reg
+		return nil
+	})
+}
+
+// 设置自动分配的消息ID
+func (ids *msgIDs) set(reg *MessageID, id int64) {
+	reg.ID = id
+	reg.msg.ID = &ast.YTMethodNo{
+		DefPos: reg.Pos,
+		Value:  &id,
+		Auto:   true,
+	}
+	ids.used[id] = reg
+}
+
+// 为等待中的消息分配ID
+func (l *Loader) allocMsgIDs() error {
+	if l.msgIDs == nil {
+		return nil
+	}
+	ids := l.msgIDs
+	defer func() {
+		ids.pending = nil
+	}()
+	for _, reg := range ids.pending {
+		full := reg.fullName()
+		id, err := ids.alloc(l.lock.messages, reg.Package, full, ids.ranges[reg.Package])
+		if err != nil {
+			return ast.NewErrorPos(reg.Pos, "message [%s] %v", full, err)
+		}
+		ids.set(reg, id)
+		l.relock(l.lock.messages, reg.Pos, id, full)
+	}
+	return nil
+}
+
+// 消息全名 package.message
+func (reg *MessageID) fullName() string {
+	return reg.Package + "." + reg.Name
+}
+
+// MessageIDs 获取已分析文件的消息ID注册表. 按ID排序
+func (l *Loader) MessageIDs() (list []*MessageID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.msgIDs == nil {
+		return
+	}
+	for _, v := range l.msgIDs.used {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return
+}
@@ -16,98 +16,236 @@ limitations under the License.
 package protocol
 
 import (
+	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/walleframe/wctl/protocol/ast"
 	"github.com/walleframe/wctl/utils"
 )
 
-func init() {
-	// 注册递归解析函数.用于递归处理依赖
-	ast.RegisterRecursionAnalyser = ast.RecursionAnalyseFunc(analyseOneFile)
-}
-
-// analyseOneFile 解析文件
-func analyseOneFile(file string) (prog *ast.YTProgram, err error) {
+// analyseOneFile 解析文件. 调用方需持有锁
+func (l *Loader) analyseOneFile(file string) (prog *ast.YTProgram, err error) {
 	suffix := filepath.Ext(file)
-	parser := GetParser(suffix)
+	parser := l.getParser(suffix)
 	if parser == nil {
 		err = fmt.Errorf("文件格式[%s]未注册！%s", suffix, file)
 		return
 	}
 
-	// // import文件没有写后缀名. 强制必须写后缀名
-	// if !strings.HasSuffix(file, ast.Flag.FileSuffix) {
-	// 	err = fmt.Errorf("import 或者 指定的文件名不对. 必须包含[%s]后缀.(%s)", ast.Flag.FileSuffix, file)
-	// 	return
-	// 	// file = file + ast.Flag.FileSuffix
-	// }
 	// 查找仓库
-	if item, ok := gWarehouse.file[file]; ok {
+	if item, ok := l.cache.file[file]; ok {
 		return item.Ast, nil
 	}
-	// 转换绝对路径
-	full, err := filepath.Abs(filepath.Join(gWarehouse.path, file))
+	// 在查找目录中定位文件
+	src, err := l.resolve(file)
 	if err != nil {
 		return
 	}
 	// 查找仓库
-	if item, ok := gWarehouse.full[full]; ok {
+	if item, ok := l.cache.full[src.full]; ok {
 		return item.Ast, nil
 	}
+	// 循环导入
+	if idx := l.findLoading(src.full); idx >= 0 {
+		return l.importCycle(idx)
+	}
 	// 读取文件
-	data, err := os.ReadFile(full)
+	data, err := l.readFile(src)
+	if err != nil {
+		return
+	}
+
+	// 进行解析. 支持上下文的解析器使用加载器的递归分析接口
+	if cp, ok := parser.(ContextParser); ok {
+		prog, err = cp.ParseContext(&ast.Context{Analyser: l.analyser}, src.full, data)
+	} else {
+		prog, err = parser.Parse(src.full, data)
+	}
 	if err != nil {
 		return
 	}
+	// 输出路径基于文件所在的查找目录
+	prog.File = src.file
 
-	// 进行解析
-	prog, err = parser.Parse(full, data)
+	// 加载选项定义文件
+	if err = l.loadOptionSchemas(); err != nil {
+		return
+	}
+	// 分析本文件定义
+	err = prog.AnalyseDefine(l.flag)
 	if err != nil {
 		return
 	}
-	prog.File = file
 
-	// 分析合理性
-	err = prog.AnalyseProgram()
+	// 解析依赖文件
+	cur := &loadingFile{full: src.full, prog: prog}
+	l.loading = append(l.loading, cur)
+	defer func() {
+		l.loading = l.loading[:len(l.loading)-1]
+	}()
+	for _, imp := range prog.Imports {
+		if imp.Prog != nil {
+			continue
+		}
+		cur.imp = imp
+		imp.Prog, err = l.analyseOneFile(imp.File)
+		if err != nil {
+			var cycle *ImportCycleError
+			if errors.As(err, &cycle) {
+				return nil, err
+			}
+			return nil, ast.NewErrorPos(imp.DefPos, "import file failed %+v", err)
+		}
+	}
+
+	// 分析引用合理性
+	err = prog.AnalyseReference()
+	if err != nil {
+		return
+	}
+	// 引用废弃定义的警告
+	for _, v := range prog.CheckDeprecated() {
+		l.warn(v)
+	}
+
+	// 分配并检查全局接口序号
+	err = l.assignMethodIDs(prog)
+	if err != nil {
+		return
+	}
+	// 分配并检查全局消息ID
+	err = l.assignMsgIDs(prog)
 	if err != nil {
 		return
 	}
 
 	// 保存
-	item := &astItem{
-		FullName: full,
+	l.cache.save(&astItem{
+		FullName: src.full,
 		FileName: file,
 		Ast:      prog,
-	}
-	gWarehouse.full[item.FullName] = item
-	gWarehouse.file[item.FileName] = item
+	})
 
 	return
 }
 
 // AnalyseFile 解析文件
-func AnalyseFile(file string) (prog *ast.YTProgram, err error) {
-	return analyseOneFile(file)
+func (l *Loader) AnalyseFile(file string) (prog *ast.YTProgram, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return nil, l.err
+	}
+	prog, err = l.analyseOneFile(file)
+	err = l.allocPendingIDs(err)
+	return
 }
 
-// AnlysePath 分析制定路径下所有文件
-func AnlysePath(dir, ext string) (progs []*ast.YTProgram, err error) {
-	var prog *ast.YTProgram
-	var file string
-	utils.RangeFilesWithExt(dir, ext, func(s string) error {
-		file, err = filepath.Rel(dir, s)
-		if err != nil {
-			return err
+// 所有文件分析完成后统一分配自动序号, 保证显式设置及锁定的序号优先. 分析出错时丢弃等待分配的记录
+func (l *Loader) allocPendingIDs(err error) error {
+	if err != nil {
+		if l.ids != nil {
+			l.ids.pending = nil
+		}
+		if l.msgIDs != nil {
+			l.msgIDs.pending = nil
 		}
-		prog, err = analyseOneFile(file)
+		return err
+	}
+	l.allocMethodIDs()
+	return l.allocMsgIDs()
+}
+
+// AnalysePath 分析指定路径下所有文件(包含该路径下的内存覆盖文件).
+// 设置了文件系统时, dir 为文件系统内的路径.
+func (l *Loader) AnalysePath(dir, ext string) (progs []*ast.YTProgram, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return nil, l.err
+	}
+	var files []string
+	if l.cc.FS != nil {
+		err = fs.WalkDir(l.cc.FS, cleanPath(dir), func(s string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(s, ext) {
+				files = append(files, s)
+			}
+			return nil
+		})
+	} else {
+		err = utils.RangeFilesWithExt(dir, ext, func(s string) error {
+			file, err := filepath.Abs(s)
+			if err != nil {
+				return err
+			}
+			files = append(files, file)
+			return nil
+		})
+	}
+	if err != nil {
+		return
+	}
+	// 虚拟文件
+	files = append(files, l.overlayFiles(dir, ext, files)...)
+
+	var prog *ast.YTProgram
+	for _, file := range files {
+		prog, err = l.analyseOneFile(file)
 		if err != nil {
-			return err
+			break
 		}
 		progs = append(progs, prog)
-		return nil
-	})
+	}
+	err = l.allocPendingIDs(err)
+	return
+}
+
+// 获取目录下,未在文件列表中的内存覆盖文件
+func (l *Loader) overlayFiles(dir, ext string, exists []string) (files []string) {
+	prefix := ""
+	if l.cc.FS != nil {
+		prefix = cleanPath(dir)
+	} else if rel, err := filepath.Rel(l.cc.BasePath, dir); err == nil {
+		prefix = cleanPath(rel)
+	}
+	check := make(map[string]struct{}, len(exists))
+	for _, v := range exists {
+		if l.cc.FS == nil && filepath.IsAbs(v) {
+			if rel, err := filepath.Rel(l.cc.BasePath, v); err == nil {
+				v = rel
+			}
+		}
+		check[cleanPath(v)] = struct{}{}
+	}
+	for file := range l.overlay {
+		if !strings.HasSuffix(file, ext) {
+			continue
+		}
+		if prefix != "." && !strings.HasPrefix(file, prefix+"/") {
+			continue
+		}
+		if _, ok := check[file]; ok {
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
 	return
 }
+
+// AnalyseFile 使用默认加载器解析文件
+func AnalyseFile(file string) (prog *ast.YTProgram, err error) {
+	return defaultLoader.AnalyseFile(file)
+}
+
+// AnlysePath 使用默认加载器分析制定路径下所有文件
+func AnlysePath(dir, ext string) (progs []*ast.YTProgram, err error) {
+	return defaultLoader.AnalysePath(dir, ext)
+}
@@ -2,7 +2,6 @@ package bridge
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 
@@ -46,6 +45,7 @@ func ProtoSyntax(c, a0 interface{}) (opt *ast.YTOption, err error) {
 		DefPos: tokVersion.Pos,
 		Key:    ProtobufOptionSyntax,
 		Value: &ast.YTOptionValue{
+			Kind:   ast.OptionValueInt,
 			IntVal: &val,
 		},
 	}
@@ -71,7 +71,7 @@ func ProtoNewOption(c, a1, a3 interface{}) (opt *ast.YTOption, err error) {
 	val := a3.(*token.Token)
 	if strings.HasPrefix(val.IDValue(), `"`) {
 		v := val.StringValue()
-		opt.Value.Value = &v
+		opt.Value.Kind, opt.Value.Value = ast.OptionValueString, &v
 	} else {
 		return nil, ast.NewError(val, "protobuf option value not string literal")
 	}
@@ -126,17 +126,14 @@ func NewImport(c, pkg, alias interface{}) (imp *ast.YTImport, err error) {
 	}
 
 	// 解析依赖文件
-	if ast.RegisterRecursionAnalyser != nil {
-		prog, err := ast.RegisterRecursionAnalyser.Analyse(imp.File)
+	if ctx.Analyser != nil {
+		prog, err := ctx.Analyser.Analyse(imp.File)
 		if err != nil {
 			return nil, ast.NewError(tokPkg, "import file failed %+v", err)
 		}
-		// 保存依赖
+		// 保存依赖. 未获取时由调用方分析
 		imp.Prog = prog
-		//
-		utils.Debugln("RecursionAnalyse true", prog.Pkg.Name)
-	} else {
-		utils.Debugln("RecursionAnalyse false")
+		utils.Debugln("RecursionAnalyse", imp.File, prog != nil)
 	}
 
 	ctx.LastElement = imp
@@ -145,16 +142,17 @@ func NewImport(c, pkg, alias interface{}) (imp *ast.YTImport, err error) {
 	return
 }
 
-// Enum: "enum" tok_identifier "{" Options "}" OptEnd	<< bridge.NewEnum($Context, $1, $3) >>
-func NewEnum(c, en, evs interface{}) (def *ast.YTEnumDef, err error) {
+// Enum: "enum" tok_identifier "{" EnumValues "}" OptEnd	<< bridge.NewEnum($Context, $1, $3) >>
+func NewEnum(c, en, evs, end interface{}) (def *ast.YTEnumDef, err error) {
 	ctx := c.(*ast.Context)
 	tokName := en.(*token.Token)
-	opts := evs.(*ast.YTOptions)
-	def = &ast.YTEnumDef{
-		YTDoc:  ctx.PreDoc(tokName.Line),
-		Name:   tokName.IDValue(),
-		DefPos: tokName.Pos,
-	}
+	def = evs.(*ast.YTEnumDef)
+	opts := def.YTOptions
+	def.Opts = nil
+	def.YTDoc = ctx.PreDoc(tokName.Line)
+	def.Name = tokName.IDValue()
+	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
 	err = checkNormalIdentifier(def.Name, "enum name define")
 	if err != nil {
 		return nil, ast.NewError2(tokName, err)
@@ -215,14 +213,32 @@ func NewEnum(c, en, evs interface{}) (def *ast.YTEnumDef, err error) {
 	return
 }
 
+// EnumValues: EnumValues OptionExpr	<< bridge.EnumElement($Context, $0, $1) >>
+func EnumElement(c, a0, a1 interface{}) (def *ast.YTEnumDef, err error) {
+	def = a0.(*ast.YTEnumDef)
+	// 枚举值和选项在 NewEnum 中拆分
+	def.Opts = append(def.Opts, a1.(*ast.YTOption))
+	return
+}
+
+// EnumValues: EnumValues Reserved	<< bridge.EnumReserved($Context, $0, $1) >>
+func EnumReserved(c, a0, a1 interface{}) (def *ast.YTEnumDef, err error) {
+	def = a0.(*ast.YTEnumDef)
+	rs := a1.(*ast.YTReserved)
+	def.Reserved.Ranges = append(def.Reserved.Ranges, rs.Ranges...)
+	def.Reserved.Names = append(def.Reserved.Names, rs.Names...)
+	return
+}
+
 // Message: "message" tok_identifier "{" Fields "}" OptEnd  << bridge.NewMessage($Context, $1, $3) >>
-func NewMessage(c, mn, fvs interface{}) (def *ast.YTMessage, err error) {
+func NewMessage(c, mn, fvs, end interface{}) (def *ast.YTMessage, err error) {
 	ctx := c.(*ast.Context)
 	tokName := mn.(*token.Token)
 	def = fvs.(*ast.YTMessage)
 	def.YTDoc = ctx.PreDoc(tokName.Line)
 	def.Name = tokName.IDValue()
 	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
 	err = checkNormalIdentifier(def.Name, "enum name define")
 	if err != nil {
 		return nil, ast.NewError2(tokName, err)
@@ -275,6 +291,148 @@ func FieldMessage(c, m, v interface{}) (msg *ast.YTMessage, err error) {
 	return
 }
 
+// Fields Enum	<< bridge.FieldEnum($Context, $0, $1) >>
+func FieldEnum(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	ctx := c.(*ast.Context)
+	msg = m.(*ast.YTMessage)
+	sub := v.(*ast.YTEnumDef)
+	msg.SubEnums = append(msg.SubEnums, sub)
+	// 修复 NewEnum 导致的重复添加
+	for k, v := range ctx.Prog.EnumDefs {
+		if v == sub {
+			ctx.Prog.EnumDefs = append(ctx.Prog.EnumDefs[:k], ctx.Prog.EnumDefs[k+1:]...)
+			break
+		}
+	}
+	ctx.LastElement = sub
+	return
+}
+
+// Fields Oneof	<< bridge.FieldOneof($Context, $0, $1) >>
+func FieldOneof(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	ctx := c.(*ast.Context)
+	msg = m.(*ast.YTMessage)
+	oneof := v.(*ast.YTOneof)
+	msg.Oneofs = append(msg.Oneofs, oneof)
+	// 联合字段同时作为消息字段
+	msg.Fields = append(msg.Fields, oneof.Fields...)
+	ctx.LastElement = oneof
+	return
+}
+
+// Oneof: "oneof" tok_identifier "{" OneofFields "}" OptEnd	<< bridge.NewOneof($Context, $1, $3) >>
+func NewOneof(c, a1, a3, end interface{}) (def *ast.YTOneof, err error) {
+	ctx := c.(*ast.Context)
+	tokName := a1.(*token.Token)
+	def = a3.(*ast.YTOneof)
+	def.YTDoc = ctx.PreDoc(tokName.Line)
+	def.Name = tokName.IDValue()
+	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
+	err = checkNormalIdentifier(def.Name, "oneof name define")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	for _, field := range def.Fields {
+		field.Oneof = def
+	}
+	ctx.LastElement = def
+	return
+}
+
+// OneofFields FieldExpr	<< bridge.OneofField($Context, $0, $1) >>
+func OneofField(c, a0, a1 interface{}) (def *ast.YTOneof, err error) {
+	ctx := c.(*ast.Context)
+	def = a0.(*ast.YTOneof)
+	field := a1.(*ast.YTField)
+	def.Fields = append(def.Fields, field)
+	ctx.LastElement = field
+	return
+}
+
+// Fields Reserved	<< bridge.FieldReserved($Context, $0, $1) >>
+func FieldReserved(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	msg = m.(*ast.YTMessage)
+	rs := v.(*ast.YTReserved)
+	msg.Reserved.Ranges = append(msg.Reserved.Ranges, rs.Ranges...)
+	msg.Reserved.Names = append(msg.Reserved.Names, rs.Names...)
+	return
+}
+
+// Reserved: "reserved" ReservedRanges ";"	<< bridge.NewReserved($Context, $0, $1) >>
+func NewReserved(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	ctx := c.(*ast.Context)
+	tok := a0.(*token.Token)
+	rs = a1.(*ast.YTReserved)
+	// 保留定义不保存注释
+	ctx.PreDoc(tok.Line)
+	ctx.LastElement = rs
+	return
+}
+
+// ReservedRanges: ReservedRanges "," ReservedRange	<< bridge.AppendReservedRange($Context, $0, $2) >>
+func AppendReservedRange(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	if a0 == nil {
+		rs = &ast.YTReserved{}
+	} else {
+		rs = a0.(*ast.YTReserved)
+	}
+	rs.Ranges = append(rs.Ranges, a1.(*ast.YTReservedRange))
+	return
+}
+
+// ReservedRange: tok_num tok_identifier tok_num	<< bridge.NewReservedRange($Context, $0, $1, $2) >>
+func NewReservedRange(c, a0, a1, a2 interface{}) (rg *ast.YTReservedRange, err error) {
+	tokStart := a0.(*token.Token)
+	rg = &ast.YTReservedRange{
+		DefPos: tokStart.Pos,
+	}
+	rg.Start, err = tokenInt64(tokStart)
+	if err != nil {
+		return nil, err
+	}
+	// 单个序号
+	if a1 == nil {
+		rg.End = rg.Start
+		return
+	}
+	if tok := a1.(*token.Token); tok.IDValue() != "to" {
+		return nil, ast.NewError(tok, "reserved range need 'to', got [%s]", tok.IDValue())
+	}
+	tokEnd := a2.(*token.Token)
+	if tokEnd.IDValue() == "max" {
+		rg.End = ast.ReservedMax
+		return
+	}
+	rg.End, err = tokenInt64(tokEnd)
+	if err != nil {
+		return nil, err
+	}
+	if rg.End < rg.Start {
+		return nil, ast.NewError(tokEnd, "reserved range end [%d] less than start [%d]", rg.End, rg.Start)
+	}
+	return
+}
+
+// ReservedNames: ReservedNames "," tok_literal	<< bridge.AppendReservedName($Context, $0, $2) >>
+func AppendReservedName(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	if a0 == nil {
+		rs = &ast.YTReserved{}
+	} else {
+		rs = a0.(*ast.YTReserved)
+	}
+	tok := a1.(*token.Token)
+	name := tok.StringValue()
+	if name == "" {
+		return nil, ast.NewError(tok, "reserved name empty")
+	}
+	rs.Names = append(rs.Names, &ast.YTReservedName{
+		DefPos: tok.Pos,
+		Name:   name,
+	})
+	return
+}
+
 // OptionExpr: tok_identifier OptionValue OptEnd << bridge.OptionExpr($Context, $0, $1) >>
 func OptionExpr(c, n, v interface{}) (opt *ast.YTOption, err error) {
 	ctx := c.(*ast.Context)
@@ -294,14 +452,14 @@ func OptionExpr(c, n, v interface{}) (opt *ast.YTOption, err error) {
 		if val {
 			v = 1
 		}
-		opt.Value.IntVal = &v
+		opt.Value.Kind, opt.Value.BoolVal, opt.Value.IntVal = ast.OptionValueBool, &val, &v
 	case int:
 		v := int64(val)
-		opt.Value.IntVal = &v
+		opt.Value.Kind, opt.Value.IntVal = ast.OptionValueInt, &v
 	case *token.Token:
 		if strings.HasPrefix(val.IDValue(), `"`) {
 			v := val.StringValue()
-			opt.Value.Value = &v
+			opt.Value.Kind, opt.Value.Value = ast.OptionValueString, &v
 		} else {
 			v := strings.TrimPrefix(val.IDValue(), "+")
 			// number
@@ -310,13 +468,13 @@ func OptionExpr(c, n, v interface{}) (opt *ast.YTOption, err error) {
 				if err != nil {
 					return nil, ast.NewError(val, "hex value [%s] invalid.%+v", v, err)
 				}
-				opt.Value.IntVal = &num
+				opt.Value.Kind, opt.Value.IntVal = ast.OptionValueInt, &num
 			} else {
 				num, err := strconv.ParseInt(v, 10, 64)
 				if err != nil {
 					return nil, ast.NewError(val, "number value [%s] invalid.%+v", v, err)
 				}
-				opt.Value.IntVal = &num
+				opt.Value.Kind, opt.Value.IntVal = ast.OptionValueInt, &num
 			}
 		}
 	default:
@@ -333,23 +491,25 @@ func NewField(c, a0, a1, a3, a4 interface{}) (field *ast.YTField, err error) {
 	tokName := a1.(*token.Token)
 	tokNum := a3.(*token.Token)
 
-	num, err := tokNum.Int32Value()
+	num, err := tokenInt64(tokNum)
 	if err != nil {
-		return nil, ast.NewError2(tokNum, err)
+		return nil, err
 	}
-
-	if num > math.MaxUint8 || num < 0 {
-		return nil, ast.NewError(tokNum, "field no must between 1 and 255")
+	if err = ast.CheckFieldNo(num); err != nil {
+		return nil, ast.NewError2(tokNum, err)
 	}
 
 	field.YTDoc = ctx.PreDoc(tokName.Line)
 	field.DefPos = tokName.Pos
 	field.Name = tokName.IDValue()
-	field.No = uint8(num)
+	field.No = int32(num)
 
 	if a4 != nil {
 		field.YTOptions.Opts = append(field.YTOptions.Opts, a4.(*ast.YTOptions).Opts...)
 	}
+	if err = field.SplitFieldOptions(); err != nil {
+		return nil, err
+	}
 
 	// field.Type = fieldType
 
@@ -357,6 +517,57 @@ func NewField(c, a0, a1, a3, a4 interface{}) (field *ast.YTField, err error) {
 	return
 }
 
+// FieldExpr: "optional" FieldType tok_identifier "=" tok_num FieldOptions OptEnd << bridge.NewOptionalField($Context,$1, $2, $4, $5) >>
+func NewOptionalField(c, a1, a2, a4, a5 interface{}) (field *ast.YTField, err error) {
+	field, err = NewField(c, a1, a2, a4, a5)
+	if err != nil {
+		return
+	}
+	field.Optional = true
+	return
+}
+
+// FieldOptionExpr: tok_identifier "=" tok_num|tok_float|tok_literal|tok_identifier << bridge.FieldOptionExpr($Context, $0, $2) >>
+func FieldOptionExpr(c, a0, a2 interface{}) (opt *ast.YTOption, err error) {
+	tokName := a0.(*token.Token)
+	tokVal := a2.(*token.Token)
+	opt = &ast.YTOption{
+		DefPos: tokName.Pos,
+		Key:    tokName.IDValue(),
+		Value:  &ast.YTOptionValue{},
+	}
+	v := tokVal.IDValue()
+	switch {
+	case strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "`"):
+		str := tokVal.StringValue()
+		opt.Value.Kind, opt.Value.Value = ast.OptionValueString, &str
+	case v == "true" || v == "false":
+		b, num := v == "true", int64(0)
+		if b {
+			num = 1
+		}
+		opt.Value.Kind, opt.Value.BoolVal, opt.Value.IntVal = ast.OptionValueBool, &b, &num
+	case strings.ContainsAny(v[:1], "+-0123456789") && strings.ContainsAny(v, ".eE") && !strings.HasPrefix(v, "0x"):
+		num, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, ast.NewError(tokVal, "float value [%s] invalid.%+v", v, err)
+		}
+		opt.Value.Kind, opt.Value.FloatVal = ast.OptionValueFloat, &num
+	case strings.ContainsAny(v[:1], "+-0123456789"):
+		num, err := tokenInt64(tokVal)
+		if err != nil {
+			return nil, err
+		}
+		opt.Value.Kind, opt.Value.IntVal = ast.OptionValueInt, &num
+	case opt.Key == ast.FieldDefaultKey:
+		// 枚举值名称, 分析阶段按字段类型填充
+		opt.Value.Macro = &ast.YTCustomType{Name: v}
+	default:
+		opt.Value.Kind, opt.Value.Value = ast.OptionValueString, &v
+	}
+	return
+}
+
 // Options OptionExpr						<< bridge.AppendOption($Context, $0, $1) >>
 func AppendOption(c, a0, a1 interface{}) (val *ast.YTOptions, err error) {
 	// ctx := c.(*ast.Context)
@@ -443,7 +654,7 @@ func BasicOrCustomType(c, a0 interface{}) (*ast.YTField, error) {
 }
 
 // Service: "service" tok_identifier  "{" ServiceElements "}" OptEnd << bridge.NewService($Context, $1, $3) >>
-func NewService(c, a1, a3 interface{}) (_ *ast.YTService, err error) {
+func NewService(c, a1, a3, end interface{}) (_ *ast.YTService, err error) {
 	ctx := c.(*ast.Context)
 	tokName := a1.(*token.Token)
 	svc := a3.(*ast.YTService)
@@ -454,8 +665,11 @@ func NewService(c, a1, a3 interface{}) (_ *ast.YTService, err error) {
 	}
 
 	svc.DefPos = tokName.Pos
+	svc.EndPos = end.(*token.Token).Pos
 	svc.Name = tokName.IDValue()
 
+	ctx.Prog.Services = append(ctx.Prog.Services, svc)
+
 	ctx.LastElement = svc
 	return
 }
@@ -465,7 +679,10 @@ func ServiceMethod(c, a0, a1 interface{}) (_ *ast.YTService, err error) {
 	ctx := c.(*ast.Context)
 	svc := a0.(*ast.YTService)
 	method := a1.(*ast.YTMethod)
-	method.Flag = svc.Flag
+	// stream 标记优先
+	if method.Flag == ast.Call {
+		method.Flag = svc.Flag
+	}
 	svc.Methods = append(svc.Methods, method)
 	// method.YTDoc = ctx.PreDoc()
 	ctx.LastElement = method
@@ -501,12 +718,29 @@ func ServiceFlag(c, a0, a1 interface{}) (_ *ast.YTService, err error) {
 	return svc, nil
 }
 
-// ServiceMethod: tok_identifier "(" tok_identifier ")" tok_identifier MethodNo FieldOption OptEnd << bridge.NewMethod($Context, $0, $2, $4, $5, $6) >>
+// streamArg 带 stream 标记的请求/回复
+type streamArg struct {
+	*token.Token
+}
+
+// MethodArg: "stream" tok_identifier << bridge.StreamArg($1) >>
+func StreamArg(a1 interface{}) (interface{}, error) {
+	return &streamArg{Token: a1.(*token.Token)}, nil
+}
+
+func methodArg(v interface{}) (*token.Token, bool) {
+	if arg, ok := v.(*streamArg); ok {
+		return arg.Token, true
+	}
+	return v.(*token.Token), false
+}
+
+// Method: "rpc" tok_identifier "(" MethodArg ")" "returns" "(" MethodArg ")" "{" "}" << bridge.NewMethod($Context, $1, $3, $7, nil, nil) >>
 func NewMethod(c, a0, a2, a4, a5, a6 interface{}) (m *ast.YTMethod, err error) {
 	ctx := c.(*ast.Context)
 	tokFunc := a0.(*token.Token)
-	tokRQ := a2.(*token.Token)
-	tokRS := a4.(*token.Token)
+	tokRQ, rqStream := methodArg(a2)
+	tokRS, rsStream := methodArg(a4)
 
 	err = checkNormalIdentifier(tokFunc.IDValue(), "method name")
 	if err != nil {
@@ -553,6 +787,14 @@ func NewMethod(c, a0, a2, a4, a5, a6 interface{}) (m *ast.YTMethod, err error) {
 			},
 		},
 	}
+	switch {
+	case rqStream && rsStream:
+		m.Flag = ast.BidiStream
+	case rqStream:
+		m.Flag = ast.ClientStream
+	case rsStream:
+		m.Flag = ast.ServerStream
+	}
 	// method no.
 	if a5 != nil {
 		tokNo := a5.(*token.Token)
@@ -560,12 +802,10 @@ func NewMethod(c, a0, a2, a4, a5, a6 interface{}) (m *ast.YTMethod, err error) {
 		if err != nil {
 			return nil, ast.NewError2(tokNo, err)
 		}
-		// 仅在配置开启情况下保存数据
-		if ast.Flag.ServiceUseMethodID {
-			m.No = &ast.YTMethodNo{
-				DefPos: tokNo.Pos,
-				Value:  &v,
-			}
+		// 是否使用由分析参数决定
+		m.No = &ast.YTMethodNo{
+			DefPos: tokNo.Pos,
+			Value:  &v,
 		}
 	}
 	// options
@@ -633,6 +873,20 @@ func ProjectOption(c, a0, a1 interface{}) (proj *ast.YTProject, err error) {
 	return
 }
 
+// 解析数值(支持十六进制)
+func tokenInt64(tok *token.Token) (num int64, err error) {
+	v := strings.TrimPrefix(tok.IDValue(), "+")
+	if strings.HasPrefix(v, "0x") {
+		num, err = strconv.ParseInt(strings.TrimPrefix(v, "0x"), 16, 64)
+	} else {
+		num, err = strconv.ParseInt(v, 10, 64)
+	}
+	if err != nil {
+		return 0, ast.NewError(tok, "number value [%s] invalid.%+v", v, err)
+	}
+	return
+}
+
 // 检测是否是正常的标识符 a-z 0-9 _
 func checkNormalIdentifier(def, tip string) error {
 	for k, r := range def {
@@ -712,6 +966,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionSigned,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -729,6 +984,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionSigned,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -747,6 +1003,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionSigned,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -754,6 +1011,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionFixed,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -771,6 +1029,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionSigned,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -778,6 +1037,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionFixed,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -809,6 +1069,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionFixed,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
@@ -826,6 +1087,7 @@ func analyseType(def, tip string) (ftyp *ast.YTField, err error) {
 						DefPos: token.Pos{},
 						Key:    ProtobufOptionFixed,
 						Value: &ast.YTOptionValue{
+							Kind:   ast.OptionValueInt,
 							IntVal: intvar(1),
 						},
 					},
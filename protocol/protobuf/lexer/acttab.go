@@ -37,11 +37,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 23,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 24,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S6
@@ -49,7 +49,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 18,
+		Accept: 17,
 		Ignore: "",
 	},
 	ActionRow{ // S8
@@ -69,7 +69,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 17,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S13
@@ -77,7 +77,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 19,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S15
@@ -85,15 +85,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 0,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 8,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 8,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S19
@@ -117,51 +117,51 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S24
-		Accept: 11,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S25
-		Accept: 12,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S26
-		Accept: 6,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: -1,
-		Ignore: "!unixcomment",
+		Accept: 12,
+		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 13,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S29
-		Accept: 0,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!unixcomment",
 	},
 	ActionRow{ // S30
-		Accept: 0,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 13,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 8,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 8,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 8,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 6,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S36
@@ -173,7 +173,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 8,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S39
@@ -205,15 +205,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S46
-		Accept: 0,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 26,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S48
-		Accept: 13,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S49
@@ -225,19 +225,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 16,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: 8,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: 8,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 8,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S55
@@ -249,7 +249,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 22,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S58
@@ -261,11 +261,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S60
-		Accept: 26,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S61
-		Accept: 10,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S62
@@ -281,7 +281,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S65
-		Accept: 8,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S66
@@ -297,15 +297,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S69
-		Accept: 8,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 8,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 8,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S72
@@ -333,7 +333,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S78
-		Accept: 9,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S79
@@ -341,7 +341,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S80
-		Accept: 14,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S81
@@ -353,23 +353,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S83
-		Accept: 8,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S84
-		Accept: 8,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S85
-		Accept: 4,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S86
-		Accept: 15,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S87
-		Accept: 7,
+		Accept: 18,
 		Ignore: "",
 	},
 	ActionRow{ // S88
@@ -377,15 +377,111 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S89
-		Accept: 25,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S90
-		Accept: 21,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S91
-		Accept: 20,
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S94
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 9,
+		Ignore: "",
+	},
+	ActionRow{ // S97
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S98
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S99
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S100
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S101
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 32,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 7,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 31,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 27,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 19,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 16,
 		Ignore: "",
 	},
 }
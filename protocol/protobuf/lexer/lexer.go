@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 92
-	NumSymbols = 106
+	NumStates  = 116
+	NumSymbols = 140
 )
 
 type Lexer struct {
@@ -129,110 +129,144 @@ func (l *Lexer) Reset() {
 
 /*
 Lexer symbols:
-0: ';'
-1: 's'
-2: 'y'
-3: 'n'
-4: 't'
-5: 'a'
-6: 'x'
-7: '='
-8: 'p'
-9: 'a'
-10: 'c'
-11: 'k'
+0: '+'
+1: '-'
+2: '.'
+3: ';'
+4: 's'
+5: 'y'
+6: 'n'
+7: 't'
+8: 'a'
+9: 'x'
+10: '='
+11: 'p'
 12: 'a'
-13: 'g'
-14: 'e'
-15: 'i'
-16: 'm'
-17: 'p'
-18: 'o'
-19: 'r'
-20: 't'
-21: 'e'
-22: 'n'
-23: 'u'
-24: 'm'
-25: '{'
-26: '}'
-27: 'o'
-28: 'p'
-29: 't'
-30: 'i'
-31: 'o'
-32: 'n'
-33: 'm'
-34: 'e'
-35: 's'
-36: 's'
-37: 'a'
-38: 'g'
-39: 'e'
-40: 'm'
-41: 'a'
-42: 'p'
-43: '<'
-44: ','
-45: '>'
-46: 'r'
-47: 'e'
-48: 'p'
+13: 'c'
+14: 'k'
+15: 'a'
+16: 'g'
+17: 'e'
+18: 'i'
+19: 'm'
+20: 'p'
+21: 'o'
+22: 'r'
+23: 't'
+24: 'e'
+25: 'n'
+26: 'u'
+27: 'm'
+28: '{'
+29: '}'
+30: 'o'
+31: 'p'
+32: 't'
+33: 'i'
+34: 'o'
+35: 'n'
+36: 'm'
+37: 'e'
+38: 's'
+39: 's'
+40: 'a'
+41: 'g'
+42: 'e'
+43: 'r'
+44: 'e'
+45: 's'
+46: 'e'
+47: 'r'
+48: 'v'
 49: 'e'
-50: 'a'
-51: 't'
-52: 'e'
-53: 'd'
-54: 's'
-55: 'e'
-56: 'r'
-57: 'v'
-58: 'i'
-59: 'c'
-60: 'e'
-61: 'r'
-62: 'p'
-63: 'c'
-64: '('
-65: ')'
-66: 'r'
-67: 'e'
-68: 't'
-69: 'u'
-70: 'r'
-71: 'n'
-72: 's'
-73: '_'
-74: '.'
-75: '['
-76: ']'
-77: '<'
-78: '>'
-79: '`'
-80: '`'
-81: '"'
-82: '"'
-83: '+'
-84: '-'
-85: '0'
-86: 'x'
-87: '/'
-88: '*'
-89: '*'
-90: '/'
-91: '/'
-92: '/'
-93: '\n'
-94: ' '
-95: '\t'
-96: '\n'
-97: '\r'
-98: '#'
-99: '\n'
-100: '0'-'9'
-101: 'a'-'z'
-102: 'A'-'Z'
-103: 'a'-'f'
-104: 'A'-'F'
-105: .
+50: 'd'
+51: ','
+52: 'o'
+53: 'n'
+54: 'e'
+55: 'o'
+56: 'f'
+57: 'o'
+58: 'p'
+59: 't'
+60: 'i'
+61: 'o'
+62: 'n'
+63: 'a'
+64: 'l'
+65: '['
+66: ']'
+67: 'm'
+68: 'a'
+69: 'p'
+70: '<'
+71: '>'
+72: 'r'
+73: 'e'
+74: 'p'
+75: 'e'
+76: 'a'
+77: 't'
+78: 'e'
+79: 'd'
+80: 's'
+81: 'e'
+82: 'r'
+83: 'v'
+84: 'i'
+85: 'c'
+86: 'e'
+87: 'r'
+88: 'p'
+89: 'c'
+90: '('
+91: ')'
+92: 'r'
+93: 'e'
+94: 't'
+95: 'u'
+96: 'r'
+97: 'n'
+98: 's'
+99: 's'
+100: 't'
+101: 'r'
+102: 'e'
+103: 'a'
+104: 'm'
+105: '_'
+106: '.'
+107: '<'
+108: '>'
+109: '`'
+110: '`'
+111: '"'
+112: '"'
+113: '+'
+114: '-'
+115: '0'
+116: 'x'
+117: 'e'
+118: 'E'
+119: '+'
+120: '-'
+121: '/'
+122: '*'
+123: '*'
+124: '/'
+125: '/'
+126: '/'
+127: '\n'
+128: ' '
+129: '\t'
+130: '\n'
+131: '\r'
+132: '#'
+133: '\n'
+134: '0'-'9'
+135: 'a'-'z'
+136: 'A'-'Z'
+137: 'a'-'f'
+138: 'A'-'F'
+139: .
 */
@@ -51,40 +51,44 @@ var TransTab = TransitionTable{
 			return 14
 		case 65 <= r && r <= 90: // ['A','Z']
 			return 15
+		case r == 91: // ['[','[']
+			return 16
+		case r == 93: // [']',']']
+			return 17
 		case r == 95: // ['_','_']
 			return 15
 		case r == 96: // ['`','`']
-			return 16
+			return 18
 		case 97 <= r && r <= 100: // ['a','d']
 			return 15
 		case r == 101: // ['e','e']
-			return 17
+			return 19
 		case 102 <= r && r <= 104: // ['f','h']
 			return 15
 		case r == 105: // ['i','i']
-			return 18
+			return 20
 		case 106 <= r && r <= 108: // ['j','l']
 			return 15
 		case r == 109: // ['m','m']
-			return 19
+			return 21
 		case r == 110: // ['n','n']
 			return 15
 		case r == 111: // ['o','o']
-			return 20
+			return 22
 		case r == 112: // ['p','p']
-			return 21
+			return 23
 		case r == 113: // ['q','q']
 			return 15
 		case r == 114: // ['r','r']
-			return 22
+			return 24
 		case r == 115: // ['s','s']
-			return 23
+			return 25
 		case 116 <= r && r <= 122: // ['t','z']
 			return 15
 		case r == 123: // ['{','{']
-			return 24
+			return 26
 		case r == 125: // ['}','}']
-			return 25
+			return 27
 		}
 		return NoState
 	},
@@ -98,7 +102,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 34: // ['"','"']
-			return 26
+			return 28
 		default:
 			return 2
 		}
@@ -107,7 +111,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 27
+			return 29
 		default:
 			return 3
 		}
@@ -128,7 +132,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 28
+			return 30
 		}
 		return NoState
 	},
@@ -142,25 +146,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 29
+			return 31
 		case r == 47: // ['/','/']
-			return 30
+			return 32
 		}
 		return NoState
 	},
 	// S9
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 33
 		case 48 <= r && r <= 57: // ['0','9']
 			return 10
 		case r == 120: // ['x','x']
-			return 31
+			return 34
 		}
 		return NoState
 	},
 	// S10
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 33
 		case 48 <= r && r <= 57: // ['0','9']
 			return 10
 		}
@@ -194,1816 +202,2028 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
 	// S16
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S17
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S18
 	func(r rune) int {
 		switch {
 		case r == 96: // ['`','`']
-			return 35
+			return 38
 		default:
-			return 16
+			return 18
 		}
 	},
-	// S17
+	// S19
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 109: // ['a','m']
-			return 34
+			return 37
 		case r == 110: // ['n','n']
-			return 36
+			return 39
 		case 111 <= r && r <= 122: // ['o','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S18
+	// S20
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 108: // ['a','l']
-			return 34
-		case r == 109: // ['m','m']
 			return 37
+		case r == 109: // ['m','m']
+			return 40
 		case 110 <= r && r <= 122: // ['n','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S19
+	// S21
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case r == 97: // ['a','a']
-			return 38
+			return 41
 		case 98 <= r && r <= 100: // ['b','d']
-			return 34
+			return 37
 		case r == 101: // ['e','e']
-			return 39
+			return 42
 		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S20
+	// S22
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 111: // ['a','o']
-			return 34
+			return 37
+		case 97 <= r && r <= 109: // ['a','m']
+			return 37
+		case r == 110: // ['n','n']
+			return 43
+		case r == 111: // ['o','o']
+			return 37
 		case r == 112: // ['p','p']
-			return 40
+			return 44
 		case 113 <= r && r <= 122: // ['q','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S21
+	// S23
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case r == 97: // ['a','a']
-			return 41
+			return 45
 		case 98 <= r && r <= 122: // ['b','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S22
+	// S24
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 100: // ['a','d']
-			return 34
+			return 37
 		case r == 101: // ['e','e']
-			return 42
+			return 46
 		case 102 <= r && r <= 111: // ['f','o']
-			return 34
+			return 37
 		case r == 112: // ['p','p']
-			return 43
+			return 47
 		case 113 <= r && r <= 122: // ['q','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S23
+	// S25
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 100: // ['a','d']
-			return 34
+			return 37
 		case r == 101: // ['e','e']
-			return 44
-		case 102 <= r && r <= 120: // ['f','x']
-			return 34
+			return 48
+		case 102 <= r && r <= 115: // ['f','s']
+			return 37
+		case r == 116: // ['t','t']
+			return 49
+		case 117 <= r && r <= 120: // ['u','x']
+			return 37
 		case r == 121: // ['y','y']
-			return 45
+			return 50
 		case r == 122: // ['z','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S24
+	// S26
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S25
+	// S27
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S26
+	// S28
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S27
+	// S29
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S28
+	// S30
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 33
 		case 48 <= r && r <= 57: // ['0','9']
-			return 28
+			return 30
 		}
 		return NoState
 	},
-	// S29
+	// S31
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 46
+			return 51
 		default:
-			return 29
+			return 31
 		}
 	},
-	// S30
+	// S32
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 47
+			return 52
 		default:
-			return 30
+			return 32
 		}
 	},
-	// S31
+	// S33
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 48
+			return 53
+		}
+		return NoState
+	},
+	// S34
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 54
 		case 65 <= r && r <= 70: // ['A','F']
-			return 31
+			return 34
 		case 97 <= r && r <= 102: // ['a','f']
-			return 31
+			return 34
 		}
 		return NoState
 	},
-	// S32
+	// S35
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S33
+	// S36
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S34
+	// S37
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S35
+	// S38
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S36
+	// S39
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 116: // ['a','t']
-			return 34
+			return 37
 		case r == 117: // ['u','u']
-			return 49
+			return 55
 		case 118 <= r && r <= 122: // ['v','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S37
+	// S40
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 111: // ['a','o']
-			return 34
+			return 37
 		case r == 112: // ['p','p']
-			return 50
+			return 56
 		case 113 <= r && r <= 122: // ['q','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S38
+	// S41
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 111: // ['a','o']
-			return 34
+			return 37
 		case r == 112: // ['p','p']
-			return 51
+			return 57
 		case 113 <= r && r <= 122: // ['q','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S39
+	// S42
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 114: // ['a','r']
-			return 34
+			return 37
 		case r == 115: // ['s','s']
-			return 52
+			return 58
 		case 116 <= r && r <= 122: // ['t','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S40
+	// S43
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 115: // ['a','s']
-			return 34
-		case r == 116: // ['t','t']
-			return 53
-		case 117 <= r && r <= 122: // ['u','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 59
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
 		}
 		return NoState
 	},
-	// S41
+	// S44
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 98: // ['a','b']
-			return 34
-		case r == 99: // ['c','c']
-			return 54
-		case 100 <= r && r <= 122: // ['d','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 115: // ['a','s']
+			return 37
+		case r == 116: // ['t','t']
+			return 60
+		case 117 <= r && r <= 122: // ['u','z']
+			return 37
 		}
 		return NoState
 	},
-	// S42
+	// S45
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 98: // ['a','b']
+			return 37
+		case r == 99: // ['c','c']
+			return 61
+		case 100 <= r && r <= 122: // ['d','z']
+			return 37
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 111: // ['a','o']
-			return 34
+			return 37
 		case r == 112: // ['p','p']
-			return 55
-		case 113 <= r && r <= 115: // ['q','s']
-			return 34
+			return 62
+		case 113 <= r && r <= 114: // ['q','r']
+			return 37
+		case r == 115: // ['s','s']
+			return 63
 		case r == 116: // ['t','t']
-			return 56
+			return 64
 		case 117 <= r && r <= 122: // ['u','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S43
+	// S47
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 98: // ['a','b']
-			return 34
+			return 37
 		case r == 99: // ['c','c']
-			return 57
+			return 65
 		case 100 <= r && r <= 122: // ['d','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S44
+	// S48
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 113: // ['a','q']
-			return 34
+			return 37
 		case r == 114: // ['r','r']
-			return 58
+			return 66
 		case 115 <= r && r <= 122: // ['s','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S45
+	// S49
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 113: // ['a','q']
+			return 37
+		case r == 114: // ['r','r']
+			return 67
+		case 115 <= r && r <= 122: // ['s','z']
+			return 37
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 109: // ['a','m']
-			return 34
+			return 37
 		case r == 110: // ['n','n']
-			return 59
+			return 68
 		case 111 <= r && r <= 122: // ['o','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S46
+	// S51
 	func(r rune) int {
 		switch {
 		case r == 47: // ['/','/']
-			return 60
+			return 69
 		}
 		return NoState
 	},
-	// S47
+	// S52
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S48
+	// S53
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 48
+			return 53
+		case r == 69: // ['E','E']
+			return 70
+		case r == 101: // ['e','e']
+			return 70
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 54
 		case 65 <= r && r <= 70: // ['A','F']
-			return 31
+			return 34
 		case 97 <= r && r <= 102: // ['a','f']
-			return 31
+			return 34
 		}
 		return NoState
 	},
-	// S49
+	// S55
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 108: // ['a','l']
-			return 34
+			return 37
 		case r == 109: // ['m','m']
-			return 61
+			return 71
 		case 110 <= r && r <= 122: // ['n','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S50
+	// S56
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 110: // ['a','n']
-			return 34
+			return 37
 		case r == 111: // ['o','o']
-			return 62
+			return 72
 		case 112 <= r && r <= 122: // ['p','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S51
+	// S57
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S52
+	// S58
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 114: // ['a','r']
-			return 34
+			return 37
 		case r == 115: // ['s','s']
-			return 63
+			return 73
 		case 116 <= r && r <= 122: // ['t','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S53
+	// S59
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 110: // ['a','n']
+			return 37
+		case r == 111: // ['o','o']
+			return 74
+		case 112 <= r && r <= 122: // ['p','z']
+			return 37
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 104: // ['a','h']
-			return 34
+			return 37
 		case r == 105: // ['i','i']
-			return 64
+			return 75
 		case 106 <= r && r <= 122: // ['j','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S54
+	// S61
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 106: // ['a','j']
-			return 34
+			return 37
 		case r == 107: // ['k','k']
-			return 65
+			return 76
 		case 108 <= r && r <= 122: // ['l','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S55
+	// S62
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 100: // ['a','d']
-			return 34
+			return 37
 		case r == 101: // ['e','e']
-			return 66
+			return 77
 		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S56
+	// S63
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 78
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 116: // ['a','t']
-			return 34
+			return 37
 		case r == 117: // ['u','u']
-			return 67
+			return 79
 		case 118 <= r && r <= 122: // ['v','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S57
+	// S65
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S58
+	// S66
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 117: // ['a','u']
-			return 34
+			return 37
 		case r == 118: // ['v','v']
-			return 68
+			return 80
 		case 119 <= r && r <= 122: // ['w','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S59
+	// S67
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 81
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 115: // ['a','s']
-			return 34
+			return 37
 		case r == 116: // ['t','t']
-			return 69
+			return 82
 		case 117 <= r && r <= 122: // ['u','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S60
+	// S69
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S61
+	// S70
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 83
+		case r == 45: // ['-','-']
+			return 83
+		case 48 <= r && r <= 57: // ['0','9']
+			return 84
+		}
+		return NoState
+	},
+	// S71
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S62
+	// S72
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 113: // ['a','q']
-			return 34
+			return 37
 		case r == 114: // ['r','r']
-			return 70
+			return 85
 		case 115 <= r && r <= 122: // ['s','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S63
+	// S73
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case r == 97: // ['a','a']
-			return 71
+			return 86
 		case 98 <= r && r <= 122: // ['b','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S64
+	// S74
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case 97 <= r && r <= 101: // ['a','e']
+			return 37
+		case r == 102: // ['f','f']
+			return 87
+		case 103 <= r && r <= 122: // ['g','z']
+			return 37
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 110: // ['a','n']
-			return 34
+			return 37
 		case r == 111: // ['o','o']
-			return 72
+			return 88
 		case 112 <= r && r <= 122: // ['p','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S65
+	// S76
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case r == 97: // ['a','a']
-			return 73
+			return 89
 		case 98 <= r && r <= 122: // ['b','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S66
+	// S77
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case r == 97: // ['a','a']
-			return 74
+			return 90
 		case 98 <= r && r <= 122: // ['b','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S67
+	// S78
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 113: // ['a','q']
-			return 34
+			return 37
 		case r == 114: // ['r','r']
-			return 75
+			return 91
 		case 115 <= r && r <= 122: // ['s','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S68
+	// S79
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 104: // ['a','h']
-			return 34
-		case r == 105: // ['i','i']
-			return 76
-		case 106 <= r && r <= 122: // ['j','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 113: // ['a','q']
+			return 37
+		case r == 114: // ['r','r']
+			return 92
+		case 115 <= r && r <= 122: // ['s','z']
+			return 37
 		}
 		return NoState
 	},
-	// S69
+	// S80
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case r == 97: // ['a','a']
-			return 77
-		case 98 <= r && r <= 122: // ['b','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 104: // ['a','h']
+			return 37
+		case r == 105: // ['i','i']
+			return 93
+		case 106 <= r && r <= 122: // ['j','z']
+			return 37
 		}
 		return NoState
 	},
-	// S70
+	// S81
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
+		case r == 97: // ['a','a']
+			return 94
+		case 98 <= r && r <= 122: // ['b','z']
+			return 37
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case r == 97: // ['a','a']
+			return 95
+		case 98 <= r && r <= 122: // ['b','z']
+			return 37
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 84
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 84
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
 		case 97 <= r && r <= 115: // ['a','s']
-			return 34
+			return 37
 		case r == 116: // ['t','t']
-			return 78
+			return 96
 		case 117 <= r && r <= 122: // ['u','z']
-			return 34
+			return 37
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 102: // ['a','f']
+			return 37
+		case r == 103: // ['g','g']
+			return 97
+		case 104 <= r && r <= 122: // ['h','z']
+			return 37
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 109: // ['a','m']
+			return 37
+		case r == 110: // ['n','n']
+			return 98
+		case 111 <= r && r <= 122: // ['o','z']
+			return 37
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 102: // ['a','f']
+			return 37
+		case r == 103: // ['g','g']
+			return 99
+		case 104 <= r && r <= 122: // ['h','z']
+			return 37
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 115: // ['a','s']
+			return 37
+		case r == 116: // ['t','t']
+			return 100
+		case 117 <= r && r <= 122: // ['u','z']
+			return 37
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 117: // ['a','u']
+			return 37
+		case r == 118: // ['v','v']
+			return 101
+		case 119 <= r && r <= 122: // ['w','z']
+			return 37
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 109: // ['a','m']
+			return 37
+		case r == 110: // ['n','n']
+			return 102
+		case 111 <= r && r <= 122: // ['o','z']
+			return 37
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 98: // ['a','b']
+			return 37
+		case r == 99: // ['c','c']
+			return 103
+		case 100 <= r && r <= 122: // ['d','z']
+			return 37
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 60: // ['<','<']
+			return 35
+		case r == 62: // ['>','>']
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
+		case r == 95: // ['_','_']
+			return 37
+		case 97 <= r && r <= 108: // ['a','l']
+			return 37
+		case r == 109: // ['m','m']
+			return 104
+		case 110 <= r && r <= 122: // ['n','z']
+			return 37
 		}
 		return NoState
 	},
-	// S71
+	// S95
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 102: // ['a','f']
-			return 34
-		case r == 103: // ['g','g']
-			return 79
-		case 104 <= r && r <= 122: // ['h','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 119: // ['a','w']
+			return 37
+		case r == 120: // ['x','x']
+			return 105
+		case 121 <= r && r <= 122: // ['y','z']
+			return 37
 		}
 		return NoState
 	},
-	// S72
+	// S96
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 109: // ['a','m']
-			return 34
-		case r == 110: // ['n','n']
-			return 80
-		case 111 <= r && r <= 122: // ['o','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
 		}
 		return NoState
 	},
-	// S73
+	// S97
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 102: // ['a','f']
-			return 34
-		case r == 103: // ['g','g']
-			return 81
-		case 104 <= r && r <= 122: // ['h','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 106
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
 		}
 		return NoState
 	},
-	// S74
+	// S98
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 115: // ['a','s']
-			return 34
-		case r == 116: // ['t','t']
-			return 82
-		case 117 <= r && r <= 122: // ['u','z']
-			return 34
+			return 37
+		case r == 97: // ['a','a']
+			return 107
+		case 98 <= r && r <= 122: // ['b','z']
+			return 37
 		}
 		return NoState
 	},
-	// S75
+	// S99
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 109: // ['a','m']
-			return 34
-		case r == 110: // ['n','n']
-			return 83
-		case 111 <= r && r <= 122: // ['o','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 108
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
 		}
 		return NoState
 	},
-	// S76
+	// S100
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 98: // ['a','b']
-			return 34
-		case r == 99: // ['c','c']
-			return 84
-		case 100 <= r && r <= 122: // ['d','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 109
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
 		}
 		return NoState
 	},
-	// S77
+	// S101
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 119: // ['a','w']
-			return 34
-		case r == 120: // ['x','x']
-			return 85
-		case 121 <= r && r <= 122: // ['y','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 100: // ['a','d']
+			return 37
+		case r == 101: // ['e','e']
+			return 110
+		case 102 <= r && r <= 122: // ['f','z']
+			return 37
 		}
 		return NoState
 	},
-	// S78
+	// S102
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 114: // ['a','r']
+			return 37
+		case r == 115: // ['s','s']
+			return 111
+		case 116 <= r && r <= 122: // ['t','z']
+			return 37
 		}
 		return NoState
 	},
-	// S79
+	// S103
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 100: // ['a','d']
-			return 34
+			return 37
 		case r == 101: // ['e','e']
-			return 86
+			return 112
 		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S80
+	// S104
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 35
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S81
+	// S105
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 100: // ['a','d']
-			return 34
-		case r == 101: // ['e','e']
-			return 87
-		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
 		}
 		return NoState
 	},
-	// S82
+	// S106
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 100: // ['a','d']
-			return 34
-		case r == 101: // ['e','e']
-			return 88
-		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
 		}
 		return NoState
 	},
-	// S83
+	// S107
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 114: // ['a','r']
-			return 34
-		case r == 115: // ['s','s']
-			return 89
-		case 116 <= r && r <= 122: // ['t','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 107: // ['a','k']
+			return 37
+		case r == 108: // ['l','l']
+			return 113
+		case 109 <= r && r <= 122: // ['m','z']
+			return 37
 		}
 		return NoState
 	},
-	// S84
+	// S108
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 100: // ['a','d']
-			return 34
-		case r == 101: // ['e','e']
-			return 90
-		case 102 <= r && r <= 122: // ['f','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
 		}
 		return NoState
 	},
-	// S85
+	// S109
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 99: // ['a','c']
+			return 37
+		case r == 100: // ['d','d']
+			return 114
+		case 101 <= r && r <= 122: // ['e','z']
+			return 37
 		}
 		return NoState
 	},
-	// S86
+	// S110
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 99: // ['a','c']
+			return 37
+		case r == 100: // ['d','d']
+			return 115
+		case 101 <= r && r <= 122: // ['e','z']
+			return 37
 		}
 		return NoState
 	},
-	// S87
+	// S111
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S88
+	// S112
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 99: // ['a','c']
-			return 34
-		case r == 100: // ['d','d']
-			return 91
-		case 101 <= r && r <= 122: // ['e','z']
-			return 34
+			return 37
+		case 97 <= r && r <= 122: // ['a','z']
+			return 37
 		}
 		return NoState
 	},
-	// S89
+	// S113
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S90
+	// S114
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
-	// S91
+	// S115
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 32
+			return 35
 		case 48 <= r && r <= 57: // ['0','9']
-			return 33
+			return 36
 		case r == 60: // ['<','<']
-			return 32
+			return 35
 		case r == 62: // ['>','>']
-			return 32
+			return 35
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 34
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
+			return 37
 		case r == 95: // ['_','_']
-			return 34
+			return 37
 		case 97 <= r && r <= 122: // ['a','z']
-			return 34
+			return 37
 		}
 		return NoState
 	},
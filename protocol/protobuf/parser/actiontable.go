@@ -30,9 +30,15 @@ var actionTab = actionTable{
 			nil,      // tok_num
 			nil,      // option
 			nil,      // message
+			nil,      // reserved
+			nil,      // ,
+			nil,      // oneof
+			nil,      // optional
+			nil,      // [
+			nil,      // ]
+			nil,      // tok_float
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
 			nil,      // repeated
 			nil,      // service
@@ -40,6 +46,7 @@ var actionTab = actionTable{
 			nil,      // (
 			nil,      // )
 			nil,      // returns
+			nil,      // stream
 			nil,      // tok_doc
 		},
 	},
@@ -62,9 +69,15 @@ var actionTab = actionTable{
 			nil,          // tok_num
 			nil,          // option
 			nil,          // message
+			nil,          // reserved
+			nil,          // ,
+			nil,          // oneof
+			nil,          // optional
+			nil,          // [
+			nil,          // ]
+			nil,          // tok_float
 			nil,          // map
 			nil,          // <
-			nil,          // ,
 			nil,          // >
 			nil,          // repeated
 			nil,          // service
@@ -72,6 +85,7 @@ var actionTab = actionTable{
 			nil,          // (
 			nil,          // )
 			nil,          // returns
+			nil,          // stream
 			nil,          // tok_doc
 		},
 	},
@@ -94,9 +108,15 @@ var actionTab = actionTable{
 			nil,      // tok_num
 			nil,      // option
 			nil,      // message
+			nil,      // reserved
+			nil,      // ,
+			nil,      // oneof
+			nil,      // optional
+			nil,      // [
+			nil,      // ]
+			nil,      // tok_float
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
 			nil,      // repeated
 			nil,      // service
@@ -104,6 +124,7 @@ var actionTab = actionTable{
 			nil,      // (
 			nil,      // )
 			nil,      // returns
+			nil,      // stream
 			nil,      // tok_doc
 		},
 	},
@@ -126,9 +147,15 @@ var actionTab = actionTable{
 			nil,      // tok_num
 			nil,      // option
 			nil,      // message
+			nil,      // reserved
+			nil,      // ,
+			nil,      // oneof
+			nil,      // optional
+			nil,      // [
+			nil,      // ]
+			nil,      // tok_float
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
 			nil,      // repeated
 			nil,      // service
@@ -136,6 +163,7 @@ var actionTab = actionTable{
 			nil,      // (
 			nil,      // )
 			nil,      // returns
+			nil,      // stream
 			nil,      // tok_doc
 		},
 	},
@@ -158,9 +186,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(6), // option, reduce: Imports
 			reduce(6), // message, reduce: Imports
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(6), // service, reduce: Imports
@@ -168,6 +202,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -190,9 +225,15 @@ var actionTab = actionTable{
 			nil,      // tok_num
 			nil,      // option
 			nil,      // message
+			nil,      // reserved
+			nil,      // ,
+			nil,      // oneof
+			nil,      // optional
+			nil,      // [
+			nil,      // ]
+			nil,      // tok_float
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
 			nil,      // repeated
 			nil,      // service
@@ -200,6 +241,7 @@ var actionTab = actionTable{
 			nil,      // (
 			nil,      // )
 			nil,      // returns
+			nil,      // stream
 			nil,      // tok_doc
 		},
 	},
@@ -222,9 +264,15 @@ var actionTab = actionTable{
 			nil,      // tok_num
 			nil,      // option
 			nil,      // message
+			nil,      // reserved
+			nil,      // ,
+			nil,      // oneof
+			nil,      // optional
+			nil,      // [
+			nil,      // ]
+			nil,      // tok_float
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
 			nil,      // repeated
 			nil,      // service
@@ -232,6 +280,7 @@ var actionTab = actionTable{
 			nil,      // (
 			nil,      // )
 			nil,      // returns
+			nil,      // stream
 			nil,      // tok_doc
 		},
 	},
@@ -254,9 +303,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(10), // option, reduce: Defines
 			reduce(10), // message, reduce: Defines
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(10), // service, reduce: Defines
@@ -264,6 +319,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -286,9 +342,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(2), // option, reduce: OptEnd
 			reduce(2), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(2), // service, reduce: OptEnd
@@ -296,6 +358,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -318,9 +381,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -328,6 +397,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -350,9 +420,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			shift(22), // option
 			shift(23), // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			shift(24), // service
@@ -360,6 +436,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -382,9 +459,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(7), // option, reduce: Imports
 			reduce(7), // message, reduce: Imports
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(7), // service, reduce: Imports
@@ -392,6 +475,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -414,9 +498,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -424,6 +514,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -446,9 +537,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(5), // option, reduce: Package
 			reduce(5), // message, reduce: Package
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(5), // service, reduce: Package
@@ -456,6 +553,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -478,9 +576,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(3), // option, reduce: OptEnd
 			reduce(3), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(3), // service, reduce: OptEnd
@@ -488,6 +592,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -510,9 +615,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -520,6 +631,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -542,9 +654,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(11), // option, reduce: Defines
 			reduce(11), // message, reduce: Defines
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(11), // service, reduce: Defines
@@ -552,6 +670,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -574,9 +693,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(12), // option, reduce: Define
 			reduce(12), // message, reduce: Define
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(12), // service, reduce: Define
@@ -584,6 +709,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -606,9 +732,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(13), // option, reduce: Define
 			reduce(13), // message, reduce: Define
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(13), // service, reduce: Define
@@ -616,6 +748,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -638,9 +771,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(14), // option, reduce: Define
 			reduce(14), // message, reduce: Define
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(14), // service, reduce: Define
@@ -648,6 +787,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -670,9 +810,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			reduce(15), // option, reduce: Define
 			reduce(15), // message, reduce: Define
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			reduce(15), // service, reduce: Define
@@ -680,6 +826,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -702,9 +849,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -712,6 +865,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -734,9 +888,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -744,6 +904,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -766,9 +927,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -776,6 +943,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -798,9 +966,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -808,6 +982,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -830,9 +1005,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(2), // option, reduce: OptEnd
 			reduce(2), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(2), // service, reduce: OptEnd
@@ -840,6 +1021,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -862,9 +1044,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -872,6 +1060,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -894,9 +1083,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -904,6 +1099,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -926,9 +1122,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -936,6 +1138,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -958,9 +1161,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -968,6 +1177,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -990,9 +1200,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1000,6 +1216,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1022,9 +1239,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(8), // option, reduce: Import
 			reduce(8), // message, reduce: Import
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(8), // service, reduce: Import
@@ -1032,6 +1255,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1054,9 +1278,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(2), // option, reduce: OptEnd
 			reduce(2), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(2), // service, reduce: OptEnd
@@ -1064,6 +1294,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1086,9 +1317,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			reduce(17), // reserved, reduce: EnumValues
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -1096,6 +1333,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -1118,9 +1356,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1128,6 +1372,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1142,24 +1387,31 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(24), // tok_identifier, reduce: Fields
+			reduce(25), // tok_identifier, reduce: Fields
 			nil,        // import
-			nil,        // enum
+			reduce(25), // enum, reduce: Fields
 			nil,        // {
-			reduce(24), // }, reduce: Fields
+			reduce(25), // }, reduce: Fields
 			nil,        // tok_num
 			nil,        // option
-			reduce(24), // message, reduce: Fields
-			reduce(24), // map, reduce: Fields
-			nil,        // <
+			reduce(25), // message, reduce: Fields
+			reduce(25), // reserved, reduce: Fields
 			nil,        // ,
+			reduce(25), // oneof, reduce: Fields
+			reduce(25), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(25), // map, reduce: Fields
+			nil,        // <
 			nil,        // >
-			reduce(24), // repeated, reduce: Fields
+			reduce(25), // repeated, reduce: Fields
 			nil,        // service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -1178,20 +1430,27 @@ var actionTab = actionTable{
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(32), // }, reduce: ServiceElements
+			reduce(57), // }, reduce: ServiceElements
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
-			reduce(32), // rpc, reduce: ServiceElements
+			reduce(57), // rpc, reduce: ServiceElements
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -1214,9 +1473,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(9), // option, reduce: Import
 			reduce(9), // message, reduce: Import
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(9), // service, reduce: Import
@@ -1224,6 +1489,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1246,9 +1512,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			shift(46), // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1256,6 +1528,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1265,7 +1538,7 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			shift(45), // ;
+			shift(47), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
@@ -1278,9 +1551,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1288,6 +1567,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1302,24 +1582,31 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(46), // tok_identifier
+			shift(48), // tok_identifier
 			nil,       // import
-			nil,       // enum
+			shift(51), // enum
 			nil,       // {
-			shift(48), // }
+			shift(52), // }
 			nil,       // tok_num
 			nil,       // option
-			shift(49), // message
-			shift(52), // map
-			nil,       // <
+			shift(54), // message
+			shift(57), // reserved
 			nil,       // ,
+			shift(58), // oneof
+			shift(60), // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			shift(61), // map
+			nil,       // <
 			nil,       // >
-			shift(53), // repeated
+			shift(62), // repeated
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1338,20 +1625,27 @@ var actionTab = actionTable{
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			shift(54), // }
+			shift(63), // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
-			shift(56), // rpc
+			shift(65), // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1361,22 +1655,28 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(20), // ;, reduce: OptionValue
+			reduce(21), // ;, reduce: OptionValue
 			nil,        // syntax
-			shift(57),  // =
+			shift(66),  // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(20), // tok_identifier, reduce: OptionValue
+			reduce(21), // tok_identifier, reduce: OptionValue
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(20), // }, reduce: OptionValue
+			reduce(21), // }, reduce: OptionValue
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			reduce(21), // reserved, reduce: OptionValue
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -1384,6 +1684,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -1393,7 +1694,7 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			reduce(2), // ␚, reduce: OptEnd
 			nil,       // empty
-			shift(60), // ;
+			shift(69), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
@@ -1406,9 +1707,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			reduce(2), // option, reduce: OptEnd
 			reduce(2), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			reduce(2), // service, reduce: OptEnd
@@ -1416,6 +1723,7 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
@@ -1438,9 +1746,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			reduce(18), // reserved, reduce: EnumValues
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -1448,6 +1762,7 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -1455,7 +1770,85 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(22), // ␚, reduce: Option
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(19), // tok_identifier, reduce: EnumValues
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(19), // }, reduce: EnumValues
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			reduce(19), // reserved, reduce: EnumValues
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S46
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // syntax
+			nil,       // =
+			shift(70), // tok_literal
+			nil,       // package
+			nil,       // tok_identifier
+			nil,       // import
+			nil,       // enum
+			nil,       // {
+			nil,       // }
+			shift(71), // tok_num
+			nil,       // option
+			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // service
+			nil,       // rpc
+			nil,       // (
+			nil,       // )
+			nil,       // returns
+			nil,       // stream
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S47
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(23), // ␚, reduce: Option
 			nil,        // empty
 			nil,        // ;
 			nil,        // syntax
@@ -1464,26 +1857,33 @@ var actionTab = actionTable{
 			nil,        // package
 			nil,        // tok_identifier
 			nil,        // import
-			reduce(22), // enum, reduce: Option
+			reduce(23), // enum, reduce: Option
 			nil,        // {
 			nil,        // }
 			nil,        // tok_num
-			reduce(22), // option, reduce: Option
-			reduce(22), // message, reduce: Option
+			reduce(23), // option, reduce: Option
+			reduce(23), // message, reduce: Option
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
-			reduce(22), // service, reduce: Option
+			reduce(23), // service, reduce: Option
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S46
+	actionRow{ // S48
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1494,7 +1894,7 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(30), // tok_identifier, reduce: FieldType
+			reduce(55), // tok_identifier, reduce: FieldType
 			nil,        // import
 			nil,        // enum
 			nil,        // {
@@ -1502,9 +1902,15 @@ var actionTab = actionTable{
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -1512,10 +1918,11 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S47
+	actionRow{ // S49
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1526,92 +1933,152 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(26), // tok_identifier, reduce: Fields
+			reduce(28), // tok_identifier, reduce: Fields
 			nil,        // import
-			nil,        // enum
+			reduce(28), // enum, reduce: Fields
 			nil,        // {
-			reduce(26), // }, reduce: Fields
+			reduce(28), // }, reduce: Fields
 			nil,        // tok_num
 			nil,        // option
-			reduce(26), // message, reduce: Fields
-			reduce(26), // map, reduce: Fields
+			reduce(28), // message, reduce: Fields
+			reduce(28), // reserved, reduce: Fields
+			nil,        // ,
+			reduce(28), // oneof, reduce: Fields
+			reduce(28), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(28), // map, reduce: Fields
 			nil,        // <
+			nil,        // >
+			reduce(28), // repeated, reduce: Fields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S50
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(27), // tok_identifier, reduce: Fields
+			nil,        // import
+			reduce(27), // enum, reduce: Fields
+			nil,        // {
+			reduce(27), // }, reduce: Fields
+			nil,        // tok_num
+			nil,        // option
+			reduce(27), // message, reduce: Fields
+			reduce(27), // reserved, reduce: Fields
 			nil,        // ,
+			reduce(27), // oneof, reduce: Fields
+			reduce(27), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(27), // map, reduce: Fields
+			nil,        // <
 			nil,        // >
-			reduce(26), // repeated, reduce: Fields
+			reduce(27), // repeated, reduce: Fields
 			nil,        // service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S48
+	actionRow{ // S51
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
+			nil,       // ␚
 			nil,       // empty
-			shift(60), // ;
+			nil,       // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			nil,       // tok_identifier
+			shift(75), // tok_identifier
 			nil,       // import
-			reduce(2), // enum, reduce: OptEnd
+			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // tok_num
-			reduce(2), // option, reduce: OptEnd
-			reduce(2), // message, reduce: OptEnd
+			nil,       // option
+			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
+			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S49
+	actionRow{ // S52
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(2), // ␚, reduce: OptEnd
 			nil,       // empty
-			nil,       // ;
+			shift(69), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(62), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
-			nil,       // enum
+			reduce(2), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			nil,       // tok_num
-			nil,       // option
-			nil,       // message
+			reduce(2), // option, reduce: OptEnd
+			reduce(2), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
-			nil,       // service
+			reduce(2), // service, reduce: OptEnd
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S50
+	actionRow{ // S53
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1622,28 +2089,35 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(25), // tok_identifier, reduce: Fields
+			reduce(30), // tok_identifier, reduce: Fields
 			nil,        // import
-			nil,        // enum
+			reduce(30), // enum, reduce: Fields
 			nil,        // {
-			reduce(25), // }, reduce: Fields
+			reduce(30), // }, reduce: Fields
 			nil,        // tok_num
 			nil,        // option
-			reduce(25), // message, reduce: Fields
-			reduce(25), // map, reduce: Fields
-			nil,        // <
+			reduce(30), // message, reduce: Fields
+			reduce(30), // reserved, reduce: Fields
 			nil,        // ,
+			reduce(30), // oneof, reduce: Fields
+			reduce(30), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(30), // map, reduce: Fields
+			nil,        // <
 			nil,        // >
-			reduce(25), // repeated, reduce: Fields
+			reduce(30), // repeated, reduce: Fields
 			nil,        // service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S51
+	actionRow{ // S54
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1654,7 +2128,7 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(63), // tok_identifier
+			shift(77), // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
@@ -1662,9 +2136,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1672,31 +2152,116 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S52
+	actionRow{ // S55
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(26), // tok_identifier, reduce: Fields
+			nil,        // import
+			reduce(26), // enum, reduce: Fields
+			nil,        // {
+			reduce(26), // }, reduce: Fields
+			nil,        // tok_num
+			nil,        // option
+			reduce(26), // message, reduce: Fields
+			reduce(26), // reserved, reduce: Fields
+			nil,        // ,
+			reduce(26), // oneof, reduce: Fields
+			reduce(26), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(26), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(26), // repeated, reduce: Fields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S56
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(29), // tok_identifier, reduce: Fields
+			nil,        // import
+			reduce(29), // enum, reduce: Fields
+			nil,        // {
+			reduce(29), // }, reduce: Fields
+			nil,        // tok_num
+			nil,        // option
+			reduce(29), // message, reduce: Fields
+			reduce(29), // reserved, reduce: Fields
+			nil,        // ,
+			reduce(29), // oneof, reduce: Fields
+			reduce(29), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(29), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(29), // repeated, reduce: Fields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S57
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // syntax
 			nil,       // =
-			nil,       // tok_literal
+			shift(70), // tok_literal
 			nil,       // package
 			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
 			nil,       // }
-			nil,       // tok_num
+			shift(71), // tok_num
 			nil,       // option
 			nil,       // message
-			nil,       // map
-			shift(64), // <
+			nil,       // reserved
 			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
+			nil,       // <
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1704,10 +2269,11 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S53
+	actionRow{ // S58
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1718,7 +2284,7 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(65), // tok_identifier
+			shift(80), // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
@@ -1726,9 +2292,15 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1736,74 +2308,50 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S54
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(31), // ␚, reduce: Service
-			nil,        // empty
-			nil,        // ;
-			nil,        // syntax
-			nil,        // =
-			nil,        // tok_literal
-			nil,        // package
-			nil,        // tok_identifier
-			nil,        // import
-			reduce(31), // enum, reduce: Service
-			nil,        // {
-			nil,        // }
-			nil,        // tok_num
-			reduce(31), // option, reduce: Service
-			reduce(31), // message, reduce: Service
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // repeated
-			reduce(31), // service, reduce: Service
-			nil,        // rpc
-			nil,        // (
-			nil,        // )
-			nil,        // returns
-			nil,        // tok_doc
-		},
-	},
-	actionRow{ // S55
+	actionRow{ // S59
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			nil,        // syntax
-			nil,        // =
-			nil,        // tok_literal
-			nil,        // package
-			nil,        // tok_identifier
-			nil,        // import
-			nil,        // enum
-			nil,        // {
-			reduce(33), // }, reduce: ServiceElements
-			nil,        // tok_num
-			nil,        // option
-			nil,        // message
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // repeated
-			nil,        // service
-			reduce(33), // rpc, reduce: ServiceElements
-			nil,        // (
-			nil,        // )
-			nil,        // returns
-			nil,        // tok_doc
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // syntax
+			nil,       // =
+			nil,       // tok_literal
+			nil,       // package
+			shift(81), // tok_identifier
+			nil,       // import
+			nil,       // enum
+			nil,       // {
+			nil,       // }
+			nil,       // tok_num
+			nil,       // option
+			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // service
+			nil,       // rpc
+			nil,       // (
+			nil,       // )
+			nil,       // returns
+			nil,       // stream
+			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S56
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1814,7 +2362,7 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(66), // tok_identifier
+			shift(48), // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
@@ -1822,20 +2370,27 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
-			nil,       // map
-			nil,       // <
+			nil,       // reserved
 			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			shift(61), // map
+			nil,       // <
 			nil,       // >
-			nil,       // repeated
+			shift(62), // repeated
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S57
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1851,12 +2406,18 @@ var actionTab = actionTable{
 			nil,       // enum
 			nil,       // {
 			nil,       // }
-			shift(67), // tok_num
+			nil,       // tok_num
 			nil,       // option
 			nil,       // message
-			nil,       // map
-			nil,       // <
+			nil,       // reserved
 			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
+			shift(83), // <
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1864,31 +2425,38 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S58
+	actionRow{ // S62
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			shift(69), // ;
+			nil,       // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
+			shift(84), // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			reduce(2), // }, reduce: OptEnd
+			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -1896,14 +2464,15 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S59
+	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(16), // ␚, reduce: Enum
+			reduce(56), // ␚, reduce: Service
 			nil,        // empty
 			nil,        // ;
 			nil,        // syntax
@@ -1912,62 +2481,37 @@ var actionTab = actionTable{
 			nil,        // package
 			nil,        // tok_identifier
 			nil,        // import
-			reduce(16), // enum, reduce: Enum
+			reduce(56), // enum, reduce: Service
 			nil,        // {
 			nil,        // }
 			nil,        // tok_num
-			reduce(16), // option, reduce: Enum
-			reduce(16), // message, reduce: Enum
+			reduce(56), // option, reduce: Service
+			reduce(56), // message, reduce: Service
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
-			reduce(16), // service, reduce: Enum
+			reduce(56), // service, reduce: Service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S60
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(3), // ␚, reduce: OptEnd
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			reduce(3), // enum, reduce: OptEnd
-			nil,       // {
-			nil,       // }
-			nil,       // tok_num
-			reduce(3), // option, reduce: OptEnd
-			reduce(3), // message, reduce: OptEnd
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // repeated
-			reduce(3), // service, reduce: OptEnd
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			nil,       // returns
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S61
+	actionRow{ // S64
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(23), // ␚, reduce: Message
+			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
 			nil,        // syntax
@@ -1976,26 +2520,33 @@ var actionTab = actionTable{
 			nil,        // package
 			nil,        // tok_identifier
 			nil,        // import
-			reduce(23), // enum, reduce: Message
+			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(58), // }, reduce: ServiceElements
 			nil,        // tok_num
-			reduce(23), // option, reduce: Message
-			reduce(23), // message, reduce: Message
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
-			reduce(23), // service, reduce: Message
-			nil,        // rpc
+			nil,        // service
+			reduce(58), // rpc, reduce: ServiceElements
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S62
+	actionRow{ // S65
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2006,17 +2557,23 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			nil,       // tok_identifier
+			shift(85), // tok_identifier
 			nil,       // import
 			nil,       // enum
-			shift(70), // {
+			nil,       // {
 			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2024,10 +2581,11 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S63
+	actionRow{ // S66
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2035,7 +2593,7 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // syntax
-			shift(71), // =
+			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
 			nil,       // tok_identifier
@@ -2043,12 +2601,18 @@ var actionTab = actionTable{
 			nil,       // enum
 			nil,       // {
 			nil,       // }
-			nil,       // tok_num
+			shift(86), // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2056,31 +2620,38 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S64
+	actionRow{ // S67
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			nil,       // ;
+			shift(88), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(72), // tok_identifier
+			reduce(2), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			nil,       // }
+			reduce(2), // }, reduce: OptEnd
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			reduce(2), // reserved, reduce: OptEnd
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2088,46 +2659,54 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S65
+	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(16), // ␚, reduce: Enum
 			nil,        // empty
 			nil,        // ;
 			nil,        // syntax
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(29), // tok_identifier, reduce: FieldType
+			nil,        // tok_identifier
 			nil,        // import
-			nil,        // enum
+			reduce(16), // enum, reduce: Enum
 			nil,        // {
 			nil,        // }
 			nil,        // tok_num
-			nil,        // option
-			nil,        // message
-			nil,        // map
-			nil,        // <
+			reduce(16), // option, reduce: Enum
+			reduce(16), // message, reduce: Enum
+			nil,        // reserved
 			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
 			nil,        // >
 			nil,        // repeated
-			nil,        // service
+			reduce(16), // service, reduce: Enum
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S66
+	actionRow{ // S69
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(3), // ␚, reduce: OptEnd
 			nil,       // empty
 			nil,       // ;
 			nil,       // syntax
@@ -2136,47 +2715,60 @@ var actionTab = actionTable{
 			nil,       // package
 			nil,       // tok_identifier
 			nil,       // import
-			nil,       // enum
+			reduce(3), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			nil,       // tok_num
-			nil,       // option
-			nil,       // message
+			reduce(3), // option, reduce: OptEnd
+			reduce(3), // message, reduce: OptEnd
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
-			nil,       // service
+			reduce(3), // service, reduce: OptEnd
 			nil,       // rpc
-			shift(73), // (
+			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S67
+	actionRow{ // S70
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(21), // ;, reduce: OptionValue
+			reduce(38), // ;, reduce: ReservedNames
 			nil,        // syntax
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(21), // tok_identifier, reduce: OptionValue
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(21), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			nil,        // reserved
+			reduce(38), // ,, reduce: ReservedNames
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -2184,31 +2776,38 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S68
+	actionRow{ // S71
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(35), // ;, reduce: ReservedRange
 			nil,        // syntax
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(19), // tok_identifier, reduce: EnumValue
+			shift(89),  // tok_identifier
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(19), // }, reduce: EnumValue
+			nil,        // }
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			nil,        // reserved
+			reduce(35), // ,, reduce: ReservedRange
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -2216,31 +2815,38 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S69
+	actionRow{ // S72
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			nil,       // ;
+			shift(90), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			reduce(3), // }, reduce: OptEnd
+			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			shift(91), // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2248,48 +2854,17 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S70
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			nil,        // syntax
-			nil,        // =
-			nil,        // tok_literal
-			nil,        // package
-			reduce(24), // tok_identifier, reduce: Fields
-			nil,        // import
-			nil,        // enum
-			nil,        // {
-			reduce(24), // }, reduce: Fields
-			nil,        // tok_num
-			nil,        // option
-			reduce(24), // message, reduce: Fields
-			reduce(24), // map, reduce: Fields
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(24), // repeated, reduce: Fields
-			nil,        // service
-			nil,        // rpc
-			nil,        // (
-			nil,        // )
-			nil,        // returns
-			nil,        // tok_doc
-		},
-	},
-	actionRow{ // S71
+	actionRow{ // S73
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			nil,       // ;
+			shift(92), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
@@ -2299,12 +2874,18 @@ var actionTab = actionTable{
 			nil,       // enum
 			nil,       // {
 			nil,       // }
-			shift(75), // tok_num
+			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			shift(93), // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2312,10 +2893,50 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S72
+	actionRow{ // S74
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(33), // ;, reduce: ReservedRanges
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(33), // ,, reduce: ReservedRanges
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S75
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2329,14 +2950,20 @@ var actionTab = actionTable{
 			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
-			nil,       // {
+			shift(94), // {
 			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			shift(76), // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2344,10 +2971,50 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S73
+	actionRow{ // S76
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(24), // ␚, reduce: Message
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			reduce(24), // enum, reduce: Message
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			reduce(24), // option, reduce: Message
+			reduce(24), // message, reduce: Message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(24), // service, reduce: Message
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S77
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2358,17 +3025,23 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(77), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
-			nil,       // {
+			shift(95), // {
 			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2376,74 +3049,89 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S74
+	actionRow{ // S78
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			nil,       // ;
+			shift(96), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(46), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			shift(78), // }
+			nil,       // }
 			nil,       // tok_num
 			nil,       // option
-			shift(49), // message
-			shift(52), // map
+			nil,       // message
+			nil,       // reserved
+			shift(91), // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			shift(53), // repeated
+			nil,       // repeated
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S75
+	actionRow{ // S79
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			shift(80), // ;
+			shift(97), // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			reduce(2), // }, reduce: OptEnd
+			nil,       // }
 			nil,       // tok_num
 			nil,       // option
-			reduce(2), // message, reduce: OptEnd
-			reduce(2), // map, reduce: OptEnd
+			nil,       // message
+			nil,       // reserved
+			shift(93), // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			reduce(2), // repeated, reduce: OptEnd
+			nil,       // repeated
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S76
+	actionRow{ // S80
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2454,17 +3142,23 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			shift(81), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
-			nil,       // {
+			shift(98), // {
 			nil,       // }
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2472,10 +3166,11 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S77
+	actionRow{ // S81
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2483,7 +3178,7 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // syntax
-			nil,       // =
+			shift(99), // =
 			nil,       // tok_literal
 			nil,       // package
 			nil,       // tok_identifier
@@ -2494,52 +3189,66 @@ var actionTab = actionTable{
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
-			shift(82), // )
+			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S78
+	actionRow{ // S82
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(80), // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			reduce(2), // }, reduce: OptEnd
-			nil,       // tok_num
-			nil,       // option
-			reduce(2), // message, reduce: OptEnd
-			reduce(2), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(2), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			nil,       // returns
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(100), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S79
+	actionRow{ // S83
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2550,156 +3259,152 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(27), // tok_identifier, reduce: FieldExpr
+			shift(101), // tok_identifier
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(27), // }, reduce: FieldExpr
+			nil,        // }
 			nil,        // tok_num
 			nil,        // option
-			reduce(27), // message, reduce: FieldExpr
-			reduce(27), // map, reduce: FieldExpr
-			nil,        // <
+			nil,        // message
+			nil,        // reserved
 			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
 			nil,        // >
-			reduce(27), // repeated, reduce: FieldExpr
+			nil,        // repeated
 			nil,        // service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S80
+	actionRow{ // S84
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			reduce(3), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			reduce(3), // }, reduce: OptEnd
-			nil,       // tok_num
-			nil,       // option
-			reduce(3), // message, reduce: OptEnd
-			reduce(3), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(3), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			nil,       // returns
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S81
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // tok_num
-			nil,       // option
-			nil,       // message
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			shift(84), // >
-			nil,       // repeated
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			nil,       // returns
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(54), // tok_identifier, reduce: FieldType
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S82
+	actionRow{ // S85
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // tok_num
-			nil,       // option
-			nil,       // message
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // repeated
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			shift(85), // returns
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			shift(102), // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S83
+	actionRow{ // S86
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(22), // ;, reduce: OptionValue
 			nil,        // syntax
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(23), // tok_identifier, reduce: Message
+			reduce(22), // tok_identifier, reduce: OptionValue
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(23), // }, reduce: Message
+			reduce(22), // }, reduce: OptionValue
 			nil,        // tok_num
 			nil,        // option
-			reduce(23), // message, reduce: Message
-			reduce(23), // map, reduce: Message
-			nil,        // <
+			nil,        // message
+			reduce(22), // reserved, reduce: OptionValue
 			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
 			nil,        // >
-			reduce(23), // repeated, reduce: Message
+			nil,        // repeated
 			nil,        // service
 			nil,        // rpc
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S84
+	actionRow{ // S87
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2710,17 +3415,23 @@ var actionTab = actionTable{
 			nil,        // =
 			nil,        // tok_literal
 			nil,        // package
-			reduce(28), // tok_identifier, reduce: FieldType
+			reduce(20), // tok_identifier, reduce: EnumValue
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(20), // }, reduce: EnumValue
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			reduce(20), // reserved, reduce: EnumValue
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
@@ -2728,10 +3439,11 @@ var actionTab = actionTable{
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S85
+	actionRow{ // S88
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2742,92 +3454,113 @@ var actionTab = actionTable{
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			nil,       // tok_identifier
+			reduce(3), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			nil,       // }
+			reduce(3), // }, reduce: OptEnd
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
+			reduce(3), // reserved, reduce: OptEnd
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
 			nil,       // rpc
-			shift(86), // (
+			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S86
+	actionRow{ // S89
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			shift(87), // tok_identifier
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // tok_num
-			nil,       // option
-			nil,       // message
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // repeated
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			nil,       // )
-			nil,       // returns
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(103), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(104), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S87
+	actionRow{ // S90
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // syntax
-			nil,       // =
-			nil,       // tok_literal
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // tok_num
-			nil,       // option
-			nil,       // message
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // repeated
-			nil,       // service
-			nil,       // rpc
-			nil,       // (
-			shift(88), // )
-			nil,       // returns
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(31), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(31), // }, reduce: Reserved
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			reduce(31), // reserved, reduce: Reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S88
+	actionRow{ // S91
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2841,14 +3574,20 @@ var actionTab = actionTable{
 			nil,       // tok_identifier
 			nil,       // import
 			nil,       // enum
-			shift(89), // {
+			nil,       // {
 			nil,       // }
-			nil,       // tok_num
+			shift(71), // tok_num
 			nil,       // option
 			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
 			nil,       // repeated
 			nil,       // service
@@ -2856,42 +3595,2819 @@ var actionTab = actionTable{
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S89
+	actionRow{ // S92
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(32), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(32), // }, reduce: Reserved
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			reduce(32), // reserved, reduce: Reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S93
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			shift(106), // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S94
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(17), // tok_identifier, reduce: EnumValues
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(17), // }, reduce: EnumValues
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			reduce(17), // reserved, reduce: EnumValues
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S95
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(25), // tok_identifier, reduce: Fields
+			nil,        // import
+			reduce(25), // enum, reduce: Fields
+			nil,        // {
+			reduce(25), // }, reduce: Fields
+			nil,        // tok_num
+			nil,        // option
+			reduce(25), // message, reduce: Fields
+			reduce(25), // reserved, reduce: Fields
+			nil,        // ,
+			reduce(25), // oneof, reduce: Fields
+			reduce(25), // optional, reduce: Fields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(25), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(25), // repeated, reduce: Fields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S96
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(31), // tok_identifier, reduce: Reserved
+			nil,        // import
+			reduce(31), // enum, reduce: Reserved
+			nil,        // {
+			reduce(31), // }, reduce: Reserved
+			nil,        // tok_num
+			nil,        // option
+			reduce(31), // message, reduce: Reserved
+			reduce(31), // reserved, reduce: Reserved
+			nil,        // ,
+			reduce(31), // oneof, reduce: Reserved
+			reduce(31), // optional, reduce: Reserved
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(31), // map, reduce: Reserved
+			nil,        // <
+			nil,        // >
+			reduce(31), // repeated, reduce: Reserved
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S97
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(32), // tok_identifier, reduce: Reserved
+			nil,        // import
+			reduce(32), // enum, reduce: Reserved
+			nil,        // {
+			reduce(32), // }, reduce: Reserved
+			nil,        // tok_num
+			nil,        // option
+			reduce(32), // message, reduce: Reserved
+			reduce(32), // reserved, reduce: Reserved
+			nil,        // ,
+			reduce(32), // oneof, reduce: Reserved
+			reduce(32), // optional, reduce: Reserved
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(32), // map, reduce: Reserved
+			nil,        // <
+			nil,        // >
+			reduce(32), // repeated, reduce: Reserved
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S98
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: OneofFields
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(41), // }, reduce: OneofFields
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(41), // optional, reduce: OneofFields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(41), // map, reduce: OneofFields
+			nil,        // <
+			nil,        // >
+			reduce(41), // repeated, reduce: OneofFields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S99
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(110), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S100
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			shift(111), // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S101
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			shift(112), // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(113), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			shift(115), // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(37), // ;, reduce: ReservedRange
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(37), // ,, reduce: ReservedRange
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S104
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(36), // ;, reduce: ReservedRange
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(36), // ,, reduce: ReservedRange
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S105
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(34), // ;, reduce: ReservedRanges
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(34), // ,, reduce: ReservedRanges
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S106
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: ReservedNames
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(39), // ,, reduce: ReservedNames
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S107
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(42),  // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			shift(116), // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			shift(46),  // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S108
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(48),  // tok_identifier
+			nil,        // import
+			shift(51),  // enum
+			nil,        // {
+			shift(117), // }
+			nil,        // tok_num
+			nil,        // option
+			shift(54),  // message
+			shift(57),  // reserved
+			nil,        // ,
+			shift(58),  // oneof
+			shift(60),  // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			shift(61),  // map
+			nil,        // <
+			nil,        // >
+			shift(62),  // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S109
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(48),  // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			shift(118), // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			shift(121), // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			shift(61),  // map
+			nil,        // <
+			nil,        // >
+			shift(62),  // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S110
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			reduce(45), // enum, reduce: FieldOptions
+			nil,        // {
+			reduce(45), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			reduce(45), // message, reduce: FieldOptions
+			reduce(45), // reserved, reduce: FieldOptions
+			nil,        // ,
+			reduce(45), // oneof, reduce: FieldOptions
+			reduce(45), // optional, reduce: FieldOptions
+			shift(123), // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(45), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S111
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(124), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S112
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(125), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S113
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			reduce(60), // ), reduce: MethodArg
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S114
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			shift(126), // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(127), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(129), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // ,
+			reduce(2),  // oneof, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(129), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // ,
+			reduce(2),  // oneof, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(129), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // ,
+			reduce(2),  // oneof, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: OneofFields
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(42), // }, reduce: OneofFields
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(42), // optional, reduce: OneofFields
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(42), // map, reduce: OneofFields
+			nil,        // <
+			nil,        // >
+			reduce(42), // repeated, reduce: OneofFields
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(132), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // syntax
+			nil,       // =
+			nil,       // tok_literal
+			nil,       // package
+			shift(48), // tok_identifier
+			nil,       // import
+			nil,       // enum
+			nil,       // {
+			nil,       // }
+			nil,       // tok_num
+			nil,       // option
+			nil,       // message
+			nil,       // reserved
+			nil,       // ,
+			nil,       // oneof
+			nil,       // optional
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			shift(61), // map
+			nil,       // <
+			nil,       // >
+			shift(62), // repeated
+			nil,       // service
+			nil,       // rpc
+			nil,       // (
+			nil,       // )
+			nil,       // returns
+			nil,       // stream
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(129), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // ,
+			reduce(2),  // oneof, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(135), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			reduce(45), // enum, reduce: FieldOptions
+			nil,        // {
+			reduce(45), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			reduce(45), // message, reduce: FieldOptions
+			reduce(45), // reserved, reduce: FieldOptions
+			nil,        // ,
+			reduce(45), // oneof, reduce: FieldOptions
+			reduce(45), // optional, reduce: FieldOptions
+			shift(123), // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(45), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			shift(139), // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			shift(140), // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			reduce(61), // ), reduce: MethodArg
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(16), // tok_identifier, reduce: Enum
+			nil,        // import
+			reduce(16), // enum, reduce: Enum
+			nil,        // {
+			reduce(16), // }, reduce: Enum
+			nil,        // tok_num
+			nil,        // option
+			reduce(16), // message, reduce: Enum
+			reduce(16), // reserved, reduce: Enum
+			nil,        // ,
+			reduce(16), // oneof, reduce: Enum
+			reduce(16), // optional, reduce: Enum
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(16), // map, reduce: Enum
+			nil,        // <
+			nil,        // >
+			reduce(16), // repeated, reduce: Enum
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // syntax
+			nil,       // =
+			nil,       // tok_literal
+			nil,       // package
+			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			reduce(3), // enum, reduce: OptEnd
+			nil,       // {
+			reduce(3), // }, reduce: OptEnd
+			nil,       // tok_num
+			nil,       // option
+			reduce(3), // message, reduce: OptEnd
+			reduce(3), // reserved, reduce: OptEnd
+			nil,       // ,
+			reduce(3), // oneof, reduce: OptEnd
+			reduce(3), // optional, reduce: OptEnd
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			reduce(3), // map, reduce: OptEnd
+			nil,       // <
+			nil,       // >
+			reduce(3), // repeated, reduce: OptEnd
+			nil,       // service
+			nil,       // rpc
+			nil,       // (
+			nil,       // )
+			nil,       // returns
+			nil,       // stream
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(24), // tok_identifier, reduce: Message
+			nil,        // import
+			reduce(24), // enum, reduce: Message
+			nil,        // {
+			reduce(24), // }, reduce: Message
+			nil,        // tok_num
+			nil,        // option
+			reduce(24), // message, reduce: Message
+			reduce(24), // reserved, reduce: Message
+			nil,        // ,
+			reduce(24), // oneof, reduce: Message
+			reduce(24), // optional, reduce: Message
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(24), // map, reduce: Message
+			nil,        // <
+			nil,        // >
+			reduce(24), // repeated, reduce: Message
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: Oneof
+			nil,        // import
+			reduce(40), // enum, reduce: Oneof
+			nil,        // {
+			reduce(40), // }, reduce: Oneof
+			nil,        // tok_num
+			nil,        // option
+			reduce(40), // message, reduce: Oneof
+			reduce(40), // reserved, reduce: Oneof
+			nil,        // ,
+			reduce(40), // oneof, reduce: Oneof
+			reduce(40), // optional, reduce: Oneof
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(40), // map, reduce: Oneof
+			nil,        // <
+			nil,        // >
+			reduce(40), // repeated, reduce: Oneof
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			shift(141), // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(142), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: FieldExpr
+			nil,        // import
+			reduce(43), // enum, reduce: FieldExpr
+			nil,        // {
+			reduce(43), // }, reduce: FieldExpr
+			nil,        // tok_num
+			nil,        // option
+			reduce(43), // message, reduce: FieldExpr
+			reduce(43), // reserved, reduce: FieldExpr
+			nil,        // ,
+			reduce(43), // oneof, reduce: FieldExpr
+			reduce(43), // optional, reduce: FieldExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(43), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(43), // repeated, reduce: FieldExpr
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			shift(143), // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			shift(144), // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			shift(145), // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(47), // ,, reduce: FieldOptionList
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(47), // ], reduce: FieldOptionList
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(129), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // ,
+			reduce(2),  // oneof, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: FieldType
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			shift(147), // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(148), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			shift(149), // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			shift(150), // tok_literal
+			nil,        // package
+			shift(151), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(152), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			shift(153), // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(135), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			reduce(46), // enum, reduce: FieldOptions
+			nil,        // {
+			reduce(46), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			reduce(46), // message, reduce: FieldOptions
+			reduce(46), // reserved, reduce: FieldOptions
+			nil,        // ,
+			reduce(46), // oneof, reduce: FieldOptions
+			reduce(46), // optional, reduce: FieldOptions
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(46), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(46), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: FieldExpr
+			nil,        // import
+			reduce(44), // enum, reduce: FieldExpr
+			nil,        // {
+			reduce(44), // }, reduce: FieldExpr
+			nil,        // tok_num
+			nil,        // option
+			reduce(44), // message, reduce: FieldExpr
+			reduce(44), // reserved, reduce: FieldExpr
+			nil,        // ,
+			reduce(44), // oneof, reduce: FieldExpr
+			reduce(44), // optional, reduce: FieldExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(44), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(44), // repeated, reduce: FieldExpr
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(113), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			shift(115), // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(45), // optional, reduce: FieldOptions
+			shift(157), // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(45), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(158), // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(51), // ,, reduce: FieldOptionExpr
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(51), // ], reduce: FieldOptionExpr
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(52), // ,, reduce: FieldOptionExpr
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(52), // ], reduce: FieldOptionExpr
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(49), // ,, reduce: FieldOptionExpr
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(49), // ], reduce: FieldOptionExpr
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(50), // ,, reduce: FieldOptionExpr
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(50), // ], reduce: FieldOptionExpr
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			reduce(48), // ,, reduce: FieldOptionList
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			reduce(48), // ], reduce: FieldOptionList
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			shift(159), // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(161), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			shift(135), // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(45), // optional, reduce: FieldOptions
+			shift(157), // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(45), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S159
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			shift(164), // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: FieldExpr
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(43), // }, reduce: FieldExpr
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(43), // optional, reduce: FieldExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(43), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(43), // repeated, reduce: FieldExpr
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
 			nil,       // ;
 			nil,       // syntax
 			nil,       // =
 			nil,       // tok_literal
 			nil,       // package
-			nil,       // tok_identifier
+			reduce(3), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // enum
 			nil,       // {
-			shift(90), // }
+			reduce(3), // }, reduce: OptEnd
 			nil,       // tok_num
 			nil,       // option
 			nil,       // message
-			nil,       // map
-			nil,       // <
+			nil,       // reserved
 			nil,       // ,
+			nil,       // oneof
+			reduce(3), // optional, reduce: OptEnd
+			nil,       // [
+			nil,       // ]
+			nil,       // tok_float
+			reduce(3), // map, reduce: OptEnd
+			nil,       // <
 			nil,       // >
-			nil,       // repeated
+			reduce(3), // repeated, reduce: OptEnd
 			nil,       // service
 			nil,       // rpc
 			nil,       // (
 			nil,       // )
 			nil,       // returns
+			nil,       // stream
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S90
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			shift(144), // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			shift(165), // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(161), // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(2),  // optional, reduce: OptEnd
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S164
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2906,20 +6422,144 @@ var actionTab = actionTable{
 			nil,        // import
 			nil,        // enum
 			nil,        // {
-			reduce(34), // }, reduce: Method
+			shift(167), // }
 			nil,        // tok_num
 			nil,        // option
 			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
 			nil,        // map
 			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: FieldOptions
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: FieldOptions
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(46), // }, reduce: FieldOptions
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(46), // optional, reduce: FieldOptions
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(46), // map, reduce: FieldOptions
+			nil,        // <
+			nil,        // >
+			reduce(46), // repeated, reduce: FieldOptions
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: FieldExpr
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(44), // }, reduce: FieldExpr
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
+			nil,        // ,
+			nil,        // oneof
+			reduce(44), // optional, reduce: FieldExpr
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			reduce(44), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(44), // repeated, reduce: FieldExpr
+			nil,        // service
+			nil,        // rpc
+			nil,        // (
+			nil,        // )
+			nil,        // returns
+			nil,        // stream
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // syntax
+			nil,        // =
+			nil,        // tok_literal
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // enum
+			nil,        // {
+			reduce(59), // }, reduce: Method
+			nil,        // tok_num
+			nil,        // option
+			nil,        // message
+			nil,        // reserved
 			nil,        // ,
+			nil,        // oneof
+			nil,        // optional
+			nil,        // [
+			nil,        // ]
+			nil,        // tok_float
+			nil,        // map
+			nil,        // <
 			nil,        // >
 			nil,        // repeated
 			nil,        // service
-			reduce(34), // rpc, reduce: Method
+			reduce(59), // rpc, reduce: Method
 			nil,        // (
 			nil,        // )
 			nil,        // returns
+			nil,        // stream
 			nil,        // tok_doc
 		},
 	},
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 21
+const numNTSymbols = 31
 
 type (
 	gotoTable [numStates]gotoRow
@@ -27,11 +27,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S1
 		-1, // S'
@@ -50,11 +60,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S2
 		-1, // S'
@@ -73,11 +93,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S3
 		-1, // S'
@@ -96,11 +126,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S4
 		-1, // S'
@@ -119,11 +159,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S5
 		-1, // S'
@@ -142,11 +192,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S6
 		-1, // S'
@@ -165,11 +225,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S7
 		-1, // S'
@@ -188,11 +258,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S8
 		-1, // S'
@@ -211,11 +291,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S9
 		-1, // S'
@@ -234,11 +324,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S10
 		-1, // S'
@@ -257,11 +357,21 @@ var gotoTab = gotoTable{
 		19, // Option
 		18, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		20, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S11
 		-1, // S'
@@ -280,11 +390,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S12
 		-1, // S'
@@ -303,11 +423,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S13
 		-1, // S'
@@ -326,11 +456,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S14
 		-1, // S'
@@ -349,11 +489,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S15
 		-1, // S'
@@ -372,11 +522,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S16
 		-1, // S'
@@ -395,11 +555,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S17
 		-1, // S'
@@ -418,11 +588,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S18
 		-1, // S'
@@ -441,11 +621,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S19
 		-1, // S'
@@ -464,11 +654,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S20
 		-1, // S'
@@ -487,11 +687,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S21
 		-1, // S'
@@ -510,11 +720,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S22
 		-1, // S'
@@ -533,11 +753,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S23
 		-1, // S'
@@ -556,11 +786,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S24
 		-1, // S'
@@ -579,11 +819,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S25
 		-1, // S'
@@ -602,11 +852,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S26
 		-1, // S'
@@ -625,11 +885,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S27
 		-1, // S'
@@ -648,11 +918,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S28
 		-1, // S'
@@ -671,11 +951,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S29
 		-1, // S'
@@ -694,11 +984,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S30
 		-1, // S'
@@ -717,11 +1017,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S31
 		-1, // S'
@@ -740,11 +1050,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S32
 		-1, // S'
@@ -763,11 +1083,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S33
 		-1, // S'
@@ -786,11 +1116,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S34
 		-1, // S'
@@ -809,11 +1149,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S35
 		-1, // S'
@@ -832,11 +1182,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		40, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S36
 		-1, // S'
@@ -855,11 +1215,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		41, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S37
 		-1, // S'
@@ -878,11 +1248,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S38
 		-1, // S'
@@ -901,11 +1281,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		45, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S39
 		-1, // S'
@@ -924,11 +1314,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S40
 		-1, // S'
@@ -940,18 +1340,28 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
-		-1, // Enum
+		49, // Enum
 		-1, // EnumValues
 		-1, // EnumValue
 		-1, // OptionValue
 		-1, // Option
-		47, // Message
+		50, // Message
 		-1, // Fields
-		50, // FieldExpr
-		51, // FieldType
+		53, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		56, // Oneof
+		-1, // OneofFields
+		55, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		59, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S41
 		-1, // S'
@@ -970,11 +1380,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
-		55, // Method
+		64, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S42
 		-1, // S'
@@ -989,20 +1409,30 @@ var gotoTab = gotoTable{
 		-1, // Enum
 		-1, // EnumValues
 		-1, // EnumValue
-		58, // OptionValue
+		67, // OptionValue
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S43
 		-1, // S'
 		-1, // ProtocolDefine
-		59, // OptEnd
+		68, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1016,11 +1446,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S44
 		-1, // S'
@@ -1039,11 +1479,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S45
 		-1, // S'
@@ -1062,11 +1512,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S46
 		-1, // S'
@@ -1085,11 +1545,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		72, // ReservedRanges
+		74, // ReservedRange
+		73, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S47
 		-1, // S'
@@ -1108,16 +1578,26 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S48
 		-1, // S'
 		-1, // ProtocolDefine
-		61, // OptEnd
+		-1, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1131,11 +1611,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S49
 		-1, // S'
@@ -1154,11 +1644,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S50
 		-1, // S'
@@ -1177,11 +1677,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S51
 		-1, // S'
@@ -1200,16 +1710,26 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S52
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		76, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1223,11 +1743,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S53
 		-1, // S'
@@ -1246,11 +1776,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S54
 		-1, // S'
@@ -1269,11 +1809,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S55
 		-1, // S'
@@ -1292,11 +1842,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S56
 		-1, // S'
@@ -1315,11 +1875,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S57
 		-1, // S'
@@ -1338,16 +1908,26 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		78, // ReservedRanges
+		74, // ReservedRange
+		79, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S58
 		-1, // S'
 		-1, // ProtocolDefine
-		68, // OptEnd
+		-1, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1361,11 +1941,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S59
 		-1, // S'
@@ -1384,11 +1974,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S60
 		-1, // S'
@@ -1407,11 +2007,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
-		-1, // FieldType
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		82, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S61
 		-1, // S'
@@ -1430,11 +2040,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S62
 		-1, // S'
@@ -1453,11 +2073,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S63
 		-1, // S'
@@ -1476,11 +2106,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S64
 		-1, // S'
@@ -1499,11 +2139,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S65
 		-1, // S'
@@ -1522,11 +2172,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S66
 		-1, // S'
@@ -1545,16 +2205,26 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S67
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		87, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1568,11 +2238,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S68
 		-1, // S'
@@ -1591,11 +2271,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S69
 		-1, // S'
@@ -1614,11 +2304,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S70
 		-1, // S'
@@ -1636,12 +2336,22 @@ var gotoTab = gotoTable{
 		-1, // OptionValue
 		-1, // Option
 		-1, // Message
-		74, // Fields
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S71
 		-1, // S'
@@ -1660,11 +2370,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S72
 		-1, // S'
@@ -1683,11 +2403,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S73
 		-1, // S'
@@ -1706,11 +2436,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S74
 		-1, // S'
@@ -1727,18 +2467,28 @@ var gotoTab = gotoTable{
 		-1, // EnumValue
 		-1, // OptionValue
 		-1, // Option
-		47, // Message
+		-1, // Message
 		-1, // Fields
-		50, // FieldExpr
-		51, // FieldType
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S75
 		-1, // S'
 		-1, // ProtocolDefine
-		79, // OptEnd
+		-1, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1752,11 +2502,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S76
 		-1, // S'
@@ -1775,11 +2535,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S77
 		-1, // S'
@@ -1798,16 +2568,26 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S78
 		-1, // S'
 		-1, // ProtocolDefine
-		83, // OptEnd
+		-1, // OptEnd
 		-1, // Syntax
 		-1, // Package
 		-1, // Imports
@@ -1821,11 +2601,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S79
 		-1, // S'
@@ -1844,11 +2634,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S80
 		-1, // S'
@@ -1867,11 +2667,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S81
 		-1, // S'
@@ -1890,11 +2700,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S82
 		-1, // S'
@@ -1913,11 +2733,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S83
 		-1, // S'
@@ -1936,11 +2766,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S84
 		-1, // S'
@@ -1959,11 +2799,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S85
 		-1, // S'
@@ -1982,11 +2832,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S86
 		-1, // S'
@@ -2005,11 +2865,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S87
 		-1, // S'
@@ -2028,11 +2898,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S88
 		-1, // S'
@@ -2051,11 +2931,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S89
 		-1, // S'
@@ -2074,11 +2964,21 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 	gotoRow{ // S90
 		-1, // S'
@@ -2097,10 +2997,2561 @@ var gotoTab = gotoTable{
 		-1, // Option
 		-1, // Message
 		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S91
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		105, // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S92
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S93
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S94
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		107, // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S95
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		108, // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S96
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S97
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S98
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		109, // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S99
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S100
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S101
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S102
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		114, // MethodArg
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		44, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		45, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S108
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		49, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		50, // Message
+		-1, // Fields
+		53, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		56, // Oneof
+		-1, // OneofFields
+		55, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		59, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		119, // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		120, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S110
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		122, // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S111
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S115
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S116
+		-1,  // S'
+		-1,  // ProtocolDefine
+		128, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S117
+		-1,  // S'
+		-1,  // ProtocolDefine
+		130, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S118
+		-1,  // S'
+		-1,  // ProtocolDefine
+		131, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S121
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		133, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S122
+		-1,  // S'
+		-1,  // ProtocolDefine
+		134, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S123
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		136, // FieldOptionList
+		137, // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S124
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		138, // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S127
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S138
+		-1,  // S'
+		-1,  // ProtocolDefine
+		146, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S144
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		154, // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S147
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		155, // MethodArg
+	},
+	gotoRow{ // S148
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		156, // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S150
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // ProtocolDefine
+		160, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S157
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		162, // FieldOptionList
+		137, // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S158
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		163, // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S163
+		-1,  // S'
+		-1,  // ProtocolDefine
+		166, // OptEnd
+		-1,  // Syntax
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // Enum
+		-1,  // EnumValues
+		-1,  // EnumValue
+		-1,  // OptionValue
+		-1,  // Option
+		-1,  // Message
+		-1,  // Fields
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOptions
+		-1,  // FieldOptionList
+		-1,  // FieldOptionExpr
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // Method
+		-1,  // MethodArg
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S166
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // Method
+		-1, // MethodArg
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Syntax
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // Enum
+		-1, // EnumValues
+		-1, // EnumValue
+		-1, // OptionValue
+		-1, // Option
+		-1, // Message
+		-1, // Fields
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
+		-1, // FieldOptions
+		-1, // FieldOptionList
+		-1, // FieldOptionExpr
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // Method
+		-1, // MethodArg
 	},
 }
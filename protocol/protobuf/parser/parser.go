@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 35
-	numStates      = 91
-	numSymbols     = 48
+	numProductions = 62
+	numStates      = 168
+	numSymbols     = 65
 )
 
 // Stack
@@ -183,40 +183,50 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Enum : "enum" tok_identifier "{" EnumValues "}" OptEnd	<< bridge.NewEnum(C, X[1], X[3]) >>`,
+		String: `Enum : "enum" tok_identifier "{" EnumValues "}" OptEnd	<< bridge.NewEnum(C, X[1], X[3], X[4]) >>`,
 		Id:         "Enum",
 		NTType:     9,
 		Index:      16,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewEnum(C, X[1], X[3])
+			return bridge.NewEnum(C, X[1], X[3], X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `EnumValues : empty	<<  >>`,
+		String: `EnumValues : empty	<< &ast.YTEnumDef{}, nil >>`,
 		Id:         "EnumValues",
 		NTType:     10,
 		Index:      17,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return &ast.YTEnumDef{}, nil
 		},
 	},
 	ProdTabEntry{
-		String: `EnumValues : EnumValues EnumValue	<< bridge.AppendOption(C, X[0], X[1]) >>`,
+		String: `EnumValues : EnumValues EnumValue	<< bridge.EnumElement(C, X[0], X[1]) >>`,
 		Id:         "EnumValues",
 		NTType:     10,
 		Index:      18,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.AppendOption(C, X[0], X[1])
+			return bridge.EnumElement(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumValues : EnumValues Reserved	<< bridge.EnumReserved(C, X[0], X[1]) >>`,
+		Id:         "EnumValues",
+		NTType:     10,
+		Index:      19,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.EnumReserved(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `EnumValue : tok_identifier OptionValue OptEnd	<< bridge.OptionExpr(C, X[0], X[1]) >>`,
 		Id:         "EnumValue",
 		NTType:     11,
-		Index:      19,
+		Index:      20,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.OptionExpr(C, X[0], X[1])
@@ -226,7 +236,7 @@ var productionsTable = ProdTab{
 		String: `OptionValue : empty	<<  >>`,
 		Id:         "OptionValue",
 		NTType:     12,
-		Index:      20,
+		Index:      21,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -236,7 +246,7 @@ var productionsTable = ProdTab{
 		String: `OptionValue : "=" tok_num	<< X[1], nil >>`,
 		Id:         "OptionValue",
 		NTType:     12,
-		Index:      21,
+		Index:      22,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -246,27 +256,27 @@ var productionsTable = ProdTab{
 		String: `Option : "option" tok_identifier "=" tok_literal ";"	<< bridge.ProtoNewOption(C, X[1], X[3]) >>`,
 		Id:         "Option",
 		NTType:     13,
-		Index:      22,
+		Index:      23,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ProtoNewOption(C, X[1], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `Message : "message" tok_identifier "{" Fields "}" OptEnd	<< bridge.NewMessage(C, X[1], X[3]) >>`,
+		String: `Message : "message" tok_identifier "{" Fields "}" OptEnd	<< bridge.NewMessage(C, X[1], X[3], X[4]) >>`,
 		Id:         "Message",
 		NTType:     14,
-		Index:      23,
+		Index:      24,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewMessage(C, X[1], X[3])
+			return bridge.NewMessage(C, X[1], X[3], X[4])
 		},
 	},
 	ProdTabEntry{
 		String: `Fields : empty	<< &ast.YTMessage{},nil >>`,
 		Id:         "Fields",
 		NTType:     15,
-		Index:      24,
+		Index:      25,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return &ast.YTMessage{},nil
@@ -276,7 +286,7 @@ var productionsTable = ProdTab{
 		String: `Fields : Fields FieldExpr	<< bridge.FieldField(C, X[0], X[1]) >>`,
 		Id:         "Fields",
 		NTType:     15,
-		Index:      25,
+		Index:      26,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.FieldField(C, X[0], X[1])
@@ -286,27 +296,267 @@ var productionsTable = ProdTab{
 		String: `Fields : Fields Message	<< bridge.FieldMessage(C, X[0], X[1]) >>`,
 		Id:         "Fields",
 		NTType:     15,
-		Index:      26,
+		Index:      27,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.FieldMessage(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `FieldExpr : FieldType tok_identifier "=" tok_num OptEnd	<< bridge.NewField(C,X[0], X[1], X[3], nil) >>`,
-		Id:         "FieldExpr",
+		String: `Fields : Fields Enum	<< bridge.FieldEnum(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     15,
+		Index:      28,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldEnum(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields Oneof	<< bridge.FieldOneof(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     15,
+		Index:      29,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOneof(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields Reserved	<< bridge.FieldReserved(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     15,
+		Index:      30,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldReserved(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Reserved : "reserved" ReservedRanges ";"	<< bridge.NewReserved(C, X[0], X[1]) >>`,
+		Id:         "Reserved",
 		NTType:     16,
-		Index:      27,
-		NumSymbols: 5,
+		Index:      31,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReserved(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Reserved : "reserved" ReservedNames ";"	<< bridge.NewReserved(C, X[0], X[1]) >>`,
+		Id:         "Reserved",
+		NTType:     16,
+		Index:      32,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReserved(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRanges : ReservedRange	<< bridge.AppendReservedRange(C, nil, X[0]) >>`,
+		Id:         "ReservedRanges",
+		NTType:     17,
+		Index:      33,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedRange(C, nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRanges : ReservedRanges "," ReservedRange	<< bridge.AppendReservedRange(C, X[0], X[2]) >>`,
+		Id:         "ReservedRanges",
+		NTType:     17,
+		Index:      34,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedRange(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRange : tok_num	<< bridge.NewReservedRange(C, X[0], nil, nil) >>`,
+		Id:         "ReservedRange",
+		NTType:     18,
+		Index:      35,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReservedRange(C, X[0], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRange : tok_num tok_identifier tok_num	<< bridge.NewReservedRange(C, X[0], X[1], X[2]) >>`,
+		Id:         "ReservedRange",
+		NTType:     18,
+		Index:      36,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReservedRange(C, X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRange : tok_num tok_identifier tok_identifier	<< bridge.NewReservedRange(C, X[0], X[1], X[2]) >>`,
+		Id:         "ReservedRange",
+		NTType:     18,
+		Index:      37,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReservedRange(C, X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedNames : tok_literal	<< bridge.AppendReservedName(C, nil, X[0]) >>`,
+		Id:         "ReservedNames",
+		NTType:     19,
+		Index:      38,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedName(C, nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedNames : ReservedNames "," tok_literal	<< bridge.AppendReservedName(C, X[0], X[2]) >>`,
+		Id:         "ReservedNames",
+		NTType:     19,
+		Index:      39,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedName(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Oneof : "oneof" tok_identifier "{" OneofFields "}" OptEnd	<< bridge.NewOneof(C, X[1], X[3], X[4]) >>`,
+		Id:         "Oneof",
+		NTType:     20,
+		Index:      40,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewOneof(C, X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `OneofFields : empty	<< &ast.YTOneof{}, nil >>`,
+		Id:         "OneofFields",
+		NTType:     21,
+		Index:      41,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTOneof{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `OneofFields : OneofFields FieldExpr	<< bridge.OneofField(C, X[0], X[1]) >>`,
+		Id:         "OneofFields",
+		NTType:     21,
+		Index:      42,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.OneofField(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldExpr : FieldType tok_identifier "=" tok_num FieldOptions OptEnd	<< bridge.NewField(C,X[0], X[1], X[3], X[4]) >>`,
+		Id:         "FieldExpr",
+		NTType:     22,
+		Index:      43,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewField(C,X[0], X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldExpr : "optional" FieldType tok_identifier "=" tok_num FieldOptions OptEnd	<< bridge.NewOptionalField(C,X[1], X[2], X[4], X[5]) >>`,
+		Id:         "FieldExpr",
+		NTType:     22,
+		Index:      44,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewOptionalField(C,X[1], X[2], X[4], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptions : empty	<<  >>`,
+		Id:         "FieldOptions",
+		NTType:     23,
+		Index:      45,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptions : "[" FieldOptionList "]"	<< X[1], nil >>`,
+		Id:         "FieldOptions",
+		NTType:     23,
+		Index:      46,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionList : FieldOptionExpr	<< bridge.AppendOption(C, nil, X[0]) >>`,
+		Id:         "FieldOptionList",
+		NTType:     24,
+		Index:      47,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendOption(C, nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionList : FieldOptionList "," FieldOptionExpr	<< bridge.AppendOption(C, X[0], X[2]) >>`,
+		Id:         "FieldOptionList",
+		NTType:     24,
+		Index:      48,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewField(C,X[0], X[1], X[3], nil)
+			return bridge.AppendOption(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionExpr : tok_identifier "=" tok_num	<< bridge.FieldOptionExpr(C, X[0], X[2]) >>`,
+		Id:         "FieldOptionExpr",
+		NTType:     25,
+		Index:      49,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOptionExpr(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionExpr : tok_identifier "=" tok_float	<< bridge.FieldOptionExpr(C, X[0], X[2]) >>`,
+		Id:         "FieldOptionExpr",
+		NTType:     25,
+		Index:      50,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOptionExpr(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionExpr : tok_identifier "=" tok_literal	<< bridge.FieldOptionExpr(C, X[0], X[2]) >>`,
+		Id:         "FieldOptionExpr",
+		NTType:     25,
+		Index:      51,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOptionExpr(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldOptionExpr : tok_identifier "=" tok_identifier	<< bridge.FieldOptionExpr(C, X[0], X[2]) >>`,
+		Id:         "FieldOptionExpr",
+		NTType:     25,
+		Index:      52,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOptionExpr(C, X[0], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `FieldType : "map" "<" tok_identifier "," tok_identifier ">"	<< bridge.MapType(C, X[2], X[4]) >>`,
 		Id:         "FieldType",
-		NTType:     17,
-		Index:      28,
+		NTType:     26,
+		Index:      53,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.MapType(C, X[2], X[4])
@@ -315,8 +565,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : "repeated" tok_identifier	<< bridge.ArrayType(C, X[1]) >>`,
 		Id:         "FieldType",
-		NTType:     17,
-		Index:      29,
+		NTType:     26,
+		Index:      54,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ArrayType(C, X[1])
@@ -325,51 +575,71 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : tok_identifier	<< bridge.BasicOrCustomType(C, X[0]) >>`,
 		Id:         "FieldType",
-		NTType:     17,
-		Index:      30,
+		NTType:     26,
+		Index:      55,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.BasicOrCustomType(C, X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Service : "service" tok_identifier "{" ServiceElements "}"	<< bridge.NewService(C, X[1], X[3]) >>`,
+		String: `Service : "service" tok_identifier "{" ServiceElements "}"	<< bridge.NewService(C, X[1], X[3], X[4]) >>`,
 		Id:         "Service",
-		NTType:     18,
-		Index:      31,
+		NTType:     27,
+		Index:      56,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewService(C, X[1], X[3])
+			return bridge.NewService(C, X[1], X[3], X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `ServiceElements : empty	<<  >>`,
+		String: `ServiceElements : empty	<< &ast.YTService{}, nil >>`,
 		Id:         "ServiceElements",
-		NTType:     19,
-		Index:      32,
+		NTType:     28,
+		Index:      57,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return &ast.YTService{}, nil
 		},
 	},
 	ProdTabEntry{
 		String: `ServiceElements : ServiceElements Method	<< bridge.ServiceMethod(C, X[0], X[1]) >>`,
 		Id:         "ServiceElements",
-		NTType:     19,
-		Index:      33,
+		NTType:     28,
+		Index:      58,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ServiceMethod(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Method : "rpc" tok_identifier "(" tok_identifier ")" "returns" "(" tok_identifier ")" "{" "}"	<< bridge.NewMethod(C, X[1], X[3], X[7], nil, nil) >>`,
+		String: `Method : "rpc" tok_identifier "(" MethodArg ")" "returns" "(" MethodArg ")" "{" "}"	<< bridge.NewMethod(C, X[1], X[3], X[7], nil, nil) >>`,
 		Id:         "Method",
-		NTType:     20,
-		Index:      34,
+		NTType:     29,
+		Index:      59,
 		NumSymbols: 11,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.NewMethod(C, X[1], X[3], X[7], nil, nil)
 		},
 	},
+	ProdTabEntry{
+		String: `MethodArg : tok_identifier	<< X[0], nil >>`,
+		Id:         "MethodArg",
+		NTType:     30,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodArg : "stream" tok_identifier	<< bridge.StreamArg(X[1]) >>`,
+		Id:         "MethodArg",
+		NTType:     30,
+		Index:      61,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.StreamArg(X[1])
+		},
+	},
 }
@@ -22,9 +22,15 @@ var TokMap = token.NewTokenMap(
 		"tok_num",
 		"option",
 		"message",
+		"reserved",
+		",",
+		"oneof",
+		"optional",
+		"[",
+		"]",
+		"tok_float",
 		"map",
 		"<",
-		",",
 		">",
 		"repeated",
 		"service",
@@ -32,6 +38,7 @@ var TokMap = token.NewTokenMap(
 		"(",
 		")",
 		"returns",
+		"stream",
 		"tok_doc",
 	},
 	map[string]token.Type{
@@ -51,16 +58,23 @@ var TokMap = token.NewTokenMap(
 		"tok_num":        13,
 		"option":         14,
 		"message":        15,
-		"map":            16,
-		"<":              17,
-		",":              18,
-		">":              19,
-		"repeated":       20,
-		"service":        21,
-		"rpc":            22,
-		"(":              23,
-		")":              24,
-		"returns":        25,
-		"tok_doc":        26,
+		"reserved":       16,
+		",":              17,
+		"oneof":          18,
+		"optional":       19,
+		"[":              20,
+		"]":              21,
+		"tok_float":      22,
+		"map":            23,
+		"<":              24,
+		">":              25,
+		"repeated":       26,
+		"service":        27,
+		"rpc":            28,
+		"(":              29,
+		")":              30,
+		"returns":        31,
+		"stream":         32,
+		"tok_doc":        33,
 	},
 )
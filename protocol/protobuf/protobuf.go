@@ -9,11 +9,16 @@ import (
 )
 
 func Parse(file string, src []byte) (_ *ast.YTProgram, err error) {
+	return ParseContext(&ast.Context{}, file, src)
+}
+
+// ParseContext 使用指定的上下文解析文件. 上下文可以设置递归分析接口
+func ParseContext(ctx *ast.Context, file string, src []byte) (_ *ast.YTProgram, err error) {
 	l := lexer.NewLexer(src)
 	l.Context = &lexer.SourceContext{Filepath: file}
 
-	ctx := &ast.Context{
-		Prog: &ast.YTProgram{},
+	if ctx.Prog == nil {
+		ctx.Prog = &ast.YTProgram{}
 	}
 
 	p := parser.NewParser()
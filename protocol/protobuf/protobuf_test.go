@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/walleframe/wctl/builder/buildpb"
 	"github.com/walleframe/wctl/protocol/ast"
+	"github.com/walleframe/wctl/protocol/protobuf/bridge"
 )
 
 func TestParse(t *testing.T) {
@@ -71,32 +72,45 @@ message m2 {
 				Pkg: &buildpb.PackageDesc{
 					Package: "test",
 					Doc: &buildpb.DocDesc{
-						Doc:     []string{"// package doc\n"},
-						TailDoc: "// package tail doc\n",
+						Doc:     []string{"// package doc"},
+						TailDoc: "// package tail doc",
 					},
 				},
 				Options: &buildpb.OptionDesc{
 					Options: map[string]*buildpb.OptionValue{
 						"proto.gopkg": {
 							Value: "xxx.xx/xx/xx",
+							Pos: &buildpb.SourcePos{
+								File:   "test.wproto",
+								Line:   13,
+								Column: 8,
+								Path:   "Options/proto.gopkg",
+							},
 						},
 						"proto.syntax": {
 							IntValue: 3,
+							Kind:     buildpb.OptionKind_OptionInt,
+							Pos: &buildpb.SourcePos{
+								File:   "test.wproto",
+								Line:   1,
+								Column: 10,
+								Path:   "Options/proto.syntax",
+							},
 						},
 					},
 				},
 				Imports: []*buildpb.ImportDesc{
 					{
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// import doc 1\n"},
-							TailDoc: "// import tail doc\n",
+							Doc:     []string{"// import doc 1"},
+							TailDoc: "// import tail doc",
 						},
 						Alias: "",
 						File:  "abc",
 					},
 					{
 						Doc: &buildpb.DocDesc{
-							Doc: []string{"// import doc 2\n"},
+							Doc: []string{"// import doc 2"},
 						},
 						Alias: "abc2",
 						File:  "abc2",
@@ -106,43 +120,63 @@ message m2 {
 					{
 						Name: "e1",
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// enum doc\n"},
-							TailDoc: "// enum tail doc \n",
+							Doc:     []string{"// enum doc"},
+							TailDoc: "// enum tail doc",
 						},
 						Options: &buildpb.OptionDesc{},
 						Values: []*buildpb.EnumValue{
 							{
 								Name: "v1",
 								Doc: &buildpb.DocDesc{
-									Doc:     []string{"// enum value doc \n"},
-									TailDoc: "// enum value tail doc\n",
+									Doc:     []string{"// enum value doc"},
+									TailDoc: "// enum value tail doc",
 								},
 								Value: 1,
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   19,
+									Column: 5,
+									Path:   "Enums/e1/Values/v1",
+								},
 							},
 							{
 								Name: "v2",
 								Doc: &buildpb.DocDesc{
-									TailDoc: "// hex enum value\n",
+									TailDoc: "// hex enum value",
 								},
 								Value: 3,
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   21,
+									Column: 5,
+									Path:   "Enums/e1/Values/v2",
+								},
 							},
 						},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      16,
+							Column:    6,
+							EndLine:   22,
+							EndColumn: 1,
+							Path:      "Enums/e1",
+						},
 					},
 				},
 				Msgs: []*buildpb.MsgDesc{
 					{
 						Name: "m1",
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// message doc\n"},
-							TailDoc: "// message tail doc\n",
+							Doc:     []string{"// message doc"},
+							TailDoc: "// message tail doc",
 						},
 						Options: &buildpb.OptionDesc{},
 						Fields: []*buildpb.Field{
 							{
 								Name: "f1",
 								Doc: &buildpb.DocDesc{
-									Doc:     []string{"// field doc\n"},
-									TailDoc: "// field tail doc \n",
+									Doc:     []string{"// field doc"},
+									TailDoc: "// field tail doc",
 								},
 								Options: &buildpb.OptionDesc{},
 								No:      1,
@@ -151,11 +185,17 @@ message m2 {
 									Key:     "int32",
 									KeyBase: buildpb.BaseTypeDesc_Int32,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   31,
+									Column: 11,
+									Path:   "Msgs/m1/Fields/f1",
+								},
 							},
 							{
 								Name: "f2",
 								Doc: &buildpb.DocDesc{
-									Doc: []string{"// field 2 doc \n"},
+									Doc: []string{"// field 2 doc"},
 								},
 								Options: &buildpb.OptionDesc{},
 								No:      2,
@@ -164,6 +204,12 @@ message m2 {
 									Key:     "int64",
 									KeyBase: buildpb.BaseTypeDesc_Int64,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   34,
+									Column: 11,
+									Path:   "Msgs/m1/Fields/f2",
+								},
 							},
 							{
 								Name: "f3",
@@ -174,9 +220,23 @@ message m2 {
 									KeyBase: buildpb.BaseTypeDesc_Int32,
 								},
 								Options: &buildpb.OptionDesc{},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   35,
+									Column: 20,
+									Path:   "Msgs/m1/Fields/f3",
+								},
 							},
 						},
 						SubMsgs: []*buildpb.MsgDesc{},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      28,
+							Column:    9,
+							EndLine:   36,
+							EndColumn: 1,
+							Path:      "Msgs/m1",
+						},
 					},
 					{
 						Name:    "m2",
@@ -191,6 +251,12 @@ message m2 {
 									Key:        "m1",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   39,
+									Column: 8,
+									Path:   "Msgs/m2/Fields/f1",
+								},
 							},
 							{
 								Name:    "f2",
@@ -201,6 +267,12 @@ message m2 {
 									Key:        "abc.abc",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   43,
+									Column: 13,
+									Path:   "Msgs/m2/Fields/f2",
+								},
 							},
 							{
 								Name:    "f3",
@@ -211,6 +283,12 @@ message m2 {
 									Key:        "m3",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   44,
+									Column: 8,
+									Path:   "Msgs/m2/Fields/f3",
+								},
 							},
 						},
 						SubMsgs: []*buildpb.MsgDesc{
@@ -227,11 +305,33 @@ message m2 {
 											Key:     "int32",
 											KeyBase: buildpb.BaseTypeDesc_Int32,
 										},
+										Pos: &buildpb.SourcePos{
+											File:   "test.wproto",
+											Line:   41,
+											Column: 15,
+											Path:   "Msgs/m2/SubMsgs/m3/Fields/f1",
+										},
 									},
 								},
 								SubMsgs: []*buildpb.MsgDesc{},
+								Pos: &buildpb.SourcePos{
+									File:      "test.wproto",
+									Line:      40,
+									Column:    13,
+									EndLine:   42,
+									EndColumn: 5,
+									Path:      "Msgs/m2/SubMsgs/m3",
+								},
 							},
 						},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      38,
+							Column:    9,
+							EndLine:   45,
+							EndColumn: 1,
+							Path:      "Msgs/m2",
+						},
 					},
 				},
 			},
@@ -262,3 +362,151 @@ message m2 {
 		})
 	}
 }
+
+func TestParseOneof(t *testing.T) {
+	prog, err := Parse("test.proto", []byte(`syntax = "proto3";
+package test;
+message event {
+	int64 uid = 1;
+	oneof payload {
+		string text = 2;
+		int32 code = 3;
+	}
+}
+`))
+	if !assert.Nil(t, err, "parse oneof") {
+		return
+	}
+	assert.Nil(t, prog.AnalyseProgram(), "analyse oneof")
+	msg := prog.Messages[0]
+	if assert.Len(t, msg.Oneofs, 1, "oneof") {
+		assert.Equal(t, "payload", msg.Oneofs[0].Name, "oneof name")
+		assert.Len(t, msg.Oneofs[0].Fields, 2, "oneof fields")
+	}
+	assert.Len(t, msg.Fields, 3, "message fields")
+}
+
+func TestParseFieldDefault(t *testing.T) {
+	prog, err := Parse("test.proto", []byte(`syntax = "proto3";
+package test;
+enum color { RED = 0; BLUE = 1; }
+message item {
+	optional int32 hp = 1;
+	int32 lv = 2 [default = 10];
+	float speed = 3 [default = 1.5, packed = true];
+	color c = 4 [default = BLUE];
+	string name = 5 [default = "none"];
+}
+`))
+	if !assert.Nil(t, err, "parse field default") {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse field default %v", err) {
+		return
+	}
+	fields := prog.Messages[0].Fields
+	assert.True(t, fields[0].Optional, "optional")
+	assert.False(t, fields[1].Optional, "not optional")
+	assert.EqualValues(t, 10, *fields[1].Default.Value.IntVal, "int default")
+	assert.EqualValues(t, 1.5, *fields[2].Default.Value.FloatVal, "float default")
+	assert.Len(t, fields[2].Opts, 1, "other field options")
+	assert.EqualValues(t, 1, *fields[3].Default.Value.IntVal, "enum default")
+	assert.Equal(t, "none", *fields[4].Default.Value.Value, "string default")
+
+	prog, err = Parse("test.proto", []byte("syntax = \"proto3\";\npackage test;\nmessage m { int32 v = 1 [default = \"a\"]; }\n"))
+	if assert.Nil(t, err, "parse invalid default") {
+		assert.NotNil(t, prog.AnalyseProgram(), "invalid default type")
+	}
+}
+
+func TestParseOptionKind(t *testing.T) {
+	prog, err := Parse("test.proto", []byte(`syntax = "proto2";
+package test;
+option go_package = "test/pb";
+message m {
+	sint32 a = 1;
+	fixed64 b = 2;
+	sfixed32 c = 3;
+}
+`))
+	if !assert.Nil(t, err, "parse option kind") {
+		return
+	}
+	syntax := prog.GetOptionValue(bridge.ProtobufOptionSyntax)
+	if assert.NotNil(t, syntax, "syntax option") {
+		assert.Equal(t, ast.OptionValueInt, syntax.Kind, "syntax kind")
+		assert.EqualValues(t, 2, *syntax.IntVal, "syntax version")
+	}
+	assert.Equal(t, ast.OptionValueString, prog.GetOptionValue("proto.gopkg").Kind, "string option kind")
+	fields := prog.Messages[0].Fields
+	for _, v := range []struct {
+		field *ast.YTField
+		key   string
+	}{
+		{fields[0], bridge.ProtobufOptionSigned},
+		{fields[1], bridge.ProtobufOptionFixed},
+		{fields[2], bridge.ProtobufOptionSigned},
+		{fields[2], bridge.ProtobufOptionFixed},
+	} {
+		val := v.field.GetOptionValue(v.key)
+		if assert.NotNil(t, val, "%s %s", v.field.Name, v.key) {
+			assert.Equal(t, ast.OptionValueInt, val.Kind, "%s %s kind", v.field.Name, v.key)
+			assert.True(t, v.field.GetOptionBool(v.key), "%s %s value", v.field.Name, v.key)
+		}
+	}
+}
+
+func TestParseStreamMethod(t *testing.T) {
+	prog, err := Parse("test.proto", []byte(`syntax = "proto3";
+package test;
+message rq { int32 a = 1; }
+message rs { int32 b = 1; }
+service svc {
+	rpc call(rq) returns (rs) {}
+	rpc watch(rq) returns (stream rs) {}
+	rpc upload(stream rq) returns (rs) {}
+	rpc chat(stream rq) returns (stream rs) {}
+}
+`))
+	if !assert.Nil(t, err, "parse stream method %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse stream method %v", err) {
+		return
+	}
+	methods := prog.Services[0].Methods
+	assert.Equal(t, ast.Call, methods[0].Flag, "call")
+	assert.Equal(t, ast.ServerStream, methods[1].Flag, "server stream")
+	assert.Equal(t, ast.ClientStream, methods[2].Flag, "client stream")
+	assert.Equal(t, ast.BidiStream, methods[3].Flag, "bidi stream")
+	assert.Equal(t, "rs", methods[1].Reply.Name, "stream reply name")
+
+	desc := prog.GetFileDesc().Services[0].Methods
+	assert.Equal(t, buildpb.MethodType_BidiStream, desc[3].Type, "method type desc")
+	assert.True(t, desc[1].IsStream(), "is stream")
+	assert.False(t, desc[0].IsStream(), "not stream")
+}
+
+func TestParseEndPos(t *testing.T) {
+	prog, err := Parse("test.proto", []byte(`syntax = "proto3";
+package test;
+enum kind {
+	normal = 0;
+}
+message rq {
+	oneof v {
+		int32 a = 1;
+	}
+}
+service svc {
+	rpc call(rq) returns (rq) {}
+}
+`))
+	if !assert.Nil(t, err, "parse %v", err) {
+		return
+	}
+	assert.Equal(t, 5, prog.EnumDefs[0].EndPos.Line, "enum end")
+	assert.Equal(t, 10, prog.Messages[0].EndPos.Line, "message end")
+	assert.Equal(t, 9, prog.Messages[0].Oneofs[0].EndPos.Line, "oneof end")
+	assert.Equal(t, 13, prog.Services[0].EndPos.Line, "service end")
+}
@@ -0,0 +1,144 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// 源文件查找结果
+type sourceFile struct {
+	// 文件所在的查找根目录
+	root string
+	// 相对根目录的文件名. 用于输出路径计算
+	file string
+	// 文件唯一名称. 文件系统使用文件系统内路径, 否则使用绝对路径
+	full string
+	// 内存覆盖文件数据
+	overlay []byte
+}
+
+// 查找根目录. BasePath 在前, ImportPaths 按顺序在后
+func (l *Loader) searchRoots() (roots []string) {
+	check := make(map[string]struct{})
+	for _, v := range append([]string{l.cc.BasePath}, l.cc.ImportPaths...) {
+		if l.cc.FS != nil {
+			v = cleanPath(v)
+			if v == "" || strings.HasPrefix(v, "/") {
+				v = "."
+			}
+		} else if abs, err := filepath.Abs(v); err == nil {
+			v = abs
+		}
+		if _, ok := check[v]; ok {
+			continue
+		}
+		check[v] = struct{}{}
+		roots = append(roots, v)
+	}
+	return
+}
+
+func (l *Loader) join(root, file string) string {
+	if l.cc.FS != nil {
+		return path.Join(root, cleanPath(file))
+	}
+	return filepath.Join(root, file)
+}
+
+func (l *Loader) exists(full string) bool {
+	var err error
+	if l.cc.FS != nil {
+		_, err = fs.Stat(l.cc.FS, full)
+	} else {
+		_, err = os.Stat(full)
+	}
+	return err == nil
+}
+
+// resolve 在查找目录中按顺序查找文件. 同一文件在多个目录中存在时, 使用第一个并记录警告.
+func (l *Loader) resolve(file string) (src *sourceFile, err error) {
+	// 绝对路径. 计算相对所在查找目录的路径
+	if l.cc.FS == nil && filepath.IsAbs(file) {
+		for _, root := range l.roots {
+			rel, err := filepath.Rel(root, file)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				continue
+			}
+			return l.source(root, rel, file), nil
+		}
+		return l.source(filepath.Dir(file), filepath.Base(file), file), nil
+	}
+
+	var found []*sourceFile
+	for _, root := range l.roots {
+		full := l.join(root, file)
+		if l.exists(full) {
+			found = append(found, l.source(root, file, full))
+		}
+	}
+	// 内存覆盖文件
+	if data, ok := l.overlay[cleanPath(file)]; ok {
+		if len(found) == 0 {
+			found = append(found, l.source(l.roots[0], file, l.join(l.roots[0], file)))
+		}
+		found[0].overlay = data
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("file [%s] not found in import paths %v", file, l.roots)
+	}
+	if len(found) > 1 {
+		list := make([]string, 0, len(found))
+		for _, v := range found {
+			list = append(list, v.full)
+		}
+		l.warn(fmt.Errorf("file [%s] found in multiple import paths %v, use %s", file, list, found[0].full))
+	}
+	return found[0], nil
+}
+
+func (l *Loader) source(root, file, full string) *sourceFile {
+	src := &sourceFile{
+		root: root,
+		file: file,
+		full: full,
+	}
+	if l.cc.FS == nil {
+		if abs, err := filepath.Abs(full); err == nil {
+			src.full = abs
+		}
+	}
+	if data, ok := l.overlay[cleanPath(src.full)]; ok {
+		src.overlay = data
+	}
+	return src
+}
+
+// 读取文件. 优先使用内存覆盖文件
+func (l *Loader) readFile(src *sourceFile) ([]byte, error) {
+	if src.overlay != nil {
+		return src.overlay, nil
+	}
+	if l.cc.FS != nil {
+		return fs.ReadFile(l.cc.FS, src.full)
+	}
+	return os.ReadFile(src.full)
+}
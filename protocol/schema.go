@@ -0,0 +1,208 @@
+/*
+Copyright © 2020 aggronmagi <czy463@163.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package protocol
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/walleframe/wctl/protocol/ast"
+	"github.com/walleframe/wctl/protocol/token"
+	"gopkg.in/yaml.v3"
+)
+
+// yaml 选项定义文件格式
+//
+//	namespace: example
+//	doc: 选项说明
+//	options:
+//	  - key: opt1
+//	    type: bool
+//	    scope: [file, message]
+//	    default: true
+//	    doc: 选项说明
+type yamlOptionSchema struct {
+	Namespace string `yaml:"namespace"`
+	Doc       string `yaml:"doc"`
+	Options   []struct {
+		Key     string    `yaml:"key"`
+		Type    string    `yaml:"type"`
+		Scope   []string  `yaml:"scope"`
+		Default yaml.Node `yaml:"default"`
+		Doc     string    `yaml:"doc"`
+	} `yaml:"options"`
+}
+
+// yaml 文件位置
+type yamlSource string
+
+func (s yamlSource) Source() string {
+	return string(s)
+}
+
+// ParseOptionSchema 解析yaml格式的选项定义
+func ParseOptionSchema(file string, data []byte) (schema *ast.YTOptionSchema, err error) {
+	var root yaml.Node
+	if err = yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parse option schema [%s] failed. %w", file, err)
+	}
+	cfg := &yamlOptionSchema{}
+	if err = root.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("parse option schema [%s] failed. %w", file, err)
+	}
+	pos := func(node *yaml.Node) token.Pos {
+		return token.Pos{Line: node.Line, Column: node.Column, Context: yamlSource(file)}
+	}
+	schema = &ast.YTOptionSchema{
+		YTDoc:     yamlDoc(cfg.Doc),
+		Namespace: cfg.Namespace,
+	}
+	if len(root.Content) > 0 {
+		schema.DefPos = pos(root.Content[0])
+	}
+	// 选项定义项位置
+	var items []*yaml.Node
+	if len(root.Content) > 0 {
+		for i := 0; i+1 < len(root.Content[0].Content); i += 2 {
+			if root.Content[0].Content[i].Value == "options" {
+				items = root.Content[0].Content[i+1].Content
+			}
+		}
+	}
+	for k, v := range cfg.Options {
+		item := &ast.YTOptionSchemaItem{
+			YTDoc: yamlDoc(v.Doc),
+			Key:   v.Key,
+		}
+		if k < len(items) {
+			item.DefPos = pos(items[k])
+		}
+		item.Type, err = ast.ParseOptionValueKind(v.Type)
+		if err != nil {
+			return nil, ast.NewErrorPos2(item.DefPos, err)
+		}
+		for _, name := range v.Scope {
+			scope, err := ast.ParseOptionScope(name)
+			if err != nil {
+				return nil, ast.NewErrorPos2(item.DefPos, err)
+			}
+			item.Scope |= scope
+		}
+		if !v.Default.IsZero() {
+			item.Default, err = yamlOptionValue(&v.Default)
+			if err != nil {
+				return nil, ast.NewErrorPos2(pos(&v.Default), err)
+			}
+		}
+		schema.Items = append(schema.Items, item)
+	}
+	if err = schema.Check(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func yamlDoc(doc string) *ast.YTDoc {
+	if doc == "" {
+		return nil
+	}
+	return &ast.YTDoc{Doc: []string{doc}}
+}
+
+// yaml 节点转换为选项值
+func yamlOptionValue(node *yaml.Node) (val *ast.YTOptionValue, err error) {
+	val = &ast.YTOptionValue{}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!bool":
+			b, v := node.Value == "true", int64(0)
+			if b {
+				v = 1
+			}
+			val.Kind, val.BoolVal, val.IntVal = ast.OptionValueBool, &b, &v
+		case "!!int":
+			v, err := strconv.ParseInt(node.Value, 0, 64)
+			if err != nil {
+				return nil, err
+			}
+			val.Kind, val.IntVal = ast.OptionValueInt, &v
+		case "!!float":
+			v, err := strconv.ParseFloat(node.Value, 64)
+			if err != nil {
+				return nil, err
+			}
+			val.Kind, val.FloatVal = ast.OptionValueFloat, &v
+		default:
+			v := node.Value
+			val.Kind, val.Value = ast.OptionValueString, &v
+		}
+	case yaml.SequenceNode:
+		val.Kind, val.List = ast.OptionValueList, []*ast.YTOptionValue{}
+		for _, v := range node.Content {
+			item, err := yamlOptionValue(v)
+			if err != nil {
+				return nil, err
+			}
+			val.List = append(val.List, item)
+		}
+	case yaml.MappingNode:
+		val.Kind = ast.OptionValueObject
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			item, err := yamlOptionValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			val.Object = append(val.Object, &ast.YTOptionField{
+				Key:   node.Content[i].Value,
+				Value: item,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("option default value invalid")
+	}
+	return
+}
+
+// 加载选项定义文件
+func (l *Loader) loadOptionSchemas() (err error) {
+	if l.schemaLoaded {
+		return
+	}
+	for _, file := range l.cc.OptionSchemaFiles {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(l.cc.BasePath, file)
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read option schema failed. %w", err)
+		}
+		schema, err := ParseOptionSchema(file, data)
+		if err != nil {
+			return err
+		}
+		for _, v := range l.flag.OptionSchemas {
+			if v.Namespace == schema.Namespace {
+				return fmt.Errorf("option schema namespace [%s] repeated. %s %s", v.Namespace, v.DefPos.String(), schema.DefPos.String())
+			}
+		}
+		l.flag.OptionSchemas = append(l.flag.OptionSchemas, schema)
+	}
+	l.schemaLoaded = true
+	return
+}
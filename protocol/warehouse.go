@@ -16,13 +16,9 @@ limitations under the License.
 package protocol
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/walleframe/wctl/protocol/ast"
 	"github.com/walleframe/wctl/protocol/protobuf"
 	"github.com/walleframe/wctl/protocol/wproto"
-	"github.com/walleframe/wctl/utils"
 )
 
 type Parser interface {
@@ -37,55 +33,51 @@ func (f ParseFunc) Parse(file string, input []byte) (prog *ast.YTProgram, err er
 
 var _ Parser = ParseFunc(nil)
 
-type astItem struct {
-	FullName string
-	FileName string
-	// RelName  string
-	Ast *ast.YTProgram
+// ContextParser 使用解析上下文的解析器. 加载器通过上下文传递递归分析接口
+type ContextParser interface {
+	Parser
+	ParseContext(ctx *ast.Context, file string, input []byte) (prog *ast.YTProgram, err error)
 }
 
-// 已分析文件仓库
-type warehouse struct {
-	full          map[string]*astItem
-	file          map[string]*astItem
-	path          string
-	startWorkPath string
-	parsers       map[string]Parser
+type ContextParseFunc func(ctx *ast.Context, file string, input []byte) (prog *ast.YTProgram, err error)
+
+func (f ContextParseFunc) Parse(file string, input []byte) (prog *ast.YTProgram, err error) {
+	return f(&ast.Context{}, file, input)
 }
 
-// 全局仓库
-var gWarehouse = &warehouse{
-	full: make(map[string]*astItem),
-	file: make(map[string]*astItem),
+func (f ContextParseFunc) ParseContext(ctx *ast.Context, file string, input []byte) (prog *ast.YTProgram, err error) {
+	return f(ctx, file, input)
 }
 
-func init() {
-	path, err := os.Getwd()
-	if err != nil {
-		utils.PanicIf(fmt.Errorf("获取运行目录出错.%w", err))
-	}
-	gWarehouse.startWorkPath = path
-	gWarehouse.path = path
-	gWarehouse.parsers = make(map[string]Parser)
+var _ ContextParser = ContextParseFunc(nil)
+
+// 内置解析器
+var builtinParsers = map[string]Parser{
+	".wproto": ContextParseFunc(wproto.ParseContext),
+	".proto":  ContextParseFunc(protobuf.ParseContext),
 }
 
-// SetBasePath 设置基础目录. 所有输入将基于这个目录进行查找.(会将目录切换到输入目录)
-func SetBasePath(path string) {
-	gWarehouse.path = path
+type astItem struct {
+	FullName string
+	FileName string
+	// RelName  string
+	Ast *ast.YTProgram
 }
 
-func RegisterParser(suffix string, parser Parser) {
-	gWarehouse.parsers[suffix] = parser
+// 已分析文件仓库
+type warehouse struct {
+	full map[string]*astItem
+	file map[string]*astItem
 }
 
-func GetParser(suffix string) Parser {
-	if val, ok := gWarehouse.parsers[suffix]; ok {
-		return val
+func newWarehouse() *warehouse {
+	return &warehouse{
+		full: make(map[string]*astItem),
+		file: make(map[string]*astItem),
 	}
-	return nil
 }
 
-func init() {
-	RegisterParser(".wproto", ParseFunc(wproto.Parse))
-	RegisterParser(".proto", ParseFunc(protobuf.Parse))
+func (w *warehouse) save(item *astItem) {
+	w.full[item.FullName] = item
+	w.file[item.FileName] = item
 }
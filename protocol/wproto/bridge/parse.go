@@ -2,7 +2,6 @@ package bridge
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 
@@ -65,17 +64,14 @@ func NewImport(c, pkg, alias interface{}) (imp *ast.YTImport, err error) {
 	}
 
 	// 解析依赖文件
-	if ast.RegisterRecursionAnalyser != nil {
-		prog, err := ast.RegisterRecursionAnalyser.Analyse(imp.File)
+	if ctx.Analyser != nil {
+		prog, err := ctx.Analyser.Analyse(imp.File)
 		if err != nil {
 			return nil, ast.NewError(tokPkg, "import file failed %+v", err)
 		}
-		// 保存依赖
+		// 保存依赖. 未获取时由调用方分析
 		imp.Prog = prog
-		//
-		utils.Debugln("RecursionAnalyse true", prog.Pkg.Name)
-	} else {
-		utils.Debugln("RecursionAnalyse false")
+		utils.Debugln("RecursionAnalyse", imp.File, prog != nil)
 	}
 
 	ctx.LastElement = imp
@@ -84,16 +80,17 @@ func NewImport(c, pkg, alias interface{}) (imp *ast.YTImport, err error) {
 	return
 }
 
-// Enum: "enum" tok_identifier "{" Options "}" OptEnd	<< bridge.NewEnum($Context, $1, $3) >>
-func NewEnum(c, en, evs interface{}) (def *ast.YTEnumDef, err error) {
+// Enum: "enum" tok_identifier "{" EnumElements "}" OptEnd	<< bridge.NewEnum($Context, $1, $3) >>
+func NewEnum(c, en, evs, end interface{}) (def *ast.YTEnumDef, err error) {
 	ctx := c.(*ast.Context)
 	tokName := en.(*token.Token)
-	opts := evs.(*ast.YTOptions)
-	def = &ast.YTEnumDef{
-		YTDoc:  ctx.PreDoc(tokName.Line),
-		Name:   tokName.IDValue(),
-		DefPos: tokName.Pos,
-	}
+	def = evs.(*ast.YTEnumDef)
+	opts := def.YTOptions
+	def.Opts = nil
+	def.YTDoc = ctx.PreDoc(tokName.Line)
+	def.Name = tokName.IDValue()
+	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
 	err = checkNormalIdentifier(def.Name, "enum name define")
 	if err != nil {
 		return nil, ast.NewError2(tokName, err)
@@ -101,35 +98,46 @@ func NewEnum(c, en, evs interface{}) (def *ast.YTEnumDef, err error) {
 
 	// 将所有option拆分成enum定义和option选项定义
 	enumValue := int64(-1)
+	// 引用常量的枚举值及其后的自增值在分析阶段计算
+	afterMacro := false
 	valCheck := make(map[int64][]string)
 	for _, v := range opts.Opts {
 		// 枚举值
 		if checkNormalIdentifier(v.Key, "") == nil {
-			if v.Value != nil {
-				if v.Value.IntVal != nil {
-					// 使用设置的枚举值
-					enumValue = *v.Value.IntVal
-				} else if v.Value.Value != nil {
-					// 枚举值设置了string类型
-					return nil, ast.NewErrorPos(v.DefPos, "enum value [%s.%s=%s] invalid", def.Name, v.Key, *v.Value.Value)
-				} else {
-					// 未设置任何值, 自增
-					enumValue++
-				}
-			} else {
-				// 未设置任何值, 自增
-				enumValue++
-			}
-			def.Values = append(def.Values, &ast.YTEnumValue{
+			ev := &ast.YTEnumValue{
 				YTDoc:  v.YTDoc,
 				DefPos: v.DefPos,
 				Name:   v.Key,
-				Value:  enumValue,
-			})
-			valCheck[enumValue] = append(valCheck[enumValue], v.Key)
+			}
+			ev.AddTags(ctx.EnumTags[v]...)
+			switch {
+			case v.Value != nil && v.Value.Macro != nil:
+				// 引用常量
+				ev.Macro = v.Value.Macro
+				afterMacro = true
+			case v.Value != nil && v.Value.IntVal != nil:
+				// 使用设置的枚举值
+				enumValue = *v.Value.IntVal
+				afterMacro = false
+			case v.Value != nil && v.Value.Value != nil:
+				// 枚举值设置了string类型
+				return nil, ast.NewErrorPos(v.DefPos, "enum value [%s.%s=%s] invalid", def.Name, v.Key, *v.Value.Value)
+			default:
+				// 未设置任何值, 自增
+				enumValue++
+				ev.Auto = true
+			}
+			ev.Value = enumValue
+			def.Values = append(def.Values, ev)
+			if !afterMacro {
+				valCheck[enumValue] = append(valCheck[enumValue], v.Key)
+			}
 			continue
 		} else if checkOptionName(v.Key, "") == nil {
 			// 选项设置
+			if tags := ctx.EnumTags[v]; len(tags) > 0 {
+				return nil, ast.NewErrorPos(tags[0].DefPos, "enum option [%s.%s] not support target tag", def.Name, v.Key)
+			}
 			def.Opts = append(def.Opts, v)
 		} else {
 			// key 无效
@@ -158,18 +166,56 @@ func NewEnum(c, en, evs interface{}) (def *ast.YTEnumDef, err error) {
 	return
 }
 
-// Message: "message" tok_identifier "{" Fields "}" OptEnd  << bridge.NewMessage($Context, $1, $3) >>
-func NewMessage(c, mn, fvs interface{}) (def *ast.YTMessage, err error) {
+// EnumElements: EnumElements OptionExpr	<< bridge.EnumElement($Context, $0, $1) >>
+func EnumElement(c, a0, a1 interface{}) (def *ast.YTEnumDef, err error) {
+	def = a0.(*ast.YTEnumDef)
+	// 枚举值和选项在 NewEnum 中拆分
+	def.Opts = append(def.Opts, a1.(*ast.YTOption))
+	return
+}
+
+// EnumElements: EnumElements Tags OptionExpr	<< bridge.TaggedEnumElement($Context, $0, $1, $2) >>
+func TaggedEnumElement(c, a0, a1, a2 interface{}) (def *ast.YTEnumDef, err error) {
+	ctx := c.(*ast.Context)
+	tags := a1.([]*ast.YTTag)
+	opt := a2.(*ast.YTOption)
+	if ctx.EnumTags == nil {
+		ctx.EnumTags = make(map[*ast.YTOption][]*ast.YTTag)
+	}
+	ctx.EnumTags[opt] = tags
+	if opt.YTDoc == nil {
+		opt.YTDoc = ctx.PreDoc(tags[0].DefPos.Line)
+	}
+	return EnumElement(c, a0, a2)
+}
+
+// EnumElements: EnumElements Reserved	<< bridge.EnumReserved($Context, $0, $1) >>
+func EnumReserved(c, a0, a1 interface{}) (def *ast.YTEnumDef, err error) {
+	def = a0.(*ast.YTEnumDef)
+	rs := a1.(*ast.YTReserved)
+	def.Reserved.Ranges = append(def.Reserved.Ranges, rs.Ranges...)
+	def.Reserved.Names = append(def.Reserved.Names, rs.Names...)
+	return
+}
+
+// Message: "message" tok_identifier MethodNo "{" Fields "}" OptEnd  << bridge.NewMessage($Context, $1, $2, $4) >>
+func NewMessage(c, mn, no, fvs, end interface{}) (def *ast.YTMessage, err error) {
 	ctx := c.(*ast.Context)
 	tokName := mn.(*token.Token)
 	def = fvs.(*ast.YTMessage)
 	def.YTDoc = ctx.PreDoc(tokName.Line)
 	def.Name = tokName.IDValue()
 	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
 	err = checkNormalIdentifier(def.Name, "enum name define")
 	if err != nil {
 		return nil, ast.NewError2(tokName, err)
 	}
+	// 消息ID
+	def.ID, err = methodNo(tokName, no)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.LastElement = def
 	ctx.Prog.Messages = append(ctx.Prog.Messages, def)
@@ -242,6 +288,279 @@ func FieldMessage(c, m, v interface{}) (msg *ast.YTMessage, err error) {
 	return
 }
 
+// Fields Enum	<< bridge.FieldEnum($Context, $0, $1) >>
+func FieldEnum(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	ctx := c.(*ast.Context)
+	msg = m.(*ast.YTMessage)
+	sub := v.(*ast.YTEnumDef)
+	msg.SubEnums = append(msg.SubEnums, sub)
+	// 修复 NewEnum 导致的重复添加
+	for k, v := range ctx.Prog.EnumDefs {
+		if v == sub {
+			ctx.Prog.EnumDefs = append(ctx.Prog.EnumDefs[:k], ctx.Prog.EnumDefs[k+1:]...)
+			break
+		}
+	}
+	ctx.LastElement = sub
+	return
+}
+
+// Fields Oneof	<< bridge.FieldOneof($Context, $0, $1) >>
+func FieldOneof(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	ctx := c.(*ast.Context)
+	msg = m.(*ast.YTMessage)
+	oneof := v.(*ast.YTOneof)
+	msg.Oneofs = append(msg.Oneofs, oneof)
+	// 联合字段同时作为消息字段
+	msg.Fields = append(msg.Fields, oneof.Fields...)
+	ctx.LastElement = oneof
+	return
+}
+
+// Oneof: "oneof" tok_identifier "{" OneofFields "}" OptEnd	<< bridge.NewOneof($Context, $1, $3) >>
+func NewOneof(c, a1, a3, end interface{}) (def *ast.YTOneof, err error) {
+	ctx := c.(*ast.Context)
+	tokName := a1.(*token.Token)
+	def = a3.(*ast.YTOneof)
+	def.YTDoc = ctx.PreDoc(tokName.Line)
+	def.Name = tokName.IDValue()
+	def.DefPos = tokName.Pos
+	def.EndPos = end.(*token.Token).Pos
+	err = checkNormalIdentifier(def.Name, "oneof name define")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	for _, field := range def.Fields {
+		field.Oneof = def
+	}
+	ctx.LastElement = def
+	return
+}
+
+// OneofFields FieldExpr	<< bridge.OneofField($Context, $0, $1) >>
+func OneofField(c, a0, a1 interface{}) (def *ast.YTOneof, err error) {
+	ctx := c.(*ast.Context)
+	def = a0.(*ast.YTOneof)
+	field := a1.(*ast.YTField)
+	def.Fields = append(def.Fields, field)
+	ctx.LastElement = field
+	return
+}
+
+// OneofFields OptionExpr	<< bridge.OneofOption($Context, $0, $1) >>
+func OneofOption(c, a0, a1 interface{}) (def *ast.YTOneof, err error) {
+	ctx := c.(*ast.Context)
+	def = a0.(*ast.YTOneof)
+	opt := a1.(*ast.YTOption)
+	// check option name
+	err = checkOptionName(opt.Key, "message oneof option name")
+	if err != nil {
+		return nil, ast.NewErrorPos2(opt.DefPos, err)
+	}
+	def.Opts = append(def.Opts, opt)
+	ctx.LastElement = opt
+	return
+}
+
+// Fields Reserved	<< bridge.FieldReserved($Context, $0, $1) >>
+func FieldReserved(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	msg = m.(*ast.YTMessage)
+	rs := v.(*ast.YTReserved)
+	msg.Reserved.Ranges = append(msg.Reserved.Ranges, rs.Ranges...)
+	msg.Reserved.Names = append(msg.Reserved.Names, rs.Names...)
+	return
+}
+
+// Tags: Tags tok_tag	<< bridge.AppendTag($0, $1) >>
+func AppendTag(a0, a1 interface{}) (tags []*ast.YTTag, err error) {
+	if a0 != nil {
+		tags = a0.([]*ast.YTTag)
+	}
+	tok := a1.(*token.Token)
+	name := strings.TrimPrefix(tok.IDValue(), "@")
+	for _, v := range tags {
+		if v.Name == name {
+			return nil, ast.NewError(tok, "target tag [%s] repeated", name)
+		}
+	}
+	tags = append(tags, &ast.YTTag{DefPos: tok.Pos, Name: name})
+	return
+}
+
+// TaggedMessage: Tags Message	<< bridge.SetTags($Context, $0, $1) >>
+func SetTags(c, a0, a1 interface{}) (_ interface{}, err error) {
+	ctx := c.(*ast.Context)
+	tags := a0.([]*ast.YTTag)
+	var doc **ast.YTDoc
+	switch v := a1.(type) {
+	case *ast.YTMessage:
+		v.AddTags(tags...)
+		doc = &v.YTDoc
+	case *ast.YTField:
+		v.AddTags(tags...)
+		doc = &v.YTDoc
+	case *ast.YTService:
+		v.AddTags(tags...)
+		doc = &v.YTDoc
+		for _, method := range v.Methods {
+			// 方法只在服务的目标中生成
+			if len(method.Tags) > 0 && len(intersectTags(method.Tags, tags)) == 0 {
+				return nil, ast.NewErrorPos(method.DefPos, "service %s method %s tags %v not match service tags %v",
+					v.Name, method.Name, method.TagNames(), v.TagNames())
+			}
+			// 参数列表生成的消息同时使用服务的标记
+			for _, msg := range []*ast.YTMessage{method.ParamRequest, method.ParamReply} {
+				if msg == nil {
+					continue
+				}
+				if len(msg.Tags) == 0 {
+					msg.AddTags(tags...)
+				} else {
+					msg.Tags = intersectTags(msg.Tags, tags)
+				}
+			}
+		}
+	case *ast.YTMethod:
+		v.AddTags(tags...)
+		doc = &v.YTDoc
+		// 参数列表生成的消息使用方法的标记
+		for _, msg := range []*ast.YTMessage{v.ParamRequest, v.ParamReply} {
+			if msg != nil {
+				msg.AddTags(tags...)
+			}
+		}
+	}
+	// 标记单独一行时, 注释在标记之前
+	if *doc == nil {
+		*doc = ctx.PreDoc(tags[0].DefPos.Line)
+	}
+	return a1, nil
+}
+
+// 同时设置在两个列表中的标记
+func intersectTags(tags, outer []*ast.YTTag) (list []*ast.YTTag) {
+	for _, v := range tags {
+		for _, o := range outer {
+			if v.Name == o.Name {
+				list = append(list, v)
+				break
+			}
+		}
+	}
+	return
+}
+
+// Fields Include	<< bridge.FieldInclude($Context, $0, $1) >>
+func FieldInclude(c, m, v interface{}) (msg *ast.YTMessage, err error) {
+	ctx := c.(*ast.Context)
+	msg = m.(*ast.YTMessage)
+	inc := v.(*ast.YTInclude)
+	msg.Includes = append(msg.Includes, inc)
+	ctx.LastElement = inc
+	return
+}
+
+// Include: "include" tok_identifier "=" tok_num OptEnd	<< bridge.NewInclude($Context, $0, $1, $3) >>
+func NewInclude(c, a0, a1, a3 interface{}) (inc *ast.YTInclude, err error) {
+	ctx := c.(*ast.Context)
+	tok := a0.(*token.Token)
+	tokName := a1.(*token.Token)
+	// 嵌入定义不保存注释
+	ctx.PreDoc(tok.Line)
+	inc = &ast.YTInclude{
+		DefPos:       tokName.Pos,
+		YTCustomType: &ast.YTCustomType{Name: tokName.IDValue()},
+	}
+	if a3 == nil {
+		return
+	}
+	tokNo := a3.(*token.Token)
+	offset, err := tokenInt64(tokNo)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || offset > ast.FieldNoMax {
+		return nil, ast.NewError(tokNo, "include [%s] offset [%d] invalid", inc.Name, offset)
+	}
+	inc.Offset = int32(offset)
+	return
+}
+
+// Reserved: "reserved" ReservedRanges ";"	<< bridge.NewReserved($Context, $0, $1) >>
+func NewReserved(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	ctx := c.(*ast.Context)
+	tok := a0.(*token.Token)
+	rs = a1.(*ast.YTReserved)
+	// 保留定义不保存注释
+	ctx.PreDoc(tok.Line)
+	ctx.LastElement = rs
+	return
+}
+
+// ReservedRanges: ReservedRanges "," ReservedRange	<< bridge.AppendReservedRange($Context, $0, $2) >>
+func AppendReservedRange(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	if a0 == nil {
+		rs = &ast.YTReserved{}
+	} else {
+		rs = a0.(*ast.YTReserved)
+	}
+	rs.Ranges = append(rs.Ranges, a1.(*ast.YTReservedRange))
+	return
+}
+
+// ReservedRange: tok_num tok_identifier tok_num	<< bridge.NewReservedRange($Context, $0, $1, $2) >>
+func NewReservedRange(c, a0, a1, a2 interface{}) (rg *ast.YTReservedRange, err error) {
+	tokStart := a0.(*token.Token)
+	rg = &ast.YTReservedRange{
+		DefPos: tokStart.Pos,
+	}
+	rg.Start, err = tokenInt64(tokStart)
+	if err != nil {
+		return nil, err
+	}
+	// 单个序号
+	if a1 == nil {
+		rg.End = rg.Start
+		return
+	}
+	if tok := a1.(*token.Token); tok.IDValue() != "to" {
+		return nil, ast.NewError(tok, "reserved range need 'to', got [%s]", tok.IDValue())
+	}
+	tokEnd := a2.(*token.Token)
+	if tokEnd.IDValue() == "max" {
+		rg.End = ast.ReservedMax
+		return
+	}
+	rg.End, err = tokenInt64(tokEnd)
+	if err != nil {
+		return nil, err
+	}
+	if rg.End < rg.Start {
+		return nil, ast.NewError(tokEnd, "reserved range end [%d] less than start [%d]", rg.End, rg.Start)
+	}
+	return
+}
+
+// ReservedNames: ReservedNames "," tok_literal	<< bridge.AppendReservedName($Context, $0, $2) >>
+func AppendReservedName(c, a0, a1 interface{}) (rs *ast.YTReserved, err error) {
+	if a0 == nil {
+		rs = &ast.YTReserved{}
+	} else {
+		rs = a0.(*ast.YTReserved)
+	}
+	tok := a1.(*token.Token)
+	name := tok.StringValue()
+	err = checkNormalIdentifier(name, "reserved name")
+	if err != nil {
+		return nil, ast.NewError2(tok, err)
+	}
+	rs.Names = append(rs.Names, &ast.YTReservedName{
+		DefPos: tok.Pos,
+		Name:   name,
+	})
+	return
+}
+
 // OptionExpr: tok_identifier OptionValue OptEnd << bridge.OptionExpr($Context, $0, $1) >>
 func OptionExpr(c, n, v interface{}) (opt *ast.YTOption, err error) {
 	ctx := c.(*ast.Context)
@@ -249,51 +568,116 @@ func OptionExpr(c, n, v interface{}) (opt *ast.YTOption, err error) {
 	optName := tokName.IDValue()
 	// e1 := checkNormalIdentifier(optName, "enum value name")
 	// e2 := checkOptionName(optName, "option name")
+	val, ok := v.(*ast.YTOptionValue)
+	if !ok {
+		return nil, ast.NewError(tokName, "value invalid. %#v", v)
+	}
 	opt = &ast.YTOption{
 		YTDoc:  ctx.PreDoc(tokName.Line),
 		DefPos: tokName.Pos,
 		Key:    optName,
-		Value:  &ast.YTOptionValue{},
-	}
-	switch val := v.(type) {
-	case bool:
-		v := int64(0)
-		if val {
-			v = 1
-		}
-		opt.Value.IntVal = &v
-	case int:
-		v := int64(val)
-		opt.Value.IntVal = &v
-	case *token.Token:
-		if strings.HasPrefix(val.IDValue(), `"`) {
-			v := val.StringValue()
-			opt.Value.Value = &v
-		} else {
-			v := strings.TrimPrefix(val.IDValue(), "+")
-			// number
-			if strings.HasPrefix(v, "0x") {
-				num, err := strconv.ParseInt(strings.TrimPrefix(v, "0x"), 16, 64)
-				if err != nil {
-					return nil, ast.NewError(val, "hex value [%s] invalid.%+v", v, err)
-				}
-				opt.Value.IntVal = &num
-			} else {
-				num, err := strconv.ParseInt(v, 10, 64)
-				if err != nil {
-					return nil, ast.NewError(val, "number value [%s] invalid.%+v", v, err)
-				}
-				opt.Value.IntVal = &num
-			}
-		}
-	default:
-		return nil, ast.NewError(tokName, "value invalid. %#v", val)
+		Value:  val,
 	}
 	ctx.LastElement = opt
 	utils.Debugln("new optionexpr")
 	return
 }
 
+// ValueExpr: "true" | "false"
+func BoolValue(b bool) (*ast.YTOptionValue, error) {
+	// 兼容旧的使用方式. 同时设置整数值
+	v := int64(0)
+	if b {
+		v = 1
+	}
+	return &ast.YTOptionValue{
+		Kind:    ast.OptionValueBool,
+		BoolVal: &b,
+		IntVal:  &v,
+	}, nil
+}
+
+// ValueExpr: tok_num
+func IntValue(a0 interface{}) (*ast.YTOptionValue, error) {
+	v, err := tokenInt64(a0.(*token.Token))
+	if err != nil {
+		return nil, err
+	}
+	return &ast.YTOptionValue{
+		Kind:   ast.OptionValueInt,
+		IntVal: &v,
+	}, nil
+}
+
+// ValueExpr: tok_float
+func FloatValue(a0 interface{}) (*ast.YTOptionValue, error) {
+	tok := a0.(*token.Token)
+	v, err := strconv.ParseFloat(tok.IDValue(), 64)
+	if err != nil {
+		return nil, ast.NewError(tok, "float value [%s] invalid.%+v", tok.IDValue(), err)
+	}
+	return &ast.YTOptionValue{
+		Kind:     ast.OptionValueFloat,
+		FloatVal: &v,
+	}, nil
+}
+
+// ValueExpr: tok_literal
+func StringValue(a0 interface{}) (*ast.YTOptionValue, error) {
+	v := a0.(*token.Token).StringValue()
+	return &ast.YTOptionValue{
+		Kind:  ast.OptionValueString,
+		Value: &v,
+	}, nil
+}
+
+// ValueExpr: tok_identifier. 引用常量, 分析阶段填充
+func ConstRefValue(c, a0 interface{}) (*ast.YTOptionValue, error) {
+	ref, err := ConstRef(c, a0)
+	if err != nil {
+		return nil, err
+	}
+	return &ast.YTOptionValue{
+		Macro: ref,
+	}, nil
+}
+
+// ValueList: ValueExpr | ValueList "," ValueExpr
+func ListValue(a0, a1 interface{}) (list *ast.YTOptionValue, err error) {
+	if a0 == nil {
+		list = &ast.YTOptionValue{
+			Kind: ast.OptionValueList,
+			List: []*ast.YTOptionValue{},
+		}
+	} else {
+		list = a0.(*ast.YTOptionValue)
+	}
+	if a1 != nil {
+		list.List = append(list.List, a1.(*ast.YTOptionValue))
+	}
+	return
+}
+
+// ValueFields: ValueFields tok_identifier ":" ValueExpr OptComma
+func ObjectValue(a0, a1, a3 interface{}) (obj *ast.YTOptionValue, err error) {
+	if a0 == nil {
+		return &ast.YTOptionValue{
+			Kind: ast.OptionValueObject,
+		}, nil
+	}
+	obj = a0.(*ast.YTOptionValue)
+	tok := a1.(*token.Token)
+	if obj.Field(tok.IDValue()) != nil {
+		return nil, ast.NewError(tok, "object option value key [%s] repeated", tok.IDValue())
+	}
+	obj.Object = append(obj.Object, &ast.YTOptionField{
+		DefPos: tok.Pos,
+		Key:    tok.IDValue(),
+		Value:  a3.(*ast.YTOptionValue),
+	})
+	return
+}
+
 // FieldExpr: FieldType tok_identifier "=" tok_num FieldOption OptEnd << bridge.NewField($Context,$0, $1, $3, $4) >>
 func NewField(c, a0, a1, a3, a4 interface{}) (field *ast.YTField, err error) {
 	ctx := c.(*ast.Context)
@@ -301,24 +685,26 @@ func NewField(c, a0, a1, a3, a4 interface{}) (field *ast.YTField, err error) {
 	tokName := a1.(*token.Token)
 	tokNum := a3.(*token.Token)
 
-	num, err := tokNum.Int32Value()
+	num, err := tokenInt64(tokNum)
 	if err != nil {
-		return nil, ast.NewError2(tokNum, err)
+		return nil, err
 	}
-
-	if num > math.MaxUint8 || num < 0 {
-		return nil, ast.NewError(tokNum, "field no must between 1 and 255")
+	if err = ast.CheckFieldNo(num); err != nil {
+		return nil, ast.NewError2(tokNum, err)
 	}
 
 	field = &ast.YTField{
 		YTDoc:  ctx.PreDoc(tokName.Line),
 		DefPos: tokName.Pos,
 		Name:   tokName.IDValue(),
-		No:     uint8(num),
+		No:     int32(num),
 	}
 	if a4 != nil {
 		field.YTOptions.Opts = a4.(*ast.YTOptions).Opts
 	}
+	if err = field.SplitFieldOptions(); err != nil {
+		return nil, err
+	}
 
 	field.Type = fieldType
 
@@ -326,6 +712,183 @@ func NewField(c, a0, a1, a3, a4 interface{}) (field *ast.YTField, err error) {
 	return
 }
 
+// FieldExpr: "optional" FieldType tok_identifier "=" tok_num FieldOption OptEnd << bridge.NewOptionalField($Context,$1, $2, $4, $5) >>
+func NewOptionalField(c, a1, a2, a4, a5 interface{}) (field *ast.YTField, err error) {
+	field, err = NewField(c, a1, a2, a4, a5)
+	if err != nil {
+		return
+	}
+	field.Optional = true
+	return
+}
+
+// OptionValue: "=" tok_identifier	<< bridge.ConstRef($Context, $1) >>
+func ConstRef(c, a1 interface{}) (ref *ast.YTCustomType, err error) {
+	tok := a1.(*token.Token)
+	if !strings.Contains(tok.IDValue(), ".") {
+		return nil, ast.NewError(tok, "const reference [%s] invalid, need const.value or pkg.const.value", tok.IDValue())
+	}
+	ref = &ast.YTCustomType{
+		Name: tok.IDValue(),
+	}
+	return
+}
+
+// OptionSchema: "option_schema" tok_identifier "{" SchemaItems "}" OptEnd	<< bridge.NewOptionSchema($Context, $1, $3) >>
+func NewOptionSchema(c, a1, a3 interface{}) (schema *ast.YTOptionSchema, err error) {
+	ctx := c.(*ast.Context)
+	tokName := a1.(*token.Token)
+	schema = a3.(*ast.YTOptionSchema)
+	schema.YTDoc = ctx.PreDoc(tokName.Line)
+	schema.DefPos = tokName.Pos
+	schema.Namespace = tokName.IDValue()
+	err = checkNormalIdentifier(schema.Namespace, "option schema namespace")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+
+	ctx.LastElement = schema
+	ctx.Prog.OptionSchemas = append(ctx.Prog.OptionSchemas, schema)
+	return
+}
+
+// SchemaItems: SchemaItems tok_identifier tok_identifier SchemaScope SchemaDefault OptEnd	<< bridge.OptionSchemaItem($Context, $0, $1, $2, $3, $4) >>
+func OptionSchemaItem(c, a0, a1, a2, a3, a4 interface{}) (schema *ast.YTOptionSchema, err error) {
+	ctx := c.(*ast.Context)
+	schema = a0.(*ast.YTOptionSchema)
+	tokName := a1.(*token.Token)
+	tokType := a2.(*token.Token)
+	item := &ast.YTOptionSchemaItem{
+		YTDoc:  ctx.PreDoc(tokName.Line),
+		DefPos: tokName.Pos,
+		Key:    tokName.IDValue(),
+	}
+	item.Type, err = ast.ParseOptionValueKind(tokType.IDValue())
+	if err != nil {
+		return nil, ast.NewError2(tokType, err)
+	}
+	if a3 != nil {
+		item.Scope = a3.(ast.OptionScope)
+	}
+	if a4 != nil {
+		item.Default = a4.(*ast.YTOptionValue)
+	}
+	schema.Items = append(schema.Items, item)
+	ctx.LastElement = item
+	return
+}
+
+// SchemaScopes: tok_identifier | SchemaScopes "," tok_identifier
+func AppendScope(a0, a1 interface{}) (scope ast.OptionScope, err error) {
+	tok := a1.(*token.Token)
+	v, err := ast.ParseOptionScope(tok.IDValue())
+	if err != nil {
+		return 0, ast.NewError2(tok, err)
+	}
+	if a0 != nil {
+		scope = a0.(ast.OptionScope)
+	}
+	return scope | v, nil
+}
+
+// TypeAlias: tok_identifier tok_identifier "=" tok_identifier OptEnd	<< bridge.NewTypeAlias($Context, $0, $1, $3) >>
+func NewTypeAlias(c, a0, a1, a3 interface{}) (def *ast.YTTypeAlias, err error) {
+	ctx := c.(*ast.Context)
+	tokKey := a0.(*token.Token)
+	tokName := a1.(*token.Token)
+	tokType := a3.(*token.Token)
+	if tokKey.IDValue() != "type" {
+		return nil, ast.NewError(tokKey, "invalid define [%s], need type alias define: type name = basic_type", tokKey.IDValue())
+	}
+	err = checkNormalIdentifier(tokName.IDValue(), "type alias name")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	if typ, _ := analyseType(tokName.IDValue(), ""); typ == nil || typ.YTBaseType != nil {
+		return nil, ast.NewError(tokName, "type alias name [%s] invalid, can not use basic type name", tokName.IDValue())
+	}
+	def = &ast.YTTypeAlias{
+		YTDoc:  ctx.PreDoc(tokName.Line),
+		DefPos: tokName.Pos,
+		Name:   tokName.IDValue(),
+	}
+	typ, err := analyseType(tokType.IDValue(), "type alias type")
+	if err != nil {
+		return nil, ast.NewError2(tokType, err)
+	}
+	// 引用其他别名时在分析阶段解析
+	if typ.YTBaseType != nil {
+		def.Base = typ.YTBaseType
+	} else {
+		def.Type = typ.YTCustomType.Name
+	}
+
+	ctx.LastElement = def
+	ctx.Prog.Aliases = append(ctx.Prog.Aliases, def)
+	return
+}
+
+// Const: "const" tok_identifier tok_identifier "{" ConstValues "}" OptEnd	<< bridge.NewConst($Context, $1, $2, $4) >>
+func NewConst(c, a1, a2, a4 interface{}) (def *ast.YTConst, err error) {
+	ctx := c.(*ast.Context)
+	tokName := a1.(*token.Token)
+	tokType := a2.(*token.Token)
+	def = a4.(*ast.YTConst)
+	def.YTDoc = ctx.PreDoc(tokName.Line)
+	def.DefPos = tokName.Pos
+	def.Name = tokName.IDValue()
+	err = checkConstIdentifier(def.Name, "const name define")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	typ, err := analyseType(tokType.IDValue(), "const type")
+	if err != nil {
+		return nil, ast.NewError2(tokType, err)
+	}
+	switch typ.YTBaseType {
+	case ast.BaseTypeInt8, ast.BaseTypeUint8, ast.BaseTypeInt16, ast.BaseTypeUint16,
+		ast.BaseTypeInt32, ast.BaseTypeUint32, ast.BaseTypeInt64, ast.BaseTypeUint64,
+		ast.BaseTypeString:
+		def.Type = typ.YTBaseType
+	default:
+		return nil, ast.NewError(tokType, "const type [%s] invalid, need integer or string", tokType.IDValue())
+	}
+
+	ctx.LastElement = def
+	ctx.Prog.Consts = append(ctx.Prog.Consts, def)
+	return
+}
+
+// ConstValues: ConstValues tok_identifier "=" tok_num OptEnd	<< bridge.ConstValue($Context, $0, $1, $3) >>
+func ConstValue(c, a0, a1, a3 interface{}) (def *ast.YTConst, err error) {
+	ctx := c.(*ast.Context)
+	def = a0.(*ast.YTConst)
+	tokName := a1.(*token.Token)
+	tokVal := a3.(*token.Token)
+	cv := &ast.YTConstValue{
+		YTDoc:  ctx.PreDoc(tokName.Line),
+		DefPos: tokName.Pos,
+		Name:   tokName.IDValue(),
+	}
+	err = checkConstIdentifier(cv.Name, "const value name")
+	if err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	if strings.HasPrefix(tokVal.IDValue(), `"`) || strings.HasPrefix(tokVal.IDValue(), "`") {
+		v := tokVal.StringValue()
+		cv.Value = &v
+	} else {
+		v, err := tokenInt64(tokVal)
+		if err != nil {
+			return nil, err
+		}
+		cv.IntVal = &v
+	}
+	def.Values = append(def.Values, cv)
+	ctx.LastElement = cv
+	return
+}
+
 // Options OptionExpr						<< bridge.AppendOption($Context, $0, $1) >>
 func AppendOption(c, a0, a1 interface{}) (val *ast.YTOptions, err error) {
 	// ctx := c.(*ast.Context)
@@ -361,6 +924,29 @@ func ArrayType(c, a0 interface{}) (_ *ast.YTFieldType, err error) {
 	}, nil
 }
 
+// FieldType: "[" tok_num "]" tok_identifier << bridge.FixedArrayType($Context, $1, $3) >>
+// FieldType: "[" tok_identifier "]" tok_identifier << bridge.FixedArrayType($Context, $1, $3) >>
+func FixedArrayType(c, a1, a3 interface{}) (typ *ast.YTFieldType, err error) {
+	typ, err = ArrayType(c, a3)
+	if err != nil {
+		return nil, err
+	}
+	tokSize := a1.(*token.Token)
+	// 引用常量, 分析阶段检测
+	if !strings.ContainsAny(tokSize.IDValue()[:1], "+-0123456789") {
+		typ.YTListType.SizeMacro, err = ConstRef(c, tokSize)
+		return
+	}
+	typ.YTListType.Size, err = tokenInt64(tokSize)
+	if err != nil {
+		return nil, err
+	}
+	if typ.YTListType.Size < 1 {
+		return nil, ast.NewError(tokSize, "fixed array size [%d] invalid, must be positive", typ.YTListType.Size)
+	}
+	return
+}
+
 // FieldType: "map" "<" tok_identifier "," tok_identifier ">" << bridge.MapType($Context, $2, $4) >>
 func MapType(c, a2, a4 interface{}) (_ *ast.YTFieldType, err error) {
 	// ctx := c.(*ast.Context)
@@ -375,8 +961,8 @@ func MapType(c, a2, a4 interface{}) (_ *ast.YTFieldType, err error) {
 		return nil, err
 	}
 
-	if key.YTBaseType == nil {
-		err = ast.NewError(tokKey, "field map key type must basic type")
+	if key.YTBaseType == nil && key.YTCustomType == nil {
+		err = ast.NewError(tokKey, "field map key type must basic type or type alias")
 		return
 	}
 	if value.YTBaseType == nil && value.YTCustomType == nil {
@@ -384,7 +970,7 @@ func MapType(c, a2, a4 interface{}) (_ *ast.YTFieldType, err error) {
 		return
 	}
 
-	return &ast.YTFieldType{
+	typ := &ast.YTFieldType{
 		YTMapTypee: &ast.YTMapTypee{
 			Key: key.YTBaseType,
 			Value: &ast.YTListType{
@@ -392,7 +978,15 @@ func MapType(c, a2, a4 interface{}) (_ *ast.YTFieldType, err error) {
 				YTCustomType: value.YTCustomType,
 			},
 		},
-	}, nil
+	}
+	// 键类型为别名时在分析阶段解析
+	if key.YTCustomType != nil {
+		typ.KeyAlias = &ast.YTTypeAlias{
+			DefPos: tokKey.Pos,
+			Name:   key.YTCustomType.Name,
+		}
+	}
+	return typ, nil
 }
 
 // FieldType: tok_identifier 	<< bridge.BasicOrCustomType($Context, $0) >>
@@ -402,11 +996,14 @@ func BasicOrCustomType(c, a0 interface{}) (*ast.YTFieldType, error) {
 	return analyseType(tokType.IDValue(), "field basic or custom type")
 }
 
-// Service: "service" tok_identifier  "{" ServiceElements "}" OptEnd << bridge.NewService($Context, $1, $3) >>
-func NewService(c, a1, a3 interface{}) (_ *ast.YTService, err error) {
+// Service: "service" tok_identifier MethodThrows "{" ServiceElements "}" OptEnd << bridge.NewService($Context, $1, $4, $2) >>
+func NewService(c, a1, a4, a2, end interface{}) (_ *ast.YTService, err error) {
 	ctx := c.(*ast.Context)
 	tokName := a1.(*token.Token)
-	svc := a3.(*ast.YTService)
+	svc := a4.(*ast.YTService)
+	if a2 != nil {
+		svc.Throws = a2.([]*ast.YTThrows)
+	}
 
 	err = checkNormalIdentifier(tokName.IDValue(), "service name")
 	if err != nil {
@@ -414,12 +1011,22 @@ func NewService(c, a1, a3 interface{}) (_ *ast.YTService, err error) {
 	}
 
 	svc.DefPos = tokName.Pos
+	svc.EndPos = end.(*token.Token).Pos
 	svc.Name = tokName.IDValue()
+	// 参数列表生成的消息使用服务名命名, 不同服务的同名方法不冲突
+	for _, m := range svc.Methods {
+		if m.ParamRequest != nil {
+			m.Request = paramBody(ctx, svc, m, m.ParamRequest, "rq")
+		}
+		if m.ParamReply != nil {
+			m.Reply = paramBody(ctx, svc, m, m.ParamReply, "rs")
+		}
+	}
 
 	ctx.Prog.Services = append(ctx.Prog.Services, svc)
 
 	ctx.LastElement = svc
-	return
+	return svc, nil
 }
 
 // ServiceElements: ServiceElements ServiceMethod << bridge.ServiceMethod($Context, $0, $1) >>
@@ -449,13 +1056,9 @@ func ServiceFlag(c, a0, a1 interface{}) (_ *ast.YTService, err error) {
 	ctx := c.(*ast.Context)
 	svc := a0.(*ast.YTService)
 	flag := a1.(*token.Token)
-	switch flag.IDValue() {
-	case "call":
-		svc.Flag = ast.Call
-	case "notify":
-		svc.Flag = ast.Notify
-	default:
-		return nil, ast.NewError(flag, "service flag invalid [%s]", flag.IDValue())
+	svc.Flag, err = ast.ParseMethodFlag(flag.IDValue())
+	if err != nil {
+		return nil, ast.NewError2(flag, err)
 	}
 	// 去除无用前置文档
 	ctx.PreDoc(flag.Line)
@@ -463,8 +1066,8 @@ func ServiceFlag(c, a0, a1 interface{}) (_ *ast.YTService, err error) {
 	return svc, nil
 }
 
-// ServiceMethod: tok_identifier "(" tok_identifier ")" tok_identifier MethodNo FieldOption OptEnd << bridge.NewMethod($Context, $0, $2, $4, $5, $6) >>
-func NewMethod(c, a0, a2, a4, a5, a6 interface{}) (m *ast.YTMethod, err error) {
+// ServiceMethod: tok_identifier "(" MethodRequest ")" MethodReply MethodThrows MethodNo FieldOption OptEnd << bridge.NewMethod($Context, $0, $2, $4, $5, $6, $7) >>
+func NewMethod(c, a0, a2, a4, a5, a6, a7 interface{}) (m *ast.YTMethod, err error) {
 	ctx := c.(*ast.Context)
 	tokFunc := a0.(*token.Token)
 
@@ -479,69 +1082,139 @@ func NewMethod(c, a0, a2, a4, a5, a6 interface{}) (m *ast.YTMethod, err error) {
 		Name:   tokFunc.IDValue(),
 	}
 
-	tokRQ := a2.(*token.Token)
+	m.Request, m.ParamRequest, err = methodBody(m, a2, "rq", "request")
+	if err != nil {
+		return nil, err
+	}
+	m.Reply, m.ParamReply, err = methodBody(m, a4, "rs", "reply")
+	if err != nil {
+		return nil, err
+	}
+	if a5 != nil {
+		m.Throws = a5.([]*ast.YTThrows)
+	}
+	// method no. 是否使用由分析参数决定
+	m.No, err = methodNo(a0.(*token.Token), a6)
+	if err != nil {
+		return nil, err
+	}
+	// options
+	if a7 != nil {
+		m.Opts = a7.(*ast.YTOptions).Opts
+	}
 
-	if tokRQ.IDValue() != "void" {
-		rqField, err := analyseType(tokRQ.IDValue(), "method request body")
-		if err != nil {
-			err = ast.NewError2(tokRQ, err)
-			return nil, err
-		}
-		if rqField.YTCustomType == nil {
-			err = ast.NewError(tokRQ, "method request body must be custom message type [%s]", tokRQ.IDValue())
-			return nil, err
-		}
-		m.Request = &ast.YTMessage{
-			Name:   tokRQ.IDValue(),
-			DefPos: tokRQ.Pos,
-			Fields: []*ast.YTField{
-				{Name: "rq", Type: rqField, No: 1},
-			},
-		}
+	ctx.LastElement = m
+	return
+}
+
+// ThrowsList: tok_identifier << bridge.AppendThrows(nil, $0) >>
+// ThrowsList: ThrowsList "," tok_identifier << bridge.AppendThrows($0, $2) >>
+func AppendThrows(a0, a1 interface{}) (list []*ast.YTThrows, err error) {
+	if a0 != nil {
+		list = a0.([]*ast.YTThrows)
 	}
+	tok := a1.(*token.Token)
+	list = append(list, &ast.YTThrows{
+		DefPos:       tok.Pos,
+		YTCustomType: &ast.YTCustomType{Name: tok.IDValue()},
+	})
+	return
+}
 
-	tokRS := a4.(*token.Token)
-	if tokRS.IDValue() != "void" {
-		rsField, err := analyseType(tokRS.IDValue(), "method reply body")
+// 方法请求/回复. 消息名或参数列表, 参数列表生成的消息在服务定义结束时命名
+func methodBody(m *ast.YTMethod, v interface{}, field, tip string) (body, params *ast.YTMessage, err error) {
+	switch val := v.(type) {
+	case *ast.YTMessage:
+		val.DefPos = m.DefPos
+		return nil, val, nil
+	case *token.Token:
+		name := val.IDValue()
+		if name == "void" {
+			return nil, nil, nil
+		}
+		typ, err := analyseType(name, "method "+tip+" body")
 		if err != nil {
-			err = ast.NewError2(tokRS, err)
-			return nil, err
+			return nil, nil, ast.NewError2(val, err)
 		}
-		if rsField.YTCustomType == nil {
-			err = ast.NewError(tokRS, "method reply body must be custom message type [%s]", tokRS.IDValue())
-			return nil, err
+		if typ.YTCustomType == nil {
+			return nil, nil, ast.NewError(val, "method %s body must be custom message type [%s]", tip, name)
 		}
+		return bodyRef(name, val.Pos, field), nil, nil
+	}
+	return nil, nil, nil
+}
 
-		m.Reply = &ast.YTMessage{
-			DefPos: tokRS.Pos,
-			Name:   tokRS.IDValue(),
-			Fields: []*ast.YTField{
-				{Name: "rs", Type: rsField, No: 1},
-			},
+// 参数列表生成的消息. 名称为 服务名_方法名_rq/服务名_方法名_rs
+func paramBody(ctx *ast.Context, svc *ast.YTService, m *ast.YTMethod, msg *ast.YTMessage, field string) *ast.YTMessage {
+	msg.Name = svc.Name + "_" + m.Name + "_" + field
+	ctx.Prog.Messages = append(ctx.Prog.Messages, msg)
+	return bodyRef(msg.Name, msg.DefPos, field)
+}
+
+// 方法请求/回复引用的消息. 分析阶段替换为实际定义
+func bodyRef(name string, pos token.Pos, field string) *ast.YTMessage {
+	return &ast.YTMessage{
+		Name:   name,
+		DefPos: pos,
+		Fields: []*ast.YTField{
+			{Name: field, Type: &ast.YTFieldType{YTCustomType: &ast.YTCustomType{Name: name}}, No: 1},
+		},
+	}
+}
+
+// MethodParams: FieldType tok_identifier << bridge.AppendParam($Context, nil, $0, $1, nil) >>
+// MethodParams: FieldType tok_identifier "=" tok_num << bridge.AppendParam($Context, nil, $0, $1, $3) >>
+// MethodParams: MethodParams "," FieldType tok_identifier << bridge.AppendParam($Context, $0, $2, $3, nil) >>
+// MethodParams: MethodParams "," FieldType tok_identifier "=" tok_num << bridge.AppendParam($Context, $0, $2, $3, $5) >>
+func AppendParam(c, a0, a1, a2, a3 interface{}) (msg *ast.YTMessage, err error) {
+	if a0 == nil {
+		msg = &ast.YTMessage{}
+	} else {
+		msg = a0.(*ast.YTMessage)
+	}
+	tokName := a2.(*token.Token)
+	if err = checkNormalIdentifier(tokName.IDValue(), "method param name"); err != nil {
+		return nil, ast.NewError2(tokName, err)
+	}
+	// 字段序号. 未设置时为前一个参数序号加1
+	no := int64(1)
+	if n := len(msg.Fields); n > 0 {
+		no = int64(msg.Fields[n-1].No) + 1
+	}
+	if a3 != nil {
+		if no, err = tokenInt64(a3.(*token.Token)); err != nil {
+			return nil, err
 		}
 	}
-	// method no.
-	if a5 != nil {
-		tokNo := a5.(*token.Token)
-		v, err := tokNo.Int64Value()
+	msg.Fields = append(msg.Fields, &ast.YTField{
+		DefPos: tokName.Pos,
+		Name:   tokName.IDValue(),
+		No:     int32(no),
+		Type:   a1.(*ast.YTFieldType),
+	})
+	return
+}
+
+// 接口序号(消息ID). 数值或常量引用
+func methodNo(tokName *token.Token, a interface{}) (*ast.YTMethodNo, error) {
+	switch no := a.(type) {
+	case *token.Token:
+		v, err := tokenInt64(no)
 		if err != nil {
-			return nil, ast.NewError2(tokNo, err)
-		}
-		// 仅在配置开启情况下保存数据
-		if ast.Flag.ServiceUseMethodID {
-			m.No = &ast.YTMethodNo{
-				DefPos: tokNo.Pos,
-				Value:  &v,
-			}
+			return nil, err
 		}
+		return &ast.YTMethodNo{
+			DefPos: no.Pos,
+			Value:  &v,
+		}, nil
+	case *ast.YTCustomType:
+		// 引用常量. 分析阶段填充
+		return &ast.YTMethodNo{
+			DefPos: tokName.Pos,
+			Macro:  no,
+		}, nil
 	}
-	// options
-	if a6 != nil {
-		m.Opts = a6.(*ast.YTOptions).Opts
-	}
-
-	ctx.LastElement = m
-	return
+	return nil, nil
 }
 
 // 项目定义
@@ -570,7 +1243,7 @@ func NewProject(c, a1, a3 interface{}) (proj *ast.YTProject, err error) {
 // ProjElements ProjArea					<< bridge.ProjectArea($Context, $0, $1) >>
 func ProjectArea(c, a0, a1 interface{}) (proj *ast.YTProject, err error) {
 	ctx := c.(*ast.Context)
-	proj = a1.(*ast.YTProject)
+	proj = a0.(*ast.YTProject)
 	area := a1.(*token.Token)
 
 	err = checkNormalIdentifier(area.IDValue(), "project area name")
@@ -587,11 +1260,14 @@ func ProjectArea(c, a0, a1 interface{}) (proj *ast.YTProject, err error) {
 // ProjElements OptionExpr				  	<< bridge.ProjectOption($Context, $0, $1) >>
 func ProjectOption(c, a0, a1 interface{}) (proj *ast.YTProject, err error) {
 	ctx := c.(*ast.Context)
-	proj = a1.(*ast.YTProject)
+	proj = a0.(*ast.YTProject)
 	opt := a1.(*ast.YTOption)
 
 	// if proj.Area == "" {}
 
+	if proj.Conf == nil {
+		proj.Conf = make(map[string]*ast.YTOptions)
+	}
 	if proj.Conf[proj.Area] == nil {
 		proj.Conf[proj.Area] = &ast.YTOptions{}
 	}
@@ -600,6 +1276,20 @@ func ProjectOption(c, a0, a1 interface{}) (proj *ast.YTProject, err error) {
 	return
 }
 
+// 解析数值(支持十六进制)
+func tokenInt64(tok *token.Token) (num int64, err error) {
+	v := strings.TrimPrefix(tok.IDValue(), "+")
+	if strings.HasPrefix(v, "0x") {
+		num, err = strconv.ParseInt(strings.TrimPrefix(v, "0x"), 16, 64)
+	} else {
+		num, err = strconv.ParseInt(v, 10, 64)
+	}
+	if err != nil {
+		return 0, ast.NewError(tok, "number value [%s] invalid.%+v", v, err)
+	}
+	return
+}
+
 // 检测是否是正常的标识符 a-z 0-9 _
 func checkNormalIdentifier(def, tip string) error {
 	for k, r := range def {
@@ -617,6 +1307,20 @@ func checkNormalIdentifier(def, tip string) error {
 	return nil
 }
 
+// 检测是否是合法的常量名 a-z A-Z 0-9 _
+func checkConstIdentifier(def, tip string) error {
+	for k, r := range def {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r == '_' && k > 0) {
+			continue
+		}
+		if k > 0 && r >= '0' && r <= '9' {
+			continue
+		}
+		return fmt.Errorf("%s invalid char '%c', const name need character first", tip, r)
+	}
+	return nil
+}
+
 // 检测是否是合法的option name定义
 func checkOptionName(def, tip string) error {
 	lastDot := -100
@@ -37,11 +37,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 27,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 28,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S6
@@ -49,7 +49,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 18,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S8
@@ -57,15 +57,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 15,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 15,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 25,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S12
@@ -73,35 +73,35 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 17,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 12,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 19,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 20,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 21,
+		Accept: 18,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 0,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S21
@@ -141,51 +141,51 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: 9,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 10,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 7,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: -1,
-		Ignore: "!unixcomment",
+		Accept: 11,
+		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 15,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 0,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!unixcomment",
 	},
 	ActionRow{ // S36
-		Accept: 0,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 15,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: 5,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 7,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S42
@@ -201,7 +201,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S45
-		Accept: 5,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S46
@@ -237,15 +237,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 0,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: 30,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 15,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S57
@@ -265,7 +265,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S61
-		Accept: 16,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S62
@@ -273,39 +273,39 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S63
-		Accept: 5,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S64
-		Accept: 5,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S65
-		Accept: 5,
+		Accept: 17,
 		Ignore: "",
 	},
 	ActionRow{ // S66
-		Accept: 5,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S67
-		Accept: 5,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S68
-		Accept: 5,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S69
-		Accept: 30,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 24,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 8,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S72
@@ -325,7 +325,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S76
-		Accept: 5,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S77
@@ -341,11 +341,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S80
-		Accept: 13,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S81
-		Accept: 14,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S82
@@ -373,23 +373,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S88
-		Accept: 5,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S89
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S90
-		Accept: 5,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S91
-		Accept: 26,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S92
-		Accept: 5,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S93
@@ -405,15 +405,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S96
-		Accept: 11,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S97
-		Accept: 4,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S98
-		Accept: 29,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S99
@@ -421,10 +421,210 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S100
-		Accept: 23,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S101
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 23,
+		Ignore: "",
+	},
+	ActionRow{ // S116
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S117
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S118
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S119
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S120
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S121
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S122
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S123
+		Accept: 6,
+		Ignore: "",
+	},
+	ActionRow{ // S124
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S125
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S126
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S127
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S128
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S129
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S130
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S131
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S132
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S133
+		Accept: 38,
+		Ignore: "",
+	},
+	ActionRow{ // S134
+		Accept: 27,
+		Ignore: "",
+	},
+	ActionRow{ // S135
+		Accept: 12,
+		Ignore: "",
+	},
+	ActionRow{ // S136
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S137
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S138
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S139
+		Accept: 25,
+		Ignore: "",
+	},
+	ActionRow{ // S140
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S141
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S142
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S143
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S144
+		Accept: 29,
+		Ignore: "",
+	},
+	ActionRow{ // S145
+		Accept: 33,
+		Ignore: "",
+	},
+	ActionRow{ // S146
+		Accept: 28,
+		Ignore: "",
+	},
+	ActionRow{ // S147
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S148
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S149
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S150
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S151
 		Accept: 22,
 		Ignore: "",
 	},
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 102
-	NumSymbols = 109
+	NumStates  = 152
+	NumSymbols = 169
 )
 
 type Lexer struct {
@@ -129,113 +129,173 @@ func (l *Lexer) Reset() {
 
 /*
 Lexer symbols:
-0: ';'
-1: 'p'
-2: 'a'
-3: 'c'
-4: 'k'
-5: 'a'
-6: 'g'
-7: 'e'
-8: 'i'
-9: 'm'
-10: 'p'
-11: 'o'
-12: 'r'
-13: 't'
-14: 'e'
-15: 'n'
-16: 'u'
-17: 'm'
-18: '{'
-19: '}'
-20: 'm'
-21: 'e'
-22: 's'
-23: 's'
-24: 'a'
-25: 'g'
+0: '@'
+1: '+'
+2: '-'
+3: '.'
+4: ';'
+5: 'p'
+6: 'a'
+7: 'c'
+8: 'k'
+9: 'a'
+10: 'g'
+11: 'e'
+12: 'i'
+13: 'm'
+14: 'p'
+15: 'o'
+16: 'r'
+17: 't'
+18: '='
+19: 'e'
+20: 'n'
+21: 'u'
+22: 'm'
+23: '{'
+24: '}'
+25: 'm'
 26: 'e'
-27: '='
-28: 't'
-29: 'r'
-30: 'u'
+27: 's'
+28: 's'
+29: 'a'
+30: 'g'
 31: 'e'
-32: 'f'
-33: 'a'
-34: 'l'
-35: 's'
-36: 'e'
-37: 'm'
-38: 'a'
-39: 'p'
-40: '<'
-41: ','
-42: '>'
-43: '['
-44: ']'
-45: 'r'
-46: 'e'
-47: 'p'
-48: 'e'
-49: 'a'
-50: 't'
-51: 'e'
-52: 'd'
-53: 's'
-54: 'e'
-55: 'r'
-56: 'v'
-57: 'i'
-58: 'c'
-59: 'e'
-60: 'c'
-61: 'a'
-62: 'l'
-63: 'l'
-64: ':'
-65: 'n'
-66: 'o'
-67: 't'
-68: 'i'
-69: 'f'
-70: 'y'
-71: '('
-72: ')'
-73: 'p'
-74: 'r'
-75: 'o'
-76: 'j'
-77: 'e'
-78: 'c'
-79: 't'
-80: '_'
-81: '.'
-82: '`'
-83: '`'
-84: '"'
-85: '"'
-86: '+'
-87: '-'
-88: '0'
-89: 'x'
-90: '/'
-91: '*'
-92: '*'
-93: '/'
-94: '/'
-95: '/'
-96: '\n'
-97: ' '
-98: '\t'
-99: '\n'
-100: '\r'
-101: '#'
-102: '\n'
-103: '0'-'9'
-104: 'a'-'z'
-105: 'A'-'Z'
-106: 'a'-'f'
-107: 'A'-'F'
-108: .
+32: 't'
+33: 'r'
+34: 'u'
+35: 'e'
+36: 'f'
+37: 'a'
+38: 'l'
+39: 's'
+40: 'e'
+41: '['
+42: ']'
+43: ','
+44: ':'
+45: 'o'
+46: 'p'
+47: 't'
+48: 'i'
+49: 'o'
+50: 'n'
+51: '_'
+52: 's'
+53: 'c'
+54: 'h'
+55: 'e'
+56: 'm'
+57: 'a'
+58: 'o'
+59: 'n'
+60: 'e'
+61: 'o'
+62: 'f'
+63: 's'
+64: 'e'
+65: 'r'
+66: 'v'
+67: 'i'
+68: 'c'
+69: 'e'
+70: 'p'
+71: 'r'
+72: 'o'
+73: 'j'
+74: 'e'
+75: 'c'
+76: 't'
+77: 'c'
+78: 'o'
+79: 'n'
+80: 's'
+81: 't'
+82: 'i'
+83: 'n'
+84: 'c'
+85: 'l'
+86: 'u'
+87: 'd'
+88: 'e'
+89: 'r'
+90: 'e'
+91: 's'
+92: 'e'
+93: 'r'
+94: 'v'
+95: 'e'
+96: 'd'
+97: 'o'
+98: 'p'
+99: 't'
+100: 'i'
+101: 'o'
+102: 'n'
+103: 'a'
+104: 'l'
+105: 'm'
+106: 'a'
+107: 'p'
+108: '<'
+109: '>'
+110: 'r'
+111: 'e'
+112: 'p'
+113: 'e'
+114: 'a'
+115: 't'
+116: 'e'
+117: 'd'
+118: 'c'
+119: 'a'
+120: 'l'
+121: 'l'
+122: 'n'
+123: 'o'
+124: 't'
+125: 'i'
+126: 'f'
+127: 'y'
+128: '('
+129: ')'
+130: 't'
+131: 'h'
+132: 'r'
+133: 'o'
+134: 'w'
+135: 's'
+136: '_'
+137: '.'
+138: '`'
+139: '`'
+140: '"'
+141: '"'
+142: '+'
+143: '-'
+144: '0'
+145: 'x'
+146: 'e'
+147: 'E'
+148: '+'
+149: '-'
+150: '/'
+151: '*'
+152: '*'
+153: '/'
+154: '/'
+155: '/'
+156: '\n'
+157: ' '
+158: '\t'
+159: '\n'
+160: '\r'
+161: '#'
+162: '\n'
+163: '0'-'9'
+164: 'a'-'z'
+165: 'A'-'Z'
+166: 'a'-'f'
+167: 'A'-'F'
+168: .
 */
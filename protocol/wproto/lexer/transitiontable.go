@@ -51,54 +51,56 @@ var TransTab = TransitionTable{
 			return 14
 		case r == 62: // ['>','>']
 			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 64: // ['@','@']
 			return 16
-		case r == 91: // ['[','[']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 17
-		case r == 93: // [']',']']
+		case r == 91: // ['[','[']
 			return 18
+		case r == 93: // [']',']']
+			return 19
 		case r == 95: // ['_','_']
-			return 16
+			return 17
 		case r == 96: // ['`','`']
-			return 19
+			return 20
 		case 97 <= r && r <= 98: // ['a','b']
-			return 16
+			return 17
 		case r == 99: // ['c','c']
-			return 20
+			return 21
 		case r == 100: // ['d','d']
-			return 16
+			return 17
 		case r == 101: // ['e','e']
-			return 21
-		case r == 102: // ['f','f']
 			return 22
+		case r == 102: // ['f','f']
+			return 23
 		case 103 <= r && r <= 104: // ['g','h']
-			return 16
+			return 17
 		case r == 105: // ['i','i']
-			return 23
+			return 24
 		case 106 <= r && r <= 108: // ['j','l']
-			return 16
+			return 17
 		case r == 109: // ['m','m']
-			return 24
-		case r == 110: // ['n','n']
 			return 25
+		case r == 110: // ['n','n']
+			return 26
 		case r == 111: // ['o','o']
-			return 16
+			return 27
 		case r == 112: // ['p','p']
-			return 26
+			return 28
 		case r == 113: // ['q','q']
-			return 16
+			return 17
 		case r == 114: // ['r','r']
-			return 27
+			return 29
 		case r == 115: // ['s','s']
-			return 28
+			return 30
 		case r == 116: // ['t','t']
-			return 29
+			return 31
 		case 117 <= r && r <= 122: // ['u','z']
-			return 16
+			return 17
 		case r == 123: // ['{','{']
-			return 30
+			return 32
 		case r == 125: // ['}','}']
-			return 31
+			return 33
 		}
 		return NoState
 	},
@@ -112,7 +114,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 34: // ['"','"']
-			return 32
+			return 34
 		default:
 			return 2
 		}
@@ -121,7 +123,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 33
+			return 35
 		default:
 			return 3
 		}
@@ -142,7 +144,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
+			return 36
 		}
 		return NoState
 	},
@@ -156,25 +158,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 35
+			return 37
 		case r == 47: // ['/','/']
-			return 36
+			return 38
 		}
 		return NoState
 	},
 	// S9
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 39
 		case 48 <= r && r <= 57: // ['0','9']
 			return 10
 		case r == 120: // ['x','x']
-			return 37
+			return 40
 		}
 		return NoState
 	},
 	// S10
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 39
 		case 48 <= r && r <= 57: // ['0','9']
 			return 10
 		}
@@ -213,22 +219,28 @@ var TransTab = TransitionTable{
 	// S16
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 38
-		case 48 <= r && r <= 57: // ['0','9']
-			return 39
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 41
 		case r == 95: // ['_','_']
-			return 40
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 41
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
@@ -239,1425 +251,2331 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 	// S19
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S20
 	func(r rune) int {
 		switch {
 		case r == 96: // ['`','`']
-			return 41
+			return 45
 		default:
-			return 19
+			return 20
 		}
 	},
-	// S20
+	// S21
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case r == 97: // ['a','a']
-			return 42
-		case 98 <= r && r <= 122: // ['b','z']
-			return 40
+			return 46
+		case 98 <= r && r <= 110: // ['b','n']
+			return 44
+		case r == 111: // ['o','o']
+			return 47
+		case 112 <= r && r <= 122: // ['p','z']
+			return 44
 		}
 		return NoState
 	},
-	// S21
+	// S22
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 109: // ['a','m']
-			return 40
+			return 44
 		case r == 110: // ['n','n']
-			return 43
+			return 48
 		case 111 <= r && r <= 122: // ['o','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S22
+	// S23
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case r == 97: // ['a','a']
 			return 44
+		case r == 97: // ['a','a']
+			return 49
 		case 98 <= r && r <= 122: // ['b','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S23
+	// S24
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 108: // ['a','l']
-			return 40
+			return 44
 		case r == 109: // ['m','m']
-			return 45
-		case 110 <= r && r <= 122: // ['n','z']
-			return 40
+			return 50
+		case r == 110: // ['n','n']
+			return 51
+		case 111 <= r && r <= 122: // ['o','z']
+			return 44
 		}
 		return NoState
 	},
-	// S24
+	// S25
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case r == 97: // ['a','a']
-			return 46
+			return 52
 		case 98 <= r && r <= 100: // ['b','d']
-			return 40
+			return 44
 		case r == 101: // ['e','e']
-			return 47
+			return 53
 		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S25
+	// S26
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 110: // ['a','n']
-			return 40
+			return 44
 		case r == 111: // ['o','o']
-			return 48
+			return 54
 		case 112 <= r && r <= 122: // ['p','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S26
+	// S27
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
+		case 97 <= r && r <= 109: // ['a','m']
+			return 44
+		case r == 110: // ['n','n']
+			return 55
+		case r == 111: // ['o','o']
+			return 44
+		case r == 112: // ['p','p']
+			return 56
+		case 113 <= r && r <= 122: // ['q','z']
+			return 44
+		}
+		return NoState
+	},
+	// S28
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
 		case r == 97: // ['a','a']
-			return 49
+			return 57
 		case 98 <= r && r <= 113: // ['b','q']
-			return 40
+			return 44
 		case r == 114: // ['r','r']
-			return 50
+			return 58
 		case 115 <= r && r <= 122: // ['s','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S27
+	// S29
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 100: // ['a','d']
-			return 40
+			return 44
 		case r == 101: // ['e','e']
-			return 51
+			return 59
 		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S28
+	// S30
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 100: // ['a','d']
-			return 40
+			return 44
 		case r == 101: // ['e','e']
-			return 52
+			return 60
 		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S29
+	// S31
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 113: // ['a','q']
-			return 40
+			return 44
+		case 97 <= r && r <= 103: // ['a','g']
+			return 44
+		case r == 104: // ['h','h']
+			return 61
+		case 105 <= r && r <= 113: // ['i','q']
+			return 44
 		case r == 114: // ['r','r']
-			return 53
+			return 62
 		case 115 <= r && r <= 122: // ['s','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S30
+	// S32
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S31
+	// S33
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S32
+	// S34
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S33
+	// S35
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S34
+	// S36
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 39
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
+			return 36
 		}
 		return NoState
 	},
-	// S35
+	// S37
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 54
+			return 63
 		default:
-			return 35
+			return 37
 		}
 	},
-	// S36
+	// S38
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 55
+			return 64
 		default:
-			return 36
+			return 38
 		}
 	},
-	// S37
+	// S39
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 56
+			return 65
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 66
 		case 65 <= r && r <= 70: // ['A','F']
-			return 37
+			return 40
 		case 97 <= r && r <= 102: // ['a','f']
-			return 37
+			return 40
 		}
 		return NoState
 	},
-	// S38
+	// S41
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 67
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 68
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 69
 		case r == 95: // ['_','_']
-			return 40
+			return 69
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 69
 		}
 		return NoState
 	},
-	// S39
+	// S42
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S40
+	// S43
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S41
+	// S44
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S42
+	// S45
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S46
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 107: // ['a','k']
-			return 40
+			return 44
 		case r == 108: // ['l','l']
-			return 57
+			return 70
 		case 109 <= r && r <= 122: // ['m','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S43
+	// S47
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 116: // ['a','t']
-			return 40
-		case r == 117: // ['u','u']
-			return 58
-		case 118 <= r && r <= 122: // ['v','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 109: // ['a','m']
+			return 44
+		case r == 110: // ['n','n']
+			return 71
+		case 111 <= r && r <= 122: // ['o','z']
+			return 44
 		}
 		return NoState
 	},
-	// S44
+	// S48
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 107: // ['a','k']
-			return 40
-		case r == 108: // ['l','l']
-			return 59
-		case 109 <= r && r <= 122: // ['m','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 116: // ['a','t']
+			return 44
+		case r == 117: // ['u','u']
+			return 72
+		case 118 <= r && r <= 122: // ['v','z']
+			return 44
 		}
 		return NoState
 	},
-	// S45
+	// S49
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 111: // ['a','o']
-			return 40
-		case r == 112: // ['p','p']
-			return 60
-		case 113 <= r && r <= 122: // ['q','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 107: // ['a','k']
+			return 44
+		case r == 108: // ['l','l']
+			return 73
+		case 109 <= r && r <= 122: // ['m','z']
+			return 44
 		}
 		return NoState
 	},
-	// S46
+	// S50
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 111: // ['a','o']
-			return 40
+			return 44
 		case r == 112: // ['p','p']
-			return 61
+			return 74
 		case 113 <= r && r <= 122: // ['q','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S47
+	// S51
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 114: // ['a','r']
-			return 40
-		case r == 115: // ['s','s']
-			return 62
-		case 116 <= r && r <= 122: // ['t','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 98: // ['a','b']
+			return 44
+		case r == 99: // ['c','c']
+			return 75
+		case 100 <= r && r <= 122: // ['d','z']
+			return 44
 		}
 		return NoState
 	},
-	// S48
+	// S52
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
+		case 97 <= r && r <= 111: // ['a','o']
+			return 44
+		case r == 112: // ['p','p']
+			return 76
+		case 113 <= r && r <= 122: // ['q','z']
+			return 44
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 77
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
 		case 97 <= r && r <= 115: // ['a','s']
-			return 40
+			return 44
 		case r == 116: // ['t','t']
-			return 63
+			return 78
 		case 117 <= r && r <= 122: // ['u','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S49
+	// S55
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 79
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 115: // ['a','s']
+			return 44
+		case r == 116: // ['t','t']
+			return 80
+		case 117 <= r && r <= 122: // ['u','z']
+			return 44
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
 		case 97 <= r && r <= 98: // ['a','b']
-			return 40
+			return 44
 		case r == 99: // ['c','c']
-			return 64
+			return 81
 		case 100 <= r && r <= 122: // ['d','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S50
+	// S58
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 110: // ['a','n']
-			return 40
+			return 44
 		case r == 111: // ['o','o']
-			return 65
+			return 82
 		case 112 <= r && r <= 122: // ['p','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S51
+	// S59
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 111: // ['a','o']
-			return 40
+			return 44
 		case r == 112: // ['p','p']
-			return 66
-		case 113 <= r && r <= 122: // ['q','z']
-			return 40
+			return 83
+		case 113 <= r && r <= 114: // ['q','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 84
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
 		}
 		return NoState
 	},
-	// S52
+	// S60
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 113: // ['a','q']
-			return 40
+			return 44
 		case r == 114: // ['r','r']
-			return 67
+			return 85
 		case 115 <= r && r <= 122: // ['s','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S53
+	// S61
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
+		case 97 <= r && r <= 113: // ['a','q']
+			return 44
+		case r == 114: // ['r','r']
+			return 86
+		case 115 <= r && r <= 122: // ['s','z']
+			return 44
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
 		case 97 <= r && r <= 116: // ['a','t']
-			return 40
+			return 44
 		case r == 117: // ['u','u']
-			return 68
+			return 87
 		case 118 <= r && r <= 122: // ['v','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S54
+	// S63
 	func(r rune) int {
 		switch {
 		case r == 47: // ['/','/']
-			return 69
+			return 88
 		}
 		return NoState
 	},
-	// S55
+	// S64
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S56
+	// S65
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 56
+			return 65
+		case r == 69: // ['E','E']
+			return 89
+		case r == 101: // ['e','e']
+			return 89
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 66
 		case 65 <= r && r <= 70: // ['A','F']
-			return 37
+			return 40
 		case 97 <= r && r <= 102: // ['a','f']
-			return 37
+			return 40
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 67
+		case 48 <= r && r <= 57: // ['0','9']
+			return 68
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 69
+		case r == 95: // ['_','_']
+			return 69
+		case 97 <= r && r <= 122: // ['a','z']
+			return 69
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 67
+		case 48 <= r && r <= 57: // ['0','9']
+			return 68
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 69
+		case r == 95: // ['_','_']
+			return 69
+		case 97 <= r && r <= 122: // ['a','z']
+			return 69
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 67
+		case 48 <= r && r <= 57: // ['0','9']
+			return 68
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 69
+		case r == 95: // ['_','_']
+			return 69
+		case 97 <= r && r <= 122: // ['a','z']
+			return 69
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 107: // ['a','k']
+			return 44
+		case r == 108: // ['l','l']
+			return 90
+		case 109 <= r && r <= 122: // ['m','z']
+			return 44
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 91
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 108: // ['a','l']
+			return 44
+		case r == 109: // ['m','m']
+			return 92
+		case 110 <= r && r <= 122: // ['n','z']
+			return 44
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 93
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 110: // ['a','n']
+			return 44
+		case r == 111: // ['o','o']
+			return 94
+		case 112 <= r && r <= 122: // ['p','z']
+			return 44
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 107: // ['a','k']
+			return 44
+		case r == 108: // ['l','l']
+			return 95
+		case 109 <= r && r <= 122: // ['m','z']
+			return 44
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 96
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 104: // ['a','h']
+			return 44
+		case r == 105: // ['i','i']
+			return 97
+		case 106 <= r && r <= 122: // ['j','z']
+			return 44
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 110: // ['a','n']
+			return 44
+		case r == 111: // ['o','o']
+			return 98
+		case 112 <= r && r <= 122: // ['p','z']
+			return 44
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 104: // ['a','h']
+			return 44
+		case r == 105: // ['i','i']
+			return 99
+		case 106 <= r && r <= 122: // ['j','z']
+			return 44
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 106: // ['a','j']
+			return 44
+		case r == 107: // ['k','k']
+			return 100
+		case 108 <= r && r <= 122: // ['l','z']
+			return 44
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 105: // ['a','i']
+			return 44
+		case r == 106: // ['j','j']
+			return 101
+		case 107 <= r && r <= 122: // ['k','z']
+			return 44
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 102
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 103
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 117: // ['a','u']
+			return 44
+		case r == 118: // ['v','v']
+			return 104
+		case 119 <= r && r <= 122: // ['w','z']
+			return 44
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 110: // ['a','n']
+			return 44
+		case r == 111: // ['o','o']
+			return 105
+		case 112 <= r && r <= 122: // ['p','z']
+			return 44
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 106
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 107
+		case r == 45: // ['-','-']
+			return 107
+		case 48 <= r && r <= 57: // ['0','9']
+			return 108
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 115: // ['a','s']
+			return 44
+		case r == 116: // ['t','t']
+			return 109
+		case 117 <= r && r <= 122: // ['u','z']
+			return 44
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 110
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 113: // ['a','q']
+			return 44
+		case r == 114: // ['r','r']
+			return 111
+		case 115 <= r && r <= 122: // ['s','z']
+			return 44
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 116: // ['a','t']
+			return 44
+		case r == 117: // ['u','u']
+			return 112
+		case 118 <= r && r <= 122: // ['v','z']
+			return 44
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case r == 97: // ['a','a']
+			return 113
+		case 98 <= r && r <= 122: // ['b','z']
+			return 44
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 101: // ['a','e']
+			return 44
+		case r == 102: // ['f','f']
+			return 114
+		case 103 <= r && r <= 122: // ['g','z']
+			return 44
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 101: // ['a','e']
+			return 44
+		case r == 102: // ['f','f']
+			return 115
+		case 103 <= r && r <= 122: // ['g','z']
+			return 44
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 110: // ['a','n']
+			return 44
+		case r == 111: // ['o','o']
+			return 116
+		case 112 <= r && r <= 122: // ['p','z']
+			return 44
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case r == 97: // ['a','a']
+			return 117
+		case 98 <= r && r <= 122: // ['b','z']
+			return 44
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 118
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case r == 97: // ['a','a']
+			return 119
+		case 98 <= r && r <= 122: // ['b','z']
+			return 44
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 113: // ['a','q']
+			return 44
+		case r == 114: // ['r','r']
+			return 120
+		case 115 <= r && r <= 122: // ['s','z']
+			return 44
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 104: // ['a','h']
+			return 44
+		case r == 105: // ['i','i']
+			return 121
+		case 106 <= r && r <= 122: // ['j','z']
+			return 44
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 118: // ['a','v']
+			return 44
+		case r == 119: // ['w','w']
+			return 122
+		case 120 <= r && r <= 122: // ['x','z']
+			return 44
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 42
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 44
+		case r == 95: // ['_','_']
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 108
 		}
 		return NoState
 	},
-	// S57
+	// S108
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 38
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
-		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 107: // ['a','k']
-			return 40
-		case r == 108: // ['l','l']
-			return 70
-		case 109 <= r && r <= 122: // ['m','z']
-			return 40
+			return 108
 		}
 		return NoState
 	},
-	// S58
+	// S109
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 108: // ['a','l']
-			return 40
-		case r == 109: // ['m','m']
-			return 71
-		case 110 <= r && r <= 122: // ['n','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S59
+	// S110
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 114: // ['a','r']
-			return 40
-		case r == 115: // ['s','s']
-			return 72
-		case 116 <= r && r <= 122: // ['t','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S60
+	// S111
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 110: // ['a','n']
-			return 40
-		case r == 111: // ['o','o']
-			return 73
-		case 112 <= r && r <= 122: // ['p','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 115: // ['a','s']
+			return 44
+		case r == 116: // ['t','t']
+			return 123
+		case 117 <= r && r <= 122: // ['u','z']
+			return 44
 		}
 		return NoState
 	},
-	// S61
+	// S112
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 99: // ['a','c']
+			return 44
+		case r == 100: // ['d','d']
+			return 124
+		case 101 <= r && r <= 122: // ['e','z']
+			return 44
 		}
 		return NoState
 	},
-	// S62
+	// S113
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 114: // ['a','r']
-			return 40
-		case r == 115: // ['s','s']
-			return 74
-		case 116 <= r && r <= 122: // ['t','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 102: // ['a','f']
+			return 44
+		case r == 103: // ['g','g']
+			return 125
+		case 104 <= r && r <= 122: // ['h','z']
+			return 44
 		}
 		return NoState
 	},
-	// S63
+	// S114
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 104: // ['a','h']
-			return 40
-		case r == 105: // ['i','i']
-			return 75
-		case 106 <= r && r <= 122: // ['j','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 120: // ['a','x']
+			return 44
+		case r == 121: // ['y','y']
+			return 126
+		case r == 122: // ['z','z']
+			return 44
 		}
 		return NoState
 	},
-	// S64
+	// S115
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 106: // ['a','j']
-			return 40
-		case r == 107: // ['k','k']
-			return 76
-		case 108 <= r && r <= 122: // ['l','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S65
+	// S116
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 105: // ['a','i']
-			return 40
-		case r == 106: // ['j','j']
-			return 77
-		case 107 <= r && r <= 122: // ['k','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 109: // ['a','m']
+			return 44
+		case r == 110: // ['n','n']
+			return 127
+		case 111 <= r && r <= 122: // ['o','z']
+			return 44
 		}
 		return NoState
 	},
-	// S66
+	// S117
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 78
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 102: // ['a','f']
+			return 44
+		case r == 103: // ['g','g']
+			return 128
+		case 104 <= r && r <= 122: // ['h','z']
+			return 44
 		}
 		return NoState
 	},
-	// S67
+	// S118
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 117: // ['a','u']
-			return 40
-		case r == 118: // ['v','v']
-			return 79
-		case 119 <= r && r <= 122: // ['w','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 98: // ['a','b']
+			return 44
+		case r == 99: // ['c','c']
+			return 129
+		case 100 <= r && r <= 122: // ['d','z']
+			return 44
 		}
 		return NoState
 	},
-	// S68
+	// S119
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 80
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
-		}
-		return NoState
-	},
-	// S69
-	func(r rune) int {
-		switch {
+			return 44
+		case 97 <= r && r <= 115: // ['a','s']
+			return 44
+		case r == 116: // ['t','t']
+			return 130
+		case 117 <= r && r <= 122: // ['u','z']
+			return 44
 		}
 		return NoState
 	},
-	// S70
+	// S120
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 117: // ['a','u']
+			return 44
+		case r == 118: // ['v','v']
+			return 131
+		case 119 <= r && r <= 122: // ['w','z']
+			return 44
 		}
 		return NoState
 	},
-	// S71
+	// S121
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 98: // ['a','b']
+			return 44
+		case r == 99: // ['c','c']
+			return 132
+		case 100 <= r && r <= 122: // ['d','z']
+			return 44
 		}
 		return NoState
 	},
-	// S72
+	// S122
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 81
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 133
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
 		}
 		return NoState
 	},
-	// S73
+	// S123
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 113: // ['a','q']
-			return 40
-		case r == 114: // ['r','r']
-			return 82
-		case 115 <= r && r <= 122: // ['s','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S74
+	// S124
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case r == 97: // ['a','a']
-			return 83
-		case 98 <= r && r <= 122: // ['b','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 134
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S75
+	// S125
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 101: // ['a','e']
-			return 40
-		case r == 102: // ['f','f']
-			return 84
-		case 103 <= r && r <= 122: // ['g','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 135
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S76
+	// S126
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case r == 97: // ['a','a']
-			return 85
-		case 98 <= r && r <= 122: // ['b','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S77
+	// S127
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 86
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 136
+		case r == 97: // ['a','a']
+			return 137
+		case 98 <= r && r <= 122: // ['b','z']
+			return 44
 		}
 		return NoState
 	},
-	// S78
+	// S128
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case r == 97: // ['a','a']
-			return 87
-		case 98 <= r && r <= 122: // ['b','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 138
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S79
+	// S129
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 104: // ['a','h']
-			return 40
-		case r == 105: // ['i','i']
-			return 88
-		case 106 <= r && r <= 122: // ['j','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 115: // ['a','s']
+			return 44
+		case r == 116: // ['t','t']
+			return 139
+		case 117 <= r && r <= 122: // ['u','z']
+			return 44
 		}
 		return NoState
 	},
-	// S80
+	// S130
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 140
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S81
+	// S131
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 141
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S82
+	// S132
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 115: // ['a','s']
-			return 40
-		case r == 116: // ['t','t']
-			return 89
-		case 117 <= r && r <= 122: // ['u','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 142
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S83
+	// S133
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 102: // ['a','f']
-			return 40
-		case r == 103: // ['g','g']
-			return 90
-		case 104 <= r && r <= 122: // ['h','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S84
+	// S134
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 120: // ['a','x']
-			return 40
-		case r == 121: // ['y','y']
-			return 91
-		case r == 122: // ['z','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S85
+	// S135
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 102: // ['a','f']
-			return 40
-		case r == 103: // ['g','g']
-			return 92
-		case 104 <= r && r <= 122: // ['h','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S86
+	// S136
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 98: // ['a','b']
-			return 40
-		case r == 99: // ['c','c']
-			return 93
-		case 100 <= r && r <= 122: // ['d','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 114: // ['a','r']
+			return 44
+		case r == 115: // ['s','s']
+			return 143
+		case 116 <= r && r <= 122: // ['t','z']
+			return 44
 		}
 		return NoState
 	},
-	// S87
+	// S137
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 115: // ['a','s']
-			return 40
-		case r == 116: // ['t','t']
-			return 94
-		case 117 <= r && r <= 122: // ['u','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 107: // ['a','k']
+			return 44
+		case r == 108: // ['l','l']
+			return 144
+		case 109 <= r && r <= 122: // ['m','z']
+			return 44
 		}
 		return NoState
 	},
-	// S88
+	// S138
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 98: // ['a','b']
-			return 40
-		case r == 99: // ['c','c']
-			return 95
-		case 100 <= r && r <= 122: // ['d','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S89
+	// S139
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S90
+	// S140
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 96
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 99: // ['a','c']
+			return 44
+		case r == 100: // ['d','d']
+			return 145
+		case 101 <= r && r <= 122: // ['e','z']
+			return 44
 		}
 		return NoState
 	},
-	// S91
+	// S141
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 99: // ['a','c']
+			return 44
+		case r == 100: // ['d','d']
+			return 146
+		case 101 <= r && r <= 122: // ['e','z']
+			return 44
 		}
 		return NoState
 	},
-	// S92
+	// S142
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 97
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S93
+	// S143
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 115: // ['a','s']
-			return 40
-		case r == 116: // ['t','t']
-			return 98
-		case 117 <= r && r <= 122: // ['u','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 98: // ['a','b']
+			return 44
+		case r == 99: // ['c','c']
+			return 147
+		case 100 <= r && r <= 122: // ['d','z']
+			return 44
 		}
 		return NoState
 	},
-	// S94
+	// S144
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 99
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S95
+	// S145
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 100: // ['a','d']
-			return 40
-		case r == 101: // ['e','e']
-			return 100
-		case 102 <= r && r <= 122: // ['f','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 122: // ['a','z']
+			return 44
 		}
 		return NoState
 	},
-	// S96
+	// S146
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
-	// S97
+	// S147
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 103: // ['a','g']
+			return 44
+		case r == 104: // ['h','h']
+			return 148
+		case 105 <= r && r <= 122: // ['i','z']
+			return 44
 		}
 		return NoState
 	},
-	// S98
+	// S148
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 100: // ['a','d']
+			return 44
+		case r == 101: // ['e','e']
+			return 149
+		case 102 <= r && r <= 122: // ['f','z']
+			return 44
 		}
 		return NoState
 	},
-	// S99
+	// S149
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 99: // ['a','c']
-			return 40
-		case r == 100: // ['d','d']
-			return 101
-		case 101 <= r && r <= 122: // ['e','z']
-			return 40
+			return 44
+		case 97 <= r && r <= 108: // ['a','l']
+			return 44
+		case r == 109: // ['m','m']
+			return 150
+		case 110 <= r && r <= 122: // ['n','z']
+			return 44
 		}
 		return NoState
 	},
-	// S100
+	// S150
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
-		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
+		case r == 97: // ['a','a']
+			return 151
+		case 98 <= r && r <= 122: // ['b','z']
+			return 44
 		}
 		return NoState
 	},
-	// S101
+	// S151
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 38
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 39
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 40
+			return 44
 		case r == 95: // ['_','_']
-			return 40
+			return 44
 		case 97 <= r && r <= 122: // ['a','z']
-			return 40
+			return 44
 		}
 		return NoState
 	},
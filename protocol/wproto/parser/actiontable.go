@@ -22,28 +22,37 @@ var actionTab = actionTable{
 			nil,      // tok_identifier
 			nil,      // import
 			nil,      // tok_literal
+			nil,      // =
 			nil,      // enum
 			nil,      // {
 			nil,      // }
 			nil,      // message
-			nil,      // =
+			nil,      // tok_tag
 			nil,      // true
 			nil,      // false
 			nil,      // tok_num
+			nil,      // tok_float
+			nil,      // [
+			nil,      // ]
+			nil,      // ,
+			nil,      // :
+			nil,      // option_schema
+			nil,      // oneof
+			nil,      // service
+			nil,      // project
+			nil,      // const
+			nil,      // include
+			nil,      // reserved
+			nil,      // optional
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
-			nil,      // [
-			nil,      // ]
 			nil,      // repeated
-			nil,      // service
 			nil,      // call
-			nil,      // :
 			nil,      // notify
 			nil,      // (
 			nil,      // )
-			nil,      // project
+			nil,      // throws
 			nil,      // tok_doc
 		},
 	},
@@ -58,28 +67,37 @@ var actionTab = actionTable{
 			nil,          // tok_identifier
 			nil,          // import
 			nil,          // tok_literal
+			nil,          // =
 			nil,          // enum
 			nil,          // {
 			nil,          // }
 			nil,          // message
-			nil,          // =
+			nil,          // tok_tag
 			nil,          // true
 			nil,          // false
 			nil,          // tok_num
+			nil,          // tok_float
+			nil,          // [
+			nil,          // ]
+			nil,          // ,
+			nil,          // :
+			nil,          // option_schema
+			nil,          // oneof
+			nil,          // service
+			nil,          // project
+			nil,          // const
+			nil,          // include
+			nil,          // reserved
+			nil,          // optional
 			nil,          // map
 			nil,          // <
-			nil,          // ,
 			nil,          // >
-			nil,          // [
-			nil,          // ]
 			nil,          // repeated
-			nil,          // service
 			nil,          // call
-			nil,          // :
 			nil,          // notify
 			nil,          // (
 			nil,          // )
-			nil,          // project
+			nil,          // throws
 			nil,          // tok_doc
 		},
 	},
@@ -94,28 +112,37 @@ var actionTab = actionTable{
 			reduce(5), // tok_identifier, reduce: Imports
 			reduce(5), // import, reduce: Imports
 			nil,       // tok_literal
+			nil,       // =
 			reduce(5), // enum, reduce: Imports
 			nil,       // {
 			nil,       // }
 			reduce(5), // message, reduce: Imports
-			nil,       // =
+			reduce(5), // tok_tag, reduce: Imports
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(5), // option_schema, reduce: Imports
+			nil,       // oneof
+			reduce(5), // service, reduce: Imports
+			reduce(5), // project, reduce: Imports
+			reduce(5), // const, reduce: Imports
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(5), // service, reduce: Imports
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(5), // project, reduce: Imports
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -130,28 +157,37 @@ var actionTab = actionTable{
 			shift(5), // tok_identifier
 			nil,      // import
 			nil,      // tok_literal
+			nil,      // =
 			nil,      // enum
 			nil,      // {
 			nil,      // }
 			nil,      // message
-			nil,      // =
+			nil,      // tok_tag
 			nil,      // true
 			nil,      // false
 			nil,      // tok_num
+			nil,      // tok_float
+			nil,      // [
+			nil,      // ]
+			nil,      // ,
+			nil,      // :
+			nil,      // option_schema
+			nil,      // oneof
+			nil,      // service
+			nil,      // project
+			nil,      // const
+			nil,      // include
+			nil,      // reserved
+			nil,      // optional
 			nil,      // map
 			nil,      // <
-			nil,      // ,
 			nil,      // >
-			nil,      // [
-			nil,      // ]
 			nil,      // repeated
-			nil,      // service
 			nil,      // call
-			nil,      // :
 			nil,      // notify
 			nil,      // (
 			nil,      // )
-			nil,      // project
+			nil,      // throws
 			nil,      // tok_doc
 		},
 	},
@@ -166,28 +202,37 @@ var actionTab = actionTable{
 			reduce(9), // tok_identifier, reduce: Defines
 			shift(8),  // import
 			nil,       // tok_literal
+			nil,       // =
 			reduce(9), // enum, reduce: Defines
 			nil,       // {
 			nil,       // }
 			reduce(9), // message, reduce: Defines
-			nil,       // =
+			reduce(9), // tok_tag, reduce: Defines
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(9), // option_schema, reduce: Defines
+			nil,       // oneof
+			reduce(9), // service, reduce: Defines
+			reduce(9), // project, reduce: Defines
+			reduce(9), // const, reduce: Defines
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(9), // service, reduce: Defines
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(9), // project, reduce: Defines
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -202,28 +247,37 @@ var actionTab = actionTable{
 			reduce(2), // tok_identifier, reduce: OptEnd
 			reduce(2), // import, reduce: OptEnd
 			nil,       // tok_literal
+			nil,       // =
 			reduce(2), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			reduce(2), // message, reduce: OptEnd
-			nil,       // =
+			reduce(2), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(2), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -238,28 +292,37 @@ var actionTab = actionTable{
 			shift(11), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
-			shift(18), // enum
+			nil,       // =
+			shift(21), // enum
 			nil,       // {
 			nil,       // }
-			shift(19), // message
-			nil,       // =
+			shift(24), // message
+			shift(25), // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			shift(27), // option_schema
+			nil,       // oneof
+			shift(28), // service
+			shift(29), // project
+			shift(30), // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			shift(20), // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			shift(21), // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -274,28 +337,37 @@ var actionTab = actionTable{
 			reduce(6), // tok_identifier, reduce: Imports
 			reduce(6), // import, reduce: Imports
 			nil,       // tok_literal
+			nil,       // =
 			reduce(6), // enum, reduce: Imports
 			nil,       // {
 			nil,       // }
 			reduce(6), // message, reduce: Imports
-			nil,       // =
+			reduce(6), // tok_tag, reduce: Imports
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(6), // option_schema, reduce: Imports
+			nil,       // oneof
+			reduce(6), // service, reduce: Imports
+			reduce(6), // project, reduce: Imports
+			reduce(6), // const, reduce: Imports
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(6), // service, reduce: Imports
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(6), // project, reduce: Imports
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -307,31 +379,40 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(22), // tok_identifier
+			shift(31), // tok_identifier
 			nil,       // import
-			shift(23), // tok_literal
+			shift(32), // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -346,28 +427,37 @@ var actionTab = actionTable{
 			reduce(4), // tok_identifier, reduce: Package
 			reduce(4), // import, reduce: Package
 			nil,       // tok_literal
+			nil,       // =
 			reduce(4), // enum, reduce: Package
 			nil,       // {
 			nil,       // }
 			reduce(4), // message, reduce: Package
-			nil,       // =
+			reduce(4), // tok_tag, reduce: Package
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(4), // option_schema, reduce: Package
+			nil,       // oneof
+			reduce(4), // service, reduce: Package
+			reduce(4), // project, reduce: Package
+			reduce(4), // const, reduce: Package
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(4), // service, reduce: Package
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(4), // project, reduce: Package
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -382,28 +472,37 @@ var actionTab = actionTable{
 			reduce(3), // tok_identifier, reduce: OptEnd
 			reduce(3), // import, reduce: OptEnd
 			nil,       // tok_literal
+			nil,       // =
 			reduce(3), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			reduce(3), // message, reduce: OptEnd
-			nil,       // =
+			reduce(3), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(3), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(3), // service, reduce: OptEnd
+			reduce(3), // project, reduce: OptEnd
+			reduce(3), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(3), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(3), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -415,31 +514,40 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			nil,       // tok_identifier
+			shift(33), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			shift(34), // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			shift(25), // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
@@ -454,28 +562,37 @@ var actionTab = actionTable{
 			reduce(10), // tok_identifier, reduce: Defines
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(10), // enum, reduce: Defines
 			nil,        // {
 			nil,        // }
 			reduce(10), // message, reduce: Defines
-			nil,        // =
+			reduce(10), // tok_tag, reduce: Defines
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(10), // option_schema, reduce: Defines
+			nil,        // oneof
+			reduce(10), // service, reduce: Defines
+			reduce(10), // project, reduce: Defines
+			reduce(10), // const, reduce: Defines
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(10), // service, reduce: Defines
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(10), // project, reduce: Defines
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -490,28 +607,37 @@ var actionTab = actionTable{
 			reduce(11), // tok_identifier, reduce: Define
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(11), // enum, reduce: Define
 			nil,        // {
 			nil,        // }
 			reduce(11), // message, reduce: Define
-			nil,        // =
+			reduce(11), // tok_tag, reduce: Define
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(11), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(11), // service, reduce: Define
+			reduce(11), // project, reduce: Define
+			reduce(11), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(11), // service, reduce: Define
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(11), // project, reduce: Define
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -526,28 +652,37 @@ var actionTab = actionTable{
 			reduce(12), // tok_identifier, reduce: Define
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(12), // enum, reduce: Define
 			nil,        // {
 			nil,        // }
 			reduce(12), // message, reduce: Define
-			nil,        // =
+			reduce(12), // tok_tag, reduce: Define
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(12), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(12), // service, reduce: Define
+			reduce(12), // project, reduce: Define
+			reduce(12), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(12), // service, reduce: Define
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(12), // project, reduce: Define
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -562,28 +697,37 @@ var actionTab = actionTable{
 			reduce(13), // tok_identifier, reduce: Define
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(13), // enum, reduce: Define
 			nil,        // {
 			nil,        // }
 			reduce(13), // message, reduce: Define
-			nil,        // =
+			reduce(13), // tok_tag, reduce: Define
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(13), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(13), // service, reduce: Define
+			reduce(13), // project, reduce: Define
+			reduce(13), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(13), // service, reduce: Define
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(13), // project, reduce: Define
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -598,28 +742,37 @@ var actionTab = actionTable{
 			reduce(14), // tok_identifier, reduce: Define
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(14), // enum, reduce: Define
 			nil,        // {
 			nil,        // }
 			reduce(14), // message, reduce: Define
-			nil,        // =
+			reduce(14), // tok_tag, reduce: Define
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(14), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(14), // service, reduce: Define
+			reduce(14), // project, reduce: Define
+			reduce(14), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(14), // service, reduce: Define
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(14), // project, reduce: Define
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -634,68 +787,176 @@ var actionTab = actionTable{
 			reduce(15), // tok_identifier, reduce: Define
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			reduce(15), // enum, reduce: Define
 			nil,        // {
 			nil,        // }
 			reduce(15), // message, reduce: Define
-			nil,        // =
+			reduce(15), // tok_tag, reduce: Define
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(15), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(15), // service, reduce: Define
+			reduce(15), // project, reduce: Define
+			reduce(15), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(15), // service, reduce: Define
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(15), // project, reduce: Define
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
 	actionRow{ // S18
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			shift(26), // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			reduce(16), // ␚, reduce: Define
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(16), // tok_identifier, reduce: Define
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(16), // enum, reduce: Define
+			nil,        // {
+			nil,        // }
+			reduce(16), // message, reduce: Define
+			reduce(16), // tok_tag, reduce: Define
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(16), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(16), // service, reduce: Define
+			reduce(16), // project, reduce: Define
+			reduce(16), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
 	actionRow{ // S19
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(17), // ␚, reduce: Define
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(17), // tok_identifier, reduce: Define
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(17), // enum, reduce: Define
+			nil,        // {
+			nil,        // }
+			reduce(17), // message, reduce: Define
+			reduce(17), // tok_tag, reduce: Define
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(17), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(17), // service, reduce: Define
+			reduce(17), // project, reduce: Define
+			reduce(17), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S20
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(18), // ␚, reduce: Define
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(18), // tok_identifier, reduce: Define
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(18), // enum, reduce: Define
+			nil,        // {
+			nil,        // }
+			reduce(18), // message, reduce: Define
+			reduce(18), // tok_tag, reduce: Define
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(18), // option_schema, reduce: Define
+			nil,        // oneof
+			reduce(18), // service, reduce: Define
+			reduce(18), // project, reduce: Define
+			reduce(18), // const, reduce: Define
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S21
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -703,35 +964,44 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(27), // tok_identifier
+			shift(36), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S20
+	actionRow{ // S22
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -739,35 +1009,89 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(28), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
-			nil,       // message
-			nil,       // =
+			shift(24), // message
+			shift(38), // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			shift(28), // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S21
+	actionRow{ // S23
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(28), // ␚, reduce: TaggedMessage
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(28), // tok_identifier, reduce: TaggedMessage
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(28), // enum, reduce: TaggedMessage
+			nil,        // {
+			nil,        // }
+			reduce(28), // message, reduce: TaggedMessage
+			reduce(28), // tok_tag, reduce: TaggedMessage
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(28), // option_schema, reduce: TaggedMessage
+			nil,        // oneof
+			reduce(28), // service, reduce: TaggedMessage
+			reduce(28), // project, reduce: TaggedMessage
+			reduce(28), // const, reduce: TaggedMessage
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S24
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -775,35 +1099,134 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(29), // tok_identifier
+			shift(40), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S22
+	actionRow{ // S25
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			reduce(26), // message, reduce: Tags
+			reduce(26), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			reduce(26), // service, reduce: Tags
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S26
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(32), // ␚, reduce: TaggedService
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(32), // tok_identifier, reduce: TaggedService
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(32), // enum, reduce: TaggedService
+			nil,        // {
+			nil,        // }
+			reduce(32), // message, reduce: TaggedService
+			reduce(32), // tok_tag, reduce: TaggedService
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(32), // option_schema, reduce: TaggedService
+			nil,        // oneof
+			reduce(32), // service, reduce: TaggedService
+			reduce(32), // project, reduce: TaggedService
+			reduce(32), // const, reduce: TaggedService
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S27
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -811,107 +1234,134 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			nil,       // tok_identifier
+			shift(41), // tok_identifier
 			nil,       // import
-			shift(30), // tok_literal
+			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
+			nil,       // tok_float
 			nil,       // [
 			nil,       // ]
-			nil,       // repeated
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
 			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S23
+	actionRow{ // S28
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
+			nil,       // ␚
 			nil,       // empty
-			shift(10), // ;
+			nil,       // ;
 			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			reduce(2), // import, reduce: OptEnd
+			shift(42), // tok_identifier
+			nil,       // import
 			nil,       // tok_literal
-			reduce(2), // enum, reduce: OptEnd
+			nil,       // =
+			nil,       // enum
 			nil,       // {
 			nil,       // }
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
+			nil,       // message
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(2), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S24
+	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
+			nil,       // ␚
 			nil,       // empty
-			shift(33), // ;
+			nil,       // ;
 			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
+			shift(43), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
-			reduce(2), // enum, reduce: OptEnd
+			nil,       // =
+			nil,       // enum
 			nil,       // {
 			nil,       // }
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
+			nil,       // message
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(2), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S25
+	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -919,35 +1369,44 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			nil,       // tok_identifier
+			shift(44), // tok_identifier
 			nil,       // import
-			shift(34), // tok_literal
+			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
-			shift(35), // true
-			shift(36), // false
-			shift(37), // tok_num
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S26
+	actionRow{ // S31
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -957,69 +1416,87 @@ var actionTab = actionTable{
 			nil,       // package
 			nil,       // tok_identifier
 			nil,       // import
-			nil,       // tok_literal
+			shift(45), // tok_literal
+			nil,       // =
 			nil,       // enum
-			shift(38), // {
+			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S27
+	actionRow{ // S32
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(2), // ␚, reduce: OptEnd
 			nil,       // empty
-			nil,       // ;
+			shift(10), // ;
 			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
+			reduce(2), // tok_identifier, reduce: OptEnd
+			reduce(2), // import, reduce: OptEnd
 			nil,       // tok_literal
-			nil,       // enum
-			shift(39), // {
-			nil,       // }
-			nil,       // message
 			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S28
+	actionRow{ // S33
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1030,32 +1507,41 @@ var actionTab = actionTable{
 			nil,       // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			shift(47), // =
 			nil,       // enum
-			shift(40), // {
+			nil,       // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S29
+	actionRow{ // S34
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1063,539 +1549,1169 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			nil,       // tok_identifier
+			shift(48), // tok_identifier
 			nil,       // import
-			nil,       // tok_literal
+			shift(49), // tok_literal
+			nil,       // =
 			nil,       // enum
-			shift(41), // {
+			shift(50), // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
+			nil,       // tok_tag
+			shift(52), // true
+			shift(53), // false
+			shift(54), // tok_num
+			shift(55), // tok_float
+			shift(56), // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S30
+	actionRow{ // S35
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(2), // ␚, reduce: OptEnd
 			nil,       // empty
-			shift(10), // ;
+			shift(58), // ;
 			nil,       // package
 			reduce(2), // tok_identifier, reduce: OptEnd
-			reduce(2), // import, reduce: OptEnd
+			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			reduce(2), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			reduce(2), // message, reduce: OptEnd
-			nil,       // =
+			reduce(2), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(2), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S31
+	actionRow{ // S36
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(7), // ␚, reduce: Import
+			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			reduce(7), // tok_identifier, reduce: Import
-			reduce(7), // import, reduce: Import
+			nil,       // tok_identifier
+			nil,       // import
 			nil,       // tok_literal
-			reduce(7), // enum, reduce: Import
-			nil,       // {
-			nil,       // }
-			reduce(7), // message, reduce: Import
 			nil,       // =
+			nil,       // enum
+			shift(59), // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(7), // service, reduce: Import
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(7), // project, reduce: Import
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S32
+	actionRow{ // S37
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(20), // ␚, reduce: OptionExpr
+			reduce(29), // ␚, reduce: TaggedMessage
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(20), // tok_identifier, reduce: OptionExpr
+			reduce(29), // tok_identifier, reduce: TaggedMessage
 			nil,        // import
 			nil,        // tok_literal
-			reduce(20), // enum, reduce: OptionExpr
+			nil,        // =
+			reduce(29), // enum, reduce: TaggedMessage
 			nil,        // {
 			nil,        // }
-			reduce(20), // message, reduce: OptionExpr
-			nil,        // =
+			reduce(29), // message, reduce: TaggedMessage
+			reduce(29), // tok_tag, reduce: TaggedMessage
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(29), // option_schema, reduce: TaggedMessage
+			nil,        // oneof
+			reduce(29), // service, reduce: TaggedMessage
+			reduce(29), // project, reduce: TaggedMessage
+			reduce(29), // const, reduce: TaggedMessage
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S38
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			reduce(27), // message, reduce: Tags
+			reduce(27), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
 			nil,        // [
 			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			reduce(27), // service, reduce: Tags
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
 			nil,        // repeated
-			reduce(20), // service, reduce: OptionExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(20), // project, reduce: OptionExpr
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S33
+	actionRow{ // S39
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(33), // ␚, reduce: TaggedService
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(33), // tok_identifier, reduce: TaggedService
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(33), // enum, reduce: TaggedService
+			nil,        // {
+			nil,        // }
+			reduce(33), // message, reduce: TaggedService
+			reduce(33), // tok_tag, reduce: TaggedService
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(33), // option_schema, reduce: TaggedService
+			nil,        // oneof
+			reduce(33), // service, reduce: TaggedService
+			reduce(33), // project, reduce: TaggedService
+			reduce(33), // const, reduce: TaggedService
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S40
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			shift(60),   // =
+			nil,         // enum
+			reduce(131), // {, reduce: MethodNo
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S41
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(3), // ␚, reduce: OptEnd
+			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
-			reduce(3), // enum, reduce: OptEnd
-			nil,       // {
+			nil,       // =
+			nil,       // enum
+			shift(62), // {
 			nil,       // }
-			reduce(3), // message, reduce: OptEnd
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S42
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(117), // {, reduce: MethodThrows
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			shift(64),   // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S43
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			nil,       // tok_identifier
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			shift(65), // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S44
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			shift(66), // tok_identifier
+			nil,       // import
+			nil,       // tok_literal
 			nil,       // =
+			nil,       // enum
+			nil,       // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S45
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(10), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			reduce(2), // import, reduce: OptEnd
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
 			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
 			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S46
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(7), // ␚, reduce: Import
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(7), // tok_identifier, reduce: Import
+			reduce(7), // import, reduce: Import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(7), // enum, reduce: Import
+			nil,       // {
+			nil,       // }
+			reduce(7), // message, reduce: Import
+			reduce(7), // tok_tag, reduce: Import
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
 			nil,       // [
 			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(7), // option_schema, reduce: Import
+			nil,       // oneof
+			reduce(7), // service, reduce: Import
+			reduce(7), // project, reduce: Import
+			reduce(7), // const, reduce: Import
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
 			nil,       // repeated
-			reduce(3), // service, reduce: OptEnd
 			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S47
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			shift(68), // tok_identifier
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			nil,       // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
 			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(3), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S34
+	actionRow{ // S48
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(24), // ␚, reduce: OptionValue
+			reduce(45), // ␚, reduce: ValueExpr
 			nil,        // empty
-			reduce(24), // ;, reduce: OptionValue
+			reduce(45), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(24), // tok_identifier, reduce: OptionValue
+			reduce(45), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			reduce(24), // enum, reduce: OptionValue
+			nil,        // =
+			reduce(45), // enum, reduce: ValueExpr
 			nil,        // {
 			nil,        // }
-			reduce(24), // message, reduce: OptionValue
-			nil,        // =
+			reduce(45), // message, reduce: ValueExpr
+			reduce(45), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(45), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(45), // service, reduce: ValueExpr
+			reduce(45), // project, reduce: ValueExpr
+			reduce(45), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(24), // service, reduce: OptionValue
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(24), // project, reduce: OptionValue
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S35
+	actionRow{ // S49
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(21), // ␚, reduce: OptionValue
+			reduce(44), // ␚, reduce: ValueExpr
 			nil,        // empty
-			reduce(21), // ;, reduce: OptionValue
+			reduce(44), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(21), // tok_identifier, reduce: OptionValue
+			reduce(44), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			reduce(21), // enum, reduce: OptionValue
+			nil,        // =
+			reduce(44), // enum, reduce: ValueExpr
 			nil,        // {
 			nil,        // }
-			reduce(21), // message, reduce: OptionValue
-			nil,        // =
+			reduce(44), // message, reduce: ValueExpr
+			reduce(44), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(44), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(44), // service, reduce: ValueExpr
+			reduce(44), // project, reduce: ValueExpr
+			reduce(44), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(21), // service, reduce: OptionValue
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(21), // project, reduce: OptionValue
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S36
+	actionRow{ // S50
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(22), // ␚, reduce: OptionValue
+			nil,        // ␚
 			nil,        // empty
-			reduce(22), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(22), // tok_identifier, reduce: OptionValue
+			reduce(53), // tok_identifier, reduce: ValueFields
 			nil,        // import
 			nil,        // tok_literal
-			reduce(22), // enum, reduce: OptionValue
-			nil,        // {
-			nil,        // }
-			reduce(22), // message, reduce: OptionValue
 			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(22), // service, reduce: OptionValue
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(22), // project, reduce: OptionValue
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S37
+	actionRow{ // S51
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(23), // ␚, reduce: OptionValue
+			reduce(39), // ␚, reduce: OptionValue
 			nil,        // empty
-			reduce(23), // ;, reduce: OptionValue
+			reduce(39), // ;, reduce: OptionValue
 			nil,        // package
-			reduce(23), // tok_identifier, reduce: OptionValue
+			reduce(39), // tok_identifier, reduce: OptionValue
 			nil,        // import
 			nil,        // tok_literal
-			reduce(23), // enum, reduce: OptionValue
+			nil,        // =
+			reduce(39), // enum, reduce: OptionValue
 			nil,        // {
 			nil,        // }
-			reduce(23), // message, reduce: OptionValue
-			nil,        // =
+			reduce(39), // message, reduce: OptionValue
+			reduce(39), // tok_tag, reduce: OptionValue
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(39), // option_schema, reduce: OptionValue
+			nil,        // oneof
+			reduce(39), // service, reduce: OptionValue
+			reduce(39), // project, reduce: OptionValue
+			reduce(39), // const, reduce: OptionValue
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(23), // service, reduce: OptionValue
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(23), // project, reduce: OptionValue
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S38
+	actionRow{ // S52
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(40), // ␚, reduce: ValueExpr
 			nil,        // empty
-			nil,        // ;
+			reduce(40), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(18), // tok_identifier, reduce: Options
+			reduce(40), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(18), // }, reduce: Options
-			nil,        // message
 			nil,        // =
+			reduce(40), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(40), // message, reduce: ValueExpr
+			reduce(40), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(40), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(40), // service, reduce: ValueExpr
+			reduce(40), // project, reduce: ValueExpr
+			reduce(40), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S39
+	actionRow{ // S53
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(41), // ␚, reduce: ValueExpr
 			nil,        // empty
-			nil,        // ;
+			reduce(41), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(25), // tok_identifier, reduce: Fields
+			reduce(41), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(25), // }, reduce: Fields
-			reduce(25), // message, reduce: Fields
 			nil,        // =
+			reduce(41), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(41), // message, reduce: ValueExpr
+			reduce(41), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(25), // map, reduce: Fields
-			nil,        // <
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
 			nil,        // ,
+			nil,        // :
+			reduce(41), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(41), // service, reduce: ValueExpr
+			reduce(41), // project, reduce: ValueExpr
+			reduce(41), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
 			nil,        // >
-			reduce(25), // [, reduce: Fields
-			nil,        // ]
-			reduce(25), // repeated, reduce: Fields
-			nil,        // service
+			nil,        // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S40
+	actionRow{ // S54
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(42), // ␚, reduce: ValueExpr
 			nil,        // empty
-			nil,        // ;
+			reduce(42), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(38), // tok_identifier, reduce: ServiceElements
+			reduce(42), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(38), // }, reduce: ServiceElements
-			nil,        // message
 			nil,        // =
+			reduce(42), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(42), // message, reduce: ValueExpr
+			reduce(42), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(42), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(42), // service, reduce: ValueExpr
+			reduce(42), // project, reduce: ValueExpr
+			reduce(42), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(38), // call, reduce: ServiceElements
-			nil,        // :
-			reduce(38), // notify, reduce: ServiceElements
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S41
+	actionRow{ // S55
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(43), // ␚, reduce: ValueExpr
 			nil,        // empty
-			nil,        // ;
+			reduce(43), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(48), // tok_identifier, reduce: ProjElements
+			reduce(43), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(48), // }, reduce: ProjElements
-			nil,        // message
 			nil,        // =
+			reduce(43), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(43), // message, reduce: ValueExpr
+			reduce(43), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(43), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(43), // service, reduce: ValueExpr
+			reduce(43), // project, reduce: ValueExpr
+			reduce(43), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S42
+	actionRow{ // S56
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(8), // ␚, reduce: Import
+			nil,       // ␚
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			reduce(8), // tok_identifier, reduce: Import
-			reduce(8), // import, reduce: Import
-			nil,       // tok_literal
-			reduce(8), // enum, reduce: Import
-			nil,       // {
-			nil,       // }
-			reduce(8), // message, reduce: Import
+			shift(70), // tok_identifier
+			nil,       // import
+			shift(71), // tok_literal
 			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
+			nil,       // enum
+			shift(72), // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
+			shift(74), // true
+			shift(75), // false
+			shift(76), // tok_num
+			shift(77), // tok_float
+			shift(78), // [
+			shift(79), // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			reduce(8), // service, reduce: Import
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(8), // project, reduce: Import
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S43
+	actionRow{ // S57
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(38), // ␚, reduce: OptionExpr
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(38), // tok_identifier, reduce: OptionExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(38), // enum, reduce: OptionExpr
+			nil,        // {
+			nil,        // }
+			reduce(38), // message, reduce: OptionExpr
+			reduce(38), // tok_tag, reduce: OptionExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(38), // option_schema, reduce: OptionExpr
+			nil,        // oneof
+			reduce(38), // service, reduce: OptionExpr
+			reduce(38), // project, reduce: OptionExpr
+			reduce(38), // const, reduce: OptionExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S58
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(3), // ␚, reduce: OptEnd
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(47), // tok_identifier
+			reduce(3), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			shift(49), // }
-			nil,       // message
 			nil,       // =
+			reduce(3), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(3), // message, reduce: OptEnd
+			reduce(3), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(3), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(3), // service, reduce: OptEnd
+			reduce(3), // project, reduce: OptEnd
+			reduce(3), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S44
+	actionRow{ // S59
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(21), // tok_identifier, reduce: EnumElements
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(21), // }, reduce: EnumElements
+			nil,        // message
+			reduce(21), // tok_tag, reduce: EnumElements
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(21), // reserved, reduce: EnumElements
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1603,35 +2719,44 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(50), // tok_identifier
+			shift(82), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
-			shift(53), // }
-			shift(54), // message
-			nil,       // =
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
-			nil,       // tok_num
-			shift(57), // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			shift(58), // [
+			shift(83), // tok_num
+			nil,       // tok_float
+			nil,       // [
 			nil,       // ]
-			shift(59), // repeated
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
 			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S45
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1639,35 +2764,89 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(60), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
-			nil,       // {
-			shift(62), // }
+			shift(84), // {
+			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
+			nil,       // tok_float
 			nil,       // [
 			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			shift(65), // call
+			nil,       // ,
 			nil,       // :
-			shift(66), // notify
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S46
+	actionRow{ // S62
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(56), // tok_identifier, reduce: SchemaItems
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(56), // }, reduce: SchemaItems
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1675,35 +2854,44 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(67), // tok_identifier
+			nil,       // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
-			nil,       // {
-			shift(69), // }
+			shift(86), // {
+			nil,       // }
 			nil,       // message
-			nil,       // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S47
+	actionRow{ // S64
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1711,107 +2899,269 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			nil,       // tok_identifier
+			shift(87), // tok_identifier
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			nil,       // }
 			nil,       // message
-			shift(72), // =
+			nil,       // tok_tag
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S65
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(135), // tok_identifier, reduce: ProjElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(135), // }, reduce: ProjElements
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S66
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			nil,       // tok_identifier
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			shift(90), // {
+			nil,       // }
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
 			nil,       // [
 			nil,       // ]
-			nil,       // repeated
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
 			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S48
+	actionRow{ // S67
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			nil,        // ;
-			nil,        // package
-			reduce(19), // tok_identifier, reduce: Options
-			nil,        // import
-			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(19), // }, reduce: Options
-			nil,        // message
-			nil,        // =
-			nil,        // true
-			nil,        // false
-			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
-			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			nil,        // call
-			nil,        // :
-			nil,        // notify
-			nil,        // (
-			nil,        // )
-			nil,        // project
-			nil,        // tok_doc
+			nil,       // INVALID
+			reduce(8), // ␚, reduce: Import
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(8), // tok_identifier, reduce: Import
+			reduce(8), // import, reduce: Import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(8), // enum, reduce: Import
+			nil,       // {
+			nil,       // }
+			reduce(8), // message, reduce: Import
+			reduce(8), // tok_tag, reduce: Import
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(8), // option_schema, reduce: Import
+			nil,       // oneof
+			reduce(8), // service, reduce: Import
+			reduce(8), // project, reduce: Import
+			reduce(8), // const, reduce: Import
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S49
+	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(2), // ␚, reduce: OptEnd
 			nil,       // empty
-			shift(33), // ;
+			shift(58), // ;
 			nil,       // package
 			reduce(2), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			reduce(2), // enum, reduce: OptEnd
 			nil,       // {
 			nil,       // }
 			reduce(2), // message, reduce: OptEnd
-			nil,       // =
+			reduce(2), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S69
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			shift(92), // tok_identifier
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			nil,       // {
+			shift(93), // }
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
 			nil,       // [
 			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
 			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			reduce(2), // project, reduce: OptEnd
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S50
+	actionRow{ // S70
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1819,35 +3169,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(36), // tok_identifier, reduce: FieldType
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			shift(75),  // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(45), // ], reduce: ValueExpr
+			reduce(45), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S51
+	actionRow{ // S71
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1855,35 +3214,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(28), // tok_identifier, reduce: Fields
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(28), // }, reduce: Fields
-			reduce(28), // message, reduce: Fields
-			nil,        // =
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(28), // map, reduce: Fields
+			nil,        // tok_float
+			nil,        // [
+			reduce(44), // ], reduce: ValueExpr
+			reduce(44), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			reduce(28), // [, reduce: Fields
-			nil,        // ]
-			reduce(28), // repeated, reduce: Fields
-			nil,        // service
+			nil,        // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S52
+	actionRow{ // S72
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1891,107 +3259,134 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(27), // tok_identifier, reduce: Fields
+			reduce(53), // tok_identifier, reduce: ValueFields
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(27), // }, reduce: Fields
-			reduce(27), // message, reduce: Fields
-			nil,        // =
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(27), // map, reduce: Fields
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(27), // [, reduce: Fields
+			nil,        // tok_float
+			nil,        // [
 			nil,        // ]
-			reduce(27), // repeated, reduce: Fields
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S53
+	actionRow{ // S73
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
-			nil,       // empty
-			shift(33), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			reduce(2), // enum, reduce: OptEnd
-			nil,       // {
-			nil,       // }
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			reduce(2), // project, reduce: OptEnd
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(49), // ], reduce: ValueList
+			reduce(49), // ,, reduce: ValueList
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S54
+	actionRow{ // S74
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			shift(77), // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(40), // ], reduce: ValueExpr
+			reduce(40), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S55
+	actionRow{ // S75
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -1999,35 +3394,134 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(26), // tok_identifier, reduce: Fields
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(26), // }, reduce: Fields
-			reduce(26), // message, reduce: Fields
-			nil,        // =
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(26), // map, reduce: Fields
+			nil,        // tok_float
+			nil,        // [
+			reduce(41), // ], reduce: ValueExpr
+			reduce(41), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			reduce(26), // [, reduce: Fields
-			nil,        // ]
-			reduce(26), // repeated, reduce: Fields
-			nil,        // service
+			nil,        // repeated
 			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S76
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(42), // ], reduce: ValueExpr
+			reduce(42), // ,, reduce: ValueExpr
 			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
 			nil,        // notify
 			nil,        // (
 			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S77
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(43), // ], reduce: ValueExpr
+			reduce(43), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
 			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S56
+	actionRow{ // S78
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2035,179 +3529,12239 @@ var actionTab = actionTable{
 			nil,       // empty
 			nil,       // ;
 			nil,       // package
-			shift(78), // tok_identifier
+			shift(70), // tok_identifier
 			nil,       // import
-			nil,       // tok_literal
+			shift(71), // tok_literal
+			nil,       // =
 			nil,       // enum
-			nil,       // {
+			shift(72), // {
 			nil,       // }
 			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
+			nil,       // tok_tag
+			shift(74), // true
+			shift(75), // false
+			shift(76), // tok_num
+			shift(77), // tok_float
+			shift(78), // [
+			shift(95), // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
 			nil,       // map
 			nil,       // <
-			nil,       // ,
 			nil,       // >
-			nil,       // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
 			nil,       // call
-			nil,       // :
 			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S57
+	actionRow{ // S79
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
+			nil,        // INVALID
+			reduce(46), // ␚, reduce: ValueExpr
+			nil,        // empty
+			reduce(46), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(46), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(46), // message, reduce: ValueExpr
+			reduce(46), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(46), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(46), // service, reduce: ValueExpr
+			reduce(46), // project, reduce: ValueExpr
+			reduce(46), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S80
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S81
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(99),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(101), // }
+			nil,        // message
+			shift(104), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			shift(105), // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S82
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(133), // {, reduce: MethodNo
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S83
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(132), // {, reduce: MethodNo
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S84
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(74), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(74), // enum, reduce: Fields
+			nil,        // {
+			reduce(74), // }, reduce: Fields
+			reduce(74), // message, reduce: Fields
+			reduce(74), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(74), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(74), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(74), // include, reduce: Fields
+			reduce(74), // reserved, reduce: Fields
+			reduce(74), // optional, reduce: Fields
+			reduce(74), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(74), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S85
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(107), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(108), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S86
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(109), // tok_identifier, reduce: ServiceElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(109), // }, reduce: ServiceElements
+			nil,         // message
+			reduce(109), // tok_tag, reduce: ServiceElements
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(109), // call, reduce: ServiceElements
+			reduce(109), // notify, reduce: ServiceElements
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S87
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(119), // {, reduce: ThrowsList
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(119), // ,, reduce: ThrowsList
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S88
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(118), // {, reduce: MethodThrows
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			shift(110),  // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S89
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(111), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(113), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S90
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(71), // tok_identifier, reduce: ConstValues
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(71), // }, reduce: ConstValues
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S91
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(19), // ␚, reduce: TypeAlias
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(19), // tok_identifier, reduce: TypeAlias
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(19), // enum, reduce: TypeAlias
+			nil,        // {
+			nil,        // }
+			reduce(19), // message, reduce: TypeAlias
+			reduce(19), // tok_tag, reduce: TypeAlias
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(19), // option_schema, reduce: TypeAlias
+			nil,        // oneof
+			reduce(19), // service, reduce: TypeAlias
+			reduce(19), // project, reduce: TypeAlias
+			reduce(19), // const, reduce: TypeAlias
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S92
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			shift(116), // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S93
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(48), // ␚, reduce: ValueExpr
+			nil,        // empty
+			reduce(48), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(48), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(48), // message, reduce: ValueExpr
+			reduce(48), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(48), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(48), // service, reduce: ValueExpr
+			reduce(48), // project, reduce: ValueExpr
+			reduce(48), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S94
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(117), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S95
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(46), // ], reduce: ValueExpr
+			reduce(46), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S96
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S97
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(119), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S98
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			reduce(52), // ], reduce: OptComma
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S99
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(121), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S100
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(22), // tok_identifier, reduce: EnumElements
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(22), // }, reduce: EnumElements
+			nil,        // message
+			reduce(22), // tok_tag, reduce: EnumElements
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(22), // reserved, reduce: EnumElements
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S101
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(99),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			shift(125), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(24), // tok_identifier, reduce: EnumElements
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(24), // }, reduce: EnumElements
+			nil,        // message
+			reduce(24), // tok_tag, reduce: EnumElements
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(24), // reserved, reduce: EnumElements
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S104
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(26), // tok_identifier, reduce: Tags
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			reduce(26), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S105
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			shift(126), // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(127), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S106
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(131), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			shift(135), // enum
+			nil,        // {
+			shift(136), // }
+			shift(140), // message
+			shift(141), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			shift(145), // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			shift(148), // include
+			shift(149), // reserved
+			shift(151), // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S107
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(154), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S108
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S109
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(156), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(158), // }
+			nil,        // message
+			shift(104), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			shift(163), // call
+			shift(164), // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S110
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(165), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S111
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(166), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			shift(168), // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S112
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(137), // tok_identifier, reduce: ProjElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(137), // }, reduce: ProjElements
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S113
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S114
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(136), // tok_identifier, reduce: ProjElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(136), // }, reduce: ProjElements
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(170), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(171), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(172), // tok_identifier
+			nil,        // import
+			shift(173), // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(174), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(176), // true
+			shift(177), // false
+			shift(178), // tok_num
+			shift(179), // tok_float
+			shift(180), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(48), // ], reduce: ValueExpr
+			reduce(48), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(181), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(47), // ␚, reduce: ValueExpr
+			nil,        // empty
+			reduce(47), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(47), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(47), // enum, reduce: ValueExpr
+			nil,        // {
+			nil,        // }
+			reduce(47), // message, reduce: ValueExpr
+			reduce(47), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(47), // option_schema, reduce: ValueExpr
+			nil,        // oneof
+			reduce(47), // service, reduce: ValueExpr
+			reduce(47), // project, reduce: ValueExpr
+			reduce(47), // const, reduce: ValueExpr
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(50), // ], reduce: ValueList
+			reduce(50), // ,, reduce: ValueList
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(182), // tok_identifier
+			nil,        // import
+			shift(183), // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(184), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(186), // true
+			shift(187), // false
+			shift(188), // tok_num
+			shift(189), // tok_float
+			shift(190), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(192), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(2),  // reserved, reduce: OptEnd
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(20), // ␚, reduce: Enum
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(20), // tok_identifier, reduce: Enum
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(20), // enum, reduce: Enum
+			nil,        // {
+			nil,        // }
+			reduce(20), // message, reduce: Enum
+			reduce(20), // tok_tag, reduce: Enum
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(20), // option_schema, reduce: Enum
+			nil,        // oneof
+			reduce(20), // service, reduce: Enum
+			reduce(20), // project, reduce: Enum
+			reduce(20), // const, reduce: Enum
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(23), // tok_identifier, reduce: EnumElements
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(23), // }, reduce: EnumElements
+			nil,        // message
+			reduce(23), // tok_tag, reduce: EnumElements
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(23), // reserved, reduce: EnumElements
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(27), // tok_identifier, reduce: Tags
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			reduce(27), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(91), // ;, reduce: ReservedNames
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(91), // ,, reduce: ReservedNames
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(88), // ;, reduce: ReservedRange
+			nil,        // package
+			shift(193), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(88), // ,, reduce: ReservedRange
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(194), // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(195), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(196), // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(197), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(86), // ;, reduce: ReservedRanges
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(86), // ,, reduce: ReservedRanges
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(107), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			shift(198),  // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(78), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(78), // enum, reduce: Fields
+			nil,        // {
+			reduce(78), // }, reduce: Fields
+			reduce(78), // message, reduce: Fields
+			reduce(78), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(78), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(78), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(78), // include, reduce: Fields
+			reduce(78), // reserved, reduce: Fields
+			reduce(78), // optional, reduce: Fields
+			reduce(78), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(78), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(77), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(77), // enum, reduce: Fields
+			nil,        // {
+			reduce(77), // }, reduce: Fields
+			reduce(77), // message, reduce: Fields
+			reduce(77), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(77), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(77), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(77), // include, reduce: Fields
+			reduce(77), // reserved, reduce: Fields
+			reduce(77), // optional, reduce: Fields
+			reduce(77), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(77), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(76), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(76), // enum, reduce: Fields
+			nil,        // {
+			reduce(76), // }, reduce: Fields
+			reduce(76), // message, reduce: Fields
+			reduce(76), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(76), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(76), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(76), // include, reduce: Fields
+			reduce(76), // reserved, reduce: Fields
+			reduce(76), // optional, reduce: Fields
+			reduce(76), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(76), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(200), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(202), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			shift(140), // message
+			shift(204), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			shift(151), // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(80), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(80), // enum, reduce: Fields
+			nil,        // {
+			reduce(80), // }, reduce: Fields
+			reduce(80), // message, reduce: Fields
+			reduce(80), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(80), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(80), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(80), // include, reduce: Fields
+			reduce(80), // reserved, reduce: Fields
+			reduce(80), // optional, reduce: Fields
+			reduce(80), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(80), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(28), // tok_identifier, reduce: TaggedMessage
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(28), // enum, reduce: TaggedMessage
+			nil,        // {
+			reduce(28), // }, reduce: TaggedMessage
+			reduce(28), // message, reduce: TaggedMessage
+			reduce(28), // tok_tag, reduce: TaggedMessage
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(28), // [, reduce: TaggedMessage
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(28), // oneof, reduce: TaggedMessage
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(28), // include, reduce: TaggedMessage
+			reduce(28), // reserved, reduce: TaggedMessage
+			reduce(28), // optional, reduce: TaggedMessage
+			reduce(28), // map, reduce: TaggedMessage
+			nil,        // <
+			nil,        // >
+			reduce(28), // repeated, reduce: TaggedMessage
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(206), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(26), // tok_identifier, reduce: Tags
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			reduce(26), // message, reduce: Tags
+			reduce(26), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(26), // [, reduce: Tags
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(26), // optional, reduce: Tags
+			reduce(26), // map, reduce: Tags
+			nil,        // <
+			nil,        // >
+			reduce(26), // repeated, reduce: Tags
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(75), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(75), // enum, reduce: Fields
+			nil,        // {
+			reduce(75), // }, reduce: Fields
+			reduce(75), // message, reduce: Fields
+			reduce(75), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(75), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(75), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(75), // include, reduce: Fields
+			reduce(75), // reserved, reduce: Fields
+			reduce(75), // optional, reduce: Fields
+			reduce(75), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(75), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(30), // tok_identifier, reduce: TaggedField
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(30), // enum, reduce: TaggedField
+			nil,        // {
+			reduce(30), // }, reduce: TaggedField
+			reduce(30), // message, reduce: TaggedField
+			reduce(30), // tok_tag, reduce: TaggedField
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(30), // [, reduce: TaggedField
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(30), // oneof, reduce: TaggedField
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(30), // include, reduce: TaggedField
+			reduce(30), // reserved, reduce: TaggedField
+			reduce(30), // optional, reduce: TaggedField
+			reduce(30), // map, reduce: TaggedField
+			nil,        // <
+			nil,        // >
+			reduce(30), // repeated, reduce: TaggedField
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(207), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(208), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(209), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(210), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(79), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(79), // enum, reduce: Fields
+			nil,        // {
+			reduce(79), // }, reduce: Fields
+			reduce(79), // message, reduce: Fields
+			reduce(79), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(79), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(79), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(79), // include, reduce: Fields
+			reduce(79), // reserved, reduce: Fields
+			reduce(79), // optional, reduce: Fields
+			reduce(79), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(79), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(81), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(81), // enum, reduce: Fields
+			nil,        // {
+			reduce(81), // }, reduce: Fields
+			reduce(81), // message, reduce: Fields
+			reduce(81), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(81), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(81), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(81), // include, reduce: Fields
+			reduce(81), // reserved, reduce: Fields
+			reduce(81), // optional, reduce: Fields
+			reduce(81), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(81), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(211), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			shift(126), // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(127), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(214), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(202), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(216), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			shift(217), // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(218), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(58), // ;, reduce: SchemaScope
+			nil,        // package
+			reduce(58), // tok_identifier, reduce: SchemaScope
+			nil,        // import
+			nil,        // tok_literal
+			reduce(58), // =, reduce: SchemaScope
+			nil,        // enum
+			nil,        // {
+			reduce(58), // }, reduce: SchemaScope
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(219), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(55), // ␚, reduce: OptionSchema
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(55), // tok_identifier, reduce: OptionSchema
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(55), // enum, reduce: OptionSchema
+			nil,        // {
+			nil,        // }
+			reduce(55), // message, reduce: OptionSchema
+			reduce(55), // tok_tag, reduce: OptionSchema
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(55), // option_schema, reduce: OptionSchema
+			nil,        // oneof
+			reduce(55), // service, reduce: OptionSchema
+			reduce(55), // project, reduce: OptionSchema
+			reduce(55), // const, reduce: OptionSchema
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(221), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			shift(223), // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			shift(224), // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(111), // tok_identifier, reduce: ServiceElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(111), // }, reduce: ServiceElements
+			nil,         // message
+			reduce(111), // tok_tag, reduce: ServiceElements
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(111), // call, reduce: ServiceElements
+			reduce(111), // notify, reduce: ServiceElements
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S159
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(226), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			shift(125), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(110), // tok_identifier, reduce: ServiceElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(110), // }, reduce: ServiceElements
+			nil,         // message
+			reduce(110), // tok_tag, reduce: ServiceElements
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(110), // call, reduce: ServiceElements
+			reduce(110), // notify, reduce: ServiceElements
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(34), // tok_identifier, reduce: TaggedMethod
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(34), // }, reduce: TaggedMethod
+			nil,        // message
+			reduce(34), // tok_tag, reduce: TaggedMethod
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(34), // call, reduce: TaggedMethod
+			reduce(34), // notify, reduce: TaggedMethod
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(112), // tok_identifier, reduce: ServiceElements
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(112), // }, reduce: ServiceElements
+			nil,         // message
+			reduce(112), // tok_tag, reduce: ServiceElements
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(112), // call, reduce: ServiceElements
+			reduce(112), // notify, reduce: ServiceElements
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			shift(228), // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S164
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			shift(229), // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(120), // {, reduce: ThrowsList
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(120), // ,, reduce: ThrowsList
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(230), // tok_identifier
+			nil,        // import
+			shift(231), // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(232), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(234), // true
+			shift(235), // false
+			shift(236), // tok_num
+			shift(237), // tok_float
+			shift(238), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(240), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S168
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(138), // tok_identifier, reduce: ProjArea
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(138), // }, reduce: ProjArea
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S169
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			reduce(134), // ␚, reduce: Project
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(134), // tok_identifier, reduce: Project
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			reduce(134), // enum, reduce: Project
+			nil,         // {
+			nil,         // }
+			reduce(134), // message, reduce: Project
+			reduce(134), // tok_tag, reduce: Project
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			reduce(134), // option_schema, reduce: Project
+			nil,         // oneof
+			reduce(134), // service, reduce: Project
+			reduce(134), // project, reduce: Project
+			reduce(134), // const, reduce: Project
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S170
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(241), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S171
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(2), // ␚, reduce: OptEnd
+			nil,       // empty
+			shift(58), // ;
+			nil,       // package
+			reduce(2), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(2), // enum, reduce: OptEnd
+			nil,       // {
+			nil,       // }
+			reduce(2), // message, reduce: OptEnd
+			reduce(2), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			reduce(2), // option_schema, reduce: OptEnd
+			nil,       // oneof
+			reduce(2), // service, reduce: OptEnd
+			reduce(2), // project, reduce: OptEnd
+			reduce(2), // const, reduce: OptEnd
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S172
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(45), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S173
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(44), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(44), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S174
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S175
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(51), // tok_identifier, reduce: OptComma
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(51), // }, reduce: OptComma
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(245), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S176
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(40), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(40), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S177
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(41), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(41), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S178
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(42), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(42), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S179
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(43), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(43), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S180
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(246), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S181
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(47), // ], reduce: ValueExpr
+			reduce(47), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S182
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(45), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(45), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S183
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(44), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(44), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(44), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S184
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S185
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: OptionValue
+			nil,        // package
+			reduce(39), // tok_identifier, reduce: OptionValue
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(39), // }, reduce: OptionValue
+			nil,        // message
+			reduce(39), // tok_tag, reduce: OptionValue
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(39), // reserved, reduce: OptionValue
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S186
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(40), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(40), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(40), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(40), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S187
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(41), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(41), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(41), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S188
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(42), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(42), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(42), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S189
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(43), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(43), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(43), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S190
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(249), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S191
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(38), // tok_identifier, reduce: OptionExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(38), // }, reduce: OptionExpr
+			nil,        // message
+			reduce(38), // tok_tag, reduce: OptionExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(38), // reserved, reduce: OptionExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S192
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			nil,       // {
+			reduce(3), // }, reduce: OptEnd
+			nil,       // message
+			reduce(3), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			reduce(3), // reserved, reduce: OptEnd
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S193
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(251), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(252), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S194
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(84), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(84), // }, reduce: Reserved
+			nil,        // message
+			reduce(84), // tok_tag, reduce: Reserved
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(84), // reserved, reduce: Reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S195
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(127), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S196
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(85), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(85), // }, reduce: Reserved
+			nil,        // message
+			reduce(85), // tok_tag, reduce: Reserved
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(85), // reserved, reduce: Reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S197
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			shift(254), // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S198
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(255), // tok_identifier
+			nil,        // import
+			shift(256), // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(257), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(259), // true
+			shift(260), // false
+			shift(261), // tok_num
+			shift(262), // tok_float
+			shift(263), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S199
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(265), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S200
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(266), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S201
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(25), // ␚, reduce: Message
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(25), // tok_identifier, reduce: Message
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(25), // enum, reduce: Message
+			nil,        // {
+			nil,        // }
+			reduce(25), // message, reduce: Message
+			reduce(25), // tok_tag, reduce: Message
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(25), // option_schema, reduce: Message
+			nil,        // oneof
+			reduce(25), // service, reduce: Message
+			reduce(25), // project, reduce: Message
+			reduce(25), // const, reduce: Message
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S202
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(107), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S203
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(29), // tok_identifier, reduce: TaggedMessage
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(29), // enum, reduce: TaggedMessage
+			nil,        // {
+			reduce(29), // }, reduce: TaggedMessage
+			reduce(29), // message, reduce: TaggedMessage
+			reduce(29), // tok_tag, reduce: TaggedMessage
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(29), // [, reduce: TaggedMessage
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(29), // oneof, reduce: TaggedMessage
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(29), // include, reduce: TaggedMessage
+			reduce(29), // reserved, reduce: TaggedMessage
+			reduce(29), // optional, reduce: TaggedMessage
+			reduce(29), // map, reduce: TaggedMessage
+			nil,        // <
+			nil,        // >
+			reduce(29), // repeated, reduce: TaggedMessage
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S204
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(27), // tok_identifier, reduce: Tags
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			reduce(27), // message, reduce: Tags
+			reduce(27), // tok_tag, reduce: Tags
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(27), // [, reduce: Tags
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(27), // optional, reduce: Tags
+			reduce(27), // map, reduce: Tags
+			nil,        // <
+			nil,        // >
+			reduce(27), // repeated, reduce: Tags
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S205
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(31), // tok_identifier, reduce: TaggedField
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(31), // enum, reduce: TaggedField
+			nil,        // {
+			reduce(31), // }, reduce: TaggedField
+			reduce(31), // message, reduce: TaggedField
+			reduce(31), // tok_tag, reduce: TaggedField
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(31), // [, reduce: TaggedField
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(31), // oneof, reduce: TaggedField
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(31), // include, reduce: TaggedField
+			reduce(31), // reserved, reduce: TaggedField
+			reduce(31), // optional, reduce: TaggedField
+			reduce(31), // map, reduce: TaggedField
+			nil,        // <
+			nil,        // >
+			reduce(31), // repeated, reduce: TaggedField
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S206
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			shift(60),   // =
+			nil,         // enum
+			reduce(131), // {, reduce: MethodNo
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S207
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(268), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S208
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(269), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S209
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(270), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S210
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(271), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S211
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(265), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			shift(273), // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S212
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(274), // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(195), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S213
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(275), // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(197), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S214
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(276), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S215
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(277), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S216
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(278), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S217
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(279), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S218
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(103), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S219
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(280), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			shift(281), // enum
+			nil,        // {
+			nil,        // }
+			shift(282), // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			shift(285), // oneof
+			shift(286), // service
+			shift(287), // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S220
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(68), // ;, reduce: SchemaDefault
+			nil,        // package
+			reduce(68), // tok_identifier, reduce: SchemaDefault
+			nil,        // import
+			nil,        // tok_literal
+			shift(166), // =
+			nil,        // enum
+			nil,        // {
+			reduce(68), // }, reduce: SchemaDefault
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S221
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(290), // tok_identifier
+			nil,        // import
+			shift(291), // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(292), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(294), // true
+			shift(295), // false
+			shift(296), // tok_num
+			shift(297), // tok_float
+			shift(298), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S222
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(300), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(2),  // call, reduce: OptEnd
+			reduce(2),  // notify, reduce: OptEnd
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S223
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(115), // tok_identifier, reduce: MethodFlag
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(115), // }, reduce: MethodFlag
+			nil,         // message
+			reduce(115), // tok_tag, reduce: MethodFlag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(115), // call, reduce: MethodFlag
+			reduce(115), // notify, reduce: MethodFlag
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S224
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			shift(301),  // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			shift(144),  // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			shift(152),  // map
+			nil,         // <
+			nil,         // >
+			shift(153),  // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(121), // ), reduce: MethodRequest
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S225
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			reduce(108), // ␚, reduce: Service
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(108), // tok_identifier, reduce: Service
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			reduce(108), // enum, reduce: Service
+			nil,         // {
+			nil,         // }
+			reduce(108), // message, reduce: Service
+			reduce(108), // tok_tag, reduce: Service
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			reduce(108), // option_schema, reduce: Service
+			nil,         // oneof
+			reduce(108), // service, reduce: Service
+			reduce(108), // project, reduce: Service
+			reduce(108), // const, reduce: Service
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S226
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			shift(224), // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S227
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(35), // tok_identifier, reduce: TaggedMethod
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(35), // }, reduce: TaggedMethod
+			nil,        // message
+			reduce(35), // tok_tag, reduce: TaggedMethod
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(35), // call, reduce: TaggedMethod
+			reduce(35), // notify, reduce: TaggedMethod
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S228
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(113), // tok_identifier, reduce: MethodFlag
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(113), // }, reduce: MethodFlag
+			nil,         // message
+			reduce(113), // tok_tag, reduce: MethodFlag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(113), // call, reduce: MethodFlag
+			reduce(113), // notify, reduce: MethodFlag
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S229
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(114), // tok_identifier, reduce: MethodFlag
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(114), // }, reduce: MethodFlag
+			nil,         // message
+			reduce(114), // tok_tag, reduce: MethodFlag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(114), // call, reduce: MethodFlag
+			reduce(114), // notify, reduce: MethodFlag
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S230
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S231
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(44), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S232
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S233
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: OptionValue
+			nil,        // package
+			reduce(39), // tok_identifier, reduce: OptionValue
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(39), // }, reduce: OptionValue
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S234
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(40), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(40), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S235
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(41), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S236
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(42), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S237
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(43), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S238
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(306), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S239
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(38), // tok_identifier, reduce: OptionExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(38), // }, reduce: OptionExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S240
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			nil,       // {
+			reduce(3), // }, reduce: OptEnd
+			nil,       // message
+			nil,       // tok_tag
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
+			nil,       // >
+			nil,       // repeated
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S241
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			shift(308), // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(309), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S242
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(70), // ␚, reduce: Const
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(70), // tok_identifier, reduce: Const
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(70), // enum, reduce: Const
+			nil,        // {
+			nil,        // }
+			reduce(70), // message, reduce: Const
+			reduce(70), // tok_tag, reduce: Const
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			reduce(70), // option_schema, reduce: Const
+			nil,        // oneof
+			reduce(70), // service, reduce: Const
+			reduce(70), // project, reduce: Const
+			reduce(70), // const, reduce: Const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S243
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(310), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S244
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(54), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(54), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S245
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(52), // tok_identifier, reduce: OptComma
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(52), // }, reduce: OptComma
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S246
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(46), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(46), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S247
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S248
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(312), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S249
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(46), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(46), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(46), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S250
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S251
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(90), // ;, reduce: ReservedRange
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(90), // ,, reduce: ReservedRange
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S252
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(89), // ;, reduce: ReservedRange
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(89), // ,, reduce: ReservedRange
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S253
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(87), // ;, reduce: ReservedRanges
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(87), // ,, reduce: ReservedRanges
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S254
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(92), // ;, reduce: ReservedNames
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(92), // ,, reduce: ReservedNames
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S255
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(45), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(45), // }, reduce: ValueExpr
+			reduce(45), // message, reduce: ValueExpr
+			reduce(45), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(45), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(45), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(45), // include, reduce: ValueExpr
+			reduce(45), // reserved, reduce: ValueExpr
+			reduce(45), // optional, reduce: ValueExpr
+			reduce(45), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S256
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(44), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(44), // }, reduce: ValueExpr
+			reduce(44), // message, reduce: ValueExpr
+			reduce(44), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(44), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(44), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(44), // include, reduce: ValueExpr
+			reduce(44), // reserved, reduce: ValueExpr
+			reduce(44), // optional, reduce: ValueExpr
+			reduce(44), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(44), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S257
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S258
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: OptionValue
+			nil,        // package
+			reduce(39), // tok_identifier, reduce: OptionValue
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(39), // enum, reduce: OptionValue
+			nil,        // {
+			reduce(39), // }, reduce: OptionValue
+			reduce(39), // message, reduce: OptionValue
+			reduce(39), // tok_tag, reduce: OptionValue
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(39), // [, reduce: OptionValue
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(39), // oneof, reduce: OptionValue
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(39), // include, reduce: OptionValue
+			reduce(39), // reserved, reduce: OptionValue
+			reduce(39), // optional, reduce: OptionValue
+			reduce(39), // map, reduce: OptionValue
+			nil,        // <
+			nil,        // >
+			reduce(39), // repeated, reduce: OptionValue
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S259
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(40), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(40), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(40), // }, reduce: ValueExpr
+			reduce(40), // message, reduce: ValueExpr
+			reduce(40), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(40), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(40), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(40), // include, reduce: ValueExpr
+			reduce(40), // reserved, reduce: ValueExpr
+			reduce(40), // optional, reduce: ValueExpr
+			reduce(40), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(40), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S260
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(41), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(41), // }, reduce: ValueExpr
+			reduce(41), // message, reduce: ValueExpr
+			reduce(41), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(41), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(41), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(41), // include, reduce: ValueExpr
+			reduce(41), // reserved, reduce: ValueExpr
+			reduce(41), // optional, reduce: ValueExpr
+			reduce(41), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(41), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S261
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(42), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(42), // }, reduce: ValueExpr
+			reduce(42), // message, reduce: ValueExpr
+			reduce(42), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(42), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(42), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(42), // include, reduce: ValueExpr
+			reduce(42), // reserved, reduce: ValueExpr
+			reduce(42), // optional, reduce: ValueExpr
+			reduce(42), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(42), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S262
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(43), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(43), // }, reduce: ValueExpr
+			reduce(43), // message, reduce: ValueExpr
+			reduce(43), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(43), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(43), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(43), // include, reduce: ValueExpr
+			reduce(43), // reserved, reduce: ValueExpr
+			reduce(43), // optional, reduce: ValueExpr
+			reduce(43), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(43), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S263
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(315), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S264
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(38), // tok_identifier, reduce: OptionExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(38), // enum, reduce: OptionExpr
+			nil,        // {
+			reduce(38), // }, reduce: OptionExpr
+			reduce(38), // message, reduce: OptionExpr
+			reduce(38), // tok_tag, reduce: OptionExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(38), // [, reduce: OptionExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(38), // oneof, reduce: OptionExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(38), // include, reduce: OptionExpr
+			reduce(38), // reserved, reduce: OptionExpr
+			reduce(38), // optional, reduce: OptionExpr
+			reduce(38), // map, reduce: OptionExpr
+			nil,        // <
+			nil,        // >
+			reduce(38), // repeated, reduce: OptionExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S265
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			reduce(3), // enum, reduce: OptEnd
+			nil,       // {
+			reduce(3), // }, reduce: OptEnd
+			reduce(3), // message, reduce: OptEnd
+			reduce(3), // tok_tag, reduce: OptEnd
+			nil,       // true
+			nil,       // false
+			nil,       // tok_num
+			nil,       // tok_float
+			reduce(3), // [, reduce: OptEnd
+			nil,       // ]
+			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			reduce(3), // oneof, reduce: OptEnd
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			reduce(3), // include, reduce: OptEnd
+			reduce(3), // reserved, reduce: OptEnd
+			reduce(3), // optional, reduce: OptEnd
+			reduce(3), // map, reduce: OptEnd
+			nil,       // <
+			nil,       // >
+			reduce(3), // repeated, reduce: OptEnd
+			nil,       // call
+			nil,       // notify
+			nil,       // (
+			nil,       // )
+			nil,       // throws
+			nil,       // tok_doc
+		},
+	},
+	actionRow{ // S266
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(21), // tok_identifier, reduce: EnumElements
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(21), // }, reduce: EnumElements
+			nil,        // message
+			reduce(21), // tok_tag, reduce: EnumElements
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(21), // reserved, reduce: EnumElements
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S267
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(318), // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S268
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(319), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S269
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(320), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S270
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(104), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S271
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(94), // tok_identifier, reduce: OneofFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(94), // }, reduce: OneofFields
+			nil,        // message
+			reduce(94), // tok_tag, reduce: OneofFields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(94), // [, reduce: OneofFields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(94), // optional, reduce: OneofFields
+			reduce(94), // map, reduce: OneofFields
+			nil,        // <
+			nil,        // >
+			reduce(94), // repeated, reduce: OneofFields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S272
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(82), // tok_identifier, reduce: Include
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(82), // enum, reduce: Include
+			nil,        // {
+			reduce(82), // }, reduce: Include
+			reduce(82), // message, reduce: Include
+			reduce(82), // tok_tag, reduce: Include
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(82), // [, reduce: Include
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(82), // oneof, reduce: Include
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(82), // include, reduce: Include
+			reduce(82), // reserved, reduce: Include
+			reduce(82), // optional, reduce: Include
+			reduce(82), // map, reduce: Include
+			nil,        // <
+			nil,        // >
+			reduce(82), // repeated, reduce: Include
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S273
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(322), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S274
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(84), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(84), // enum, reduce: Reserved
+			nil,        // {
+			reduce(84), // }, reduce: Reserved
+			reduce(84), // message, reduce: Reserved
+			reduce(84), // tok_tag, reduce: Reserved
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(84), // [, reduce: Reserved
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(84), // oneof, reduce: Reserved
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(84), // include, reduce: Reserved
+			reduce(84), // reserved, reduce: Reserved
+			reduce(84), // optional, reduce: Reserved
+			reduce(84), // map, reduce: Reserved
+			nil,        // <
+			nil,        // >
+			reduce(84), // repeated, reduce: Reserved
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S275
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(85), // tok_identifier, reduce: Reserved
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(85), // enum, reduce: Reserved
+			nil,        // {
+			reduce(85), // }, reduce: Reserved
+			reduce(85), // message, reduce: Reserved
+			reduce(85), // tok_tag, reduce: Reserved
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(85), // [, reduce: Reserved
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(85), // oneof, reduce: Reserved
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(85), // include, reduce: Reserved
+			reduce(85), // reserved, reduce: Reserved
+			reduce(85), // optional, reduce: Reserved
+			reduce(85), // map, reduce: Reserved
+			nil,        // <
+			nil,        // >
+			reduce(85), // repeated, reduce: Reserved
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S276
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(323), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S277
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			shift(324), // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S278
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(325), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S279
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(326), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S280
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(62), // ], reduce: ScopeName
+			reduce(62), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S281
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(65), // ], reduce: ScopeName
+			reduce(65), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S282
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(63), // ], reduce: ScopeName
+			reduce(63), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S283
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(327), // ]
+			shift(328), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S284
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(60), // ], reduce: SchemaScopes
+			reduce(60), // ,, reduce: SchemaScopes
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S285
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(64), // ], reduce: ScopeName
+			reduce(64), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S286
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(66), // ], reduce: ScopeName
+			reduce(66), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S287
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(67), // ], reduce: ScopeName
+			reduce(67), // ,, reduce: ScopeName
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S288
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(69), // ;, reduce: SchemaDefault
+			nil,        // package
+			reduce(69), // tok_identifier, reduce: SchemaDefault
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(69), // }, reduce: SchemaDefault
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S289
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(240), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S290
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(45), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(45), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(45), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(45), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(45), // call, reduce: ValueExpr
+			reduce(45), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S291
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(44), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(44), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(44), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(44), // call, reduce: ValueExpr
+			reduce(44), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S292
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(53), // tok_identifier, reduce: ValueFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S293
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(39), // ;, reduce: OptionValue
+			nil,        // package
+			reduce(39), // tok_identifier, reduce: OptionValue
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(39), // }, reduce: OptionValue
+			nil,        // message
+			reduce(39), // tok_tag, reduce: OptionValue
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(39), // call, reduce: OptionValue
+			reduce(39), // notify, reduce: OptionValue
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S294
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(40), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(40), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(40), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(40), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(40), // call, reduce: ValueExpr
+			reduce(40), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S295
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(41), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(41), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(41), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(41), // call, reduce: ValueExpr
+			reduce(41), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S296
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(42), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(42), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(42), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(42), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(42), // call, reduce: ValueExpr
+			reduce(42), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S297
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(43), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(43), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(43), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(43), // call, reduce: ValueExpr
+			reduce(43), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S298
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(70),  // tok_identifier
+			nil,        // import
+			shift(71),  // tok_literal
+			nil,        // =
+			nil,        // enum
+			shift(72),  // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(331), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S299
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(38), // tok_identifier, reduce: OptionExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(38), // }, reduce: OptionExpr
+			nil,        // message
+			reduce(38), // tok_tag, reduce: OptionExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(38), // call, reduce: OptionExpr
+			reduce(38), // notify, reduce: OptionExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S300
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // ;
+			nil,       // package
+			reduce(3), // tok_identifier, reduce: OptEnd
+			nil,       // import
+			nil,       // tok_literal
+			nil,       // =
+			nil,       // enum
+			nil,       // {
+			reduce(3), // }, reduce: OptEnd
+			nil,       // message
+			reduce(3), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
-			nil,       // map
-			shift(79), // <
+			nil,       // tok_float
+			nil,       // [
+			nil,       // ]
 			nil,       // ,
+			nil,       // :
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			nil,       // optional
+			nil,       // map
+			nil,       // <
 			nil,       // >
-			shift(80), // [
-			nil,       // ]
 			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
+			reduce(3), // call, reduce: OptEnd
+			reduce(3), // notify, reduce: OptEnd
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S58
+	actionRow{ // S301
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(107), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(122), // ), reduce: MethodRequest
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S302
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(333), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S303
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			shift(334), // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S304
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			shift(335),  // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(123), // ), reduce: MethodRequest
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S305
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(336), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S306
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(46), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S307
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S308
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(240), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S309
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(240), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S310
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(48), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(48), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S311
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(340), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S312
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(48), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(48), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(48), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S313
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(341), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S314
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(342), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S315
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(46), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(46), // }, reduce: ValueExpr
+			reduce(46), // message, reduce: ValueExpr
+			reduce(46), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(46), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(46), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(46), // include, reduce: ValueExpr
+			reduce(46), // reserved, reduce: ValueExpr
+			reduce(46), // optional, reduce: ValueExpr
+			reduce(46), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(46), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S316
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S317
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(99),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(344), // }
+			nil,        // message
+			shift(104), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			shift(105), // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S318
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(74), // tok_identifier, reduce: Fields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(74), // enum, reduce: Fields
+			nil,        // {
+			reduce(74), // }, reduce: Fields
+			reduce(74), // message, reduce: Fields
+			reduce(74), // tok_tag, reduce: Fields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(74), // [, reduce: Fields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(74), // oneof, reduce: Fields
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(74), // include, reduce: Fields
+			reduce(74), // reserved, reduce: Fields
+			reduce(74), // optional, reduce: Fields
+			reduce(74), // map, reduce: Fields
+			nil,        // <
+			nil,        // >
+			reduce(74), // repeated, reduce: Fields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S319
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(106), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S320
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(105), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S321
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(346), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(348), // }
+			nil,        // message
+			shift(350), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			shift(354), // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S322
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(265), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S323
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(99), // ;, reduce: FieldOption
+			nil,        // package
+			reduce(99), // tok_identifier, reduce: FieldOption
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(99), // enum, reduce: FieldOption
+			shift(356), // {
+			reduce(99), // }, reduce: FieldOption
+			reduce(99), // message, reduce: FieldOption
+			reduce(99), // tok_tag, reduce: FieldOption
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(99), // [, reduce: FieldOption
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(99), // oneof, reduce: FieldOption
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(99), // include, reduce: FieldOption
+			reduce(99), // reserved, reduce: FieldOption
+			reduce(99), // optional, reduce: FieldOption
+			reduce(99), // map, reduce: FieldOption
+			nil,        // <
+			nil,        // >
+			reduce(99), // repeated, reduce: FieldOption
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S324
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			shift(358), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S325
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(359), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S326
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(360), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S327
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(59), // ;, reduce: SchemaScope
+			nil,        // package
+			reduce(59), // tok_identifier, reduce: SchemaScope
+			nil,        // import
+			nil,        // tok_literal
+			reduce(59), // =, reduce: SchemaScope
+			nil,        // enum
+			nil,        // {
+			reduce(59), // }, reduce: SchemaScope
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S328
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(280), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			shift(281), // enum
+			nil,        // {
+			nil,        // }
+			shift(282), // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			shift(285), // oneof
+			shift(286), // service
+			shift(287), // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S329
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(57), // tok_identifier, reduce: SchemaItems
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(57), // }, reduce: SchemaItems
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S330
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(92),  // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(362), // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S331
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(46), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(46), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(46), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(46), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(46), // call, reduce: ValueExpr
+			reduce(46), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S332
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S333
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			shift(364),  // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(127), // ,, reduce: MethodParams
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(127), // ), reduce: MethodParams
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S334
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(365), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			shift(366), // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S335
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(202), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S336
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(48), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S337
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(369), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S338
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(73), // tok_identifier, reduce: ConstValues
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(73), // }, reduce: ConstValues
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S339
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(72), // tok_identifier, reduce: ConstValues
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(72), // }, reduce: ConstValues
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S340
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(47), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(47), // }, reduce: ValueExpr
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			reduce(47), // ,, reduce: ValueExpr
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S341
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(47), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(47), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(47), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(47), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			reduce(47), // reserved, reduce: ValueExpr
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S342
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(48), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(48), // }, reduce: ValueExpr
+			reduce(48), // message, reduce: ValueExpr
+			reduce(48), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(48), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(48), // oneof, reduce: ValueExpr
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(48), // include, reduce: ValueExpr
+			reduce(48), // reserved, reduce: ValueExpr
+			reduce(48), // optional, reduce: ValueExpr
+			reduce(48), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(48), // repeated, reduce: ValueExpr
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S343
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			nil,        // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(370), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S344
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			shift(81), // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(265), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S59
+	actionRow{ // S345
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			shift(82), // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(131), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			shift(135), // enum
+			nil,        // {
+			shift(372), // }
+			shift(140), // message
+			shift(141), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			shift(145), // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			shift(148), // include
+			shift(149), // reserved
+			shift(151), // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S60
+	actionRow{ // S346
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			shift(84), // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			shift(85), // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(107), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			shift(373),  // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S347
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(96), // tok_identifier, reduce: OneofFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(96), // }, reduce: OneofFields
+			nil,        // message
+			reduce(96), // tok_tag, reduce: OneofFields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(96), // [, reduce: OneofFields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(96), // optional, reduce: OneofFields
+			reduce(96), // map, reduce: OneofFields
+			nil,        // <
+			nil,        // >
+			reduce(96), // repeated, reduce: OneofFields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S348
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(265), // ;
+			nil,        // package
+			reduce(2),  // tok_identifier, reduce: OptEnd
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S349
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(202), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			shift(376), // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			shift(354), // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S61
+	actionRow{ // S350
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2215,71 +15769,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(40), // tok_identifier, reduce: ServiceElements
+			reduce(26), // tok_identifier, reduce: Tags
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(40), // }, reduce: ServiceElements
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			reduce(26), // tok_tag, reduce: Tags
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(26), // [, reduce: Tags
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(40), // call, reduce: ServiceElements
+			nil,        // ,
 			nil,        // :
-			reduce(40), // notify, reduce: ServiceElements
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(26), // optional, reduce: Tags
+			reduce(26), // map, reduce: Tags
+			nil,        // <
+			nil,        // >
+			reduce(26), // repeated, reduce: Tags
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S62
+	actionRow{ // S351
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
-			nil,       // empty
-			shift(33), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			reduce(2), // enum, reduce: OptEnd
-			nil,       // {
-			nil,       // }
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			reduce(2), // project, reduce: OptEnd
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(95), // tok_identifier, reduce: OneofFields
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(95), // }, reduce: OneofFields
+			nil,        // message
+			reduce(95), // tok_tag, reduce: OneofFields
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(95), // [, reduce: OneofFields
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(95), // optional, reduce: OneofFields
+			reduce(95), // map, reduce: OneofFields
+			nil,        // <
+			nil,        // >
+			reduce(95), // repeated, reduce: OneofFields
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S63
+	actionRow{ // S352
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2287,35 +15859,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(39), // tok_identifier, reduce: ServiceElements
+			reduce(30), // tok_identifier, reduce: TaggedField
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(39), // }, reduce: ServiceElements
+			reduce(30), // }, reduce: TaggedField
 			nil,        // message
-			nil,        // =
+			reduce(30), // tok_tag, reduce: TaggedField
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(30), // [, reduce: TaggedField
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(39), // call, reduce: ServiceElements
+			nil,        // ,
 			nil,        // :
-			reduce(39), // notify, reduce: ServiceElements
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(30), // optional, reduce: TaggedField
+			reduce(30), // map, reduce: TaggedField
+			nil,        // <
+			nil,        // >
+			reduce(30), // repeated, reduce: TaggedField
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S64
+	actionRow{ // S353
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2323,143 +15904,134 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(41), // tok_identifier, reduce: ServiceElements
+			shift(378), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(41), // }, reduce: ServiceElements
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(41), // call, reduce: ServiceElements
-			nil,        // :
-			reduce(41), // notify, reduce: ServiceElements
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S65
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			shift(87), // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S66
+	actionRow{ // S354
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			shift(88), // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			shift(202), // tok_identifier
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			shift(144), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S67
+	actionRow{ // S355
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			shift(72), // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			shift(89), // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(83), // tok_identifier, reduce: Include
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(83), // enum, reduce: Include
+			nil,        // {
+			reduce(83), // }, reduce: Include
+			reduce(83), // message, reduce: Include
+			reduce(83), // tok_tag, reduce: Include
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(83), // [, reduce: Include
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(83), // oneof, reduce: Include
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(83), // include, reduce: Include
+			reduce(83), // reserved, reduce: Include
+			reduce(83), // optional, reduce: Include
+			reduce(83), // map, reduce: Include
+			nil,        // <
+			nil,        // >
+			reduce(83), // repeated, reduce: Include
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S68
+	actionRow{ // S356
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2467,251 +16039,224 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(50), // tok_identifier, reduce: ProjElements
+			reduce(36), // tok_identifier, reduce: Options
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(50), // }, reduce: ProjElements
+			reduce(36), // }, reduce: Options
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S69
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // ␚, reduce: OptEnd
-			nil,       // empty
-			shift(33), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			reduce(2), // enum, reduce: OptEnd
-			nil,       // {
-			nil,       // }
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			reduce(2), // service, reduce: OptEnd
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			reduce(2), // project, reduce: OptEnd
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S70
+	actionRow{ // S357
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			shift(265), // ;
 			nil,        // package
-			reduce(49), // tok_identifier, reduce: ProjElements
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(49), // }, reduce: ProjElements
-			nil,        // message
 			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S71
+	actionRow{ // S358
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(92), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(2), // }, reduce: OptEnd
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(99), // ;, reduce: FieldOption
+			nil,        // package
+			reduce(99), // tok_identifier, reduce: FieldOption
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(99), // enum, reduce: FieldOption
+			shift(356), // {
+			reduce(99), // }, reduce: FieldOption
+			reduce(99), // message, reduce: FieldOption
+			reduce(99), // tok_tag, reduce: FieldOption
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(99), // [, reduce: FieldOption
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(99), // oneof, reduce: FieldOption
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(99), // include, reduce: FieldOption
+			reduce(99), // reserved, reduce: FieldOption
+			reduce(99), // optional, reduce: FieldOption
+			reduce(99), // map, reduce: FieldOption
+			nil,        // <
+			nil,        // >
+			reduce(99), // repeated, reduce: FieldOption
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S72
+	actionRow{ // S359
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			nil,       // tok_identifier
-			nil,       // import
-			shift(93), // tok_literal
-			nil,       // enum
-			nil,       // {
-			nil,       // }
-			nil,       // message
-			nil,       // =
-			shift(94), // true
-			shift(95), // false
-			shift(96), // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(102), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
 		},
 	},
-	actionRow{ // S73
+	actionRow{ // S360
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(16), // ␚, reduce: Enum
+			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(16), // tok_identifier, reduce: Enum
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
-			reduce(16), // enum, reduce: Enum
+			nil,        // =
+			nil,        // enum
 			nil,        // {
 			nil,        // }
-			reduce(16), // message, reduce: Enum
-			nil,        // =
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
+			nil,        // tok_float
 			nil,        // [
 			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			shift(383), // >
 			nil,        // repeated
-			reduce(16), // service, reduce: Enum
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(16), // project, reduce: Enum
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S74
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(98), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(2), // }, reduce: OptEnd
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			reduce(2), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(2), // [, reduce: OptEnd
-			nil,       // ]
-			reduce(2), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S75
+	actionRow{ // S361
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2721,69 +16266,132 @@ var actionTab = actionTable{
 			nil,        // package
 			nil,        // tok_identifier
 			nil,        // import
-			shift(99),  // tok_literal
+			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
-			shift(100), // true
-			shift(101), // false
-			shift(102), // tok_num
+			nil,        // tok_tag
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(61), // ], reduce: SchemaScopes
+			reduce(61), // ,, reduce: SchemaScopes
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S362
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // ;, reduce: ValueExpr
+			nil,        // package
+			reduce(48), // tok_identifier, reduce: ValueExpr
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			nil,        // enum
+			nil,        // {
+			reduce(48), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(48), // tok_tag, reduce: ValueExpr
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
 			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(48), // call, reduce: ValueExpr
+			reduce(48), // notify, reduce: ValueExpr
+			nil,        // (
+			nil,        // )
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S76
+	actionRow{ // S363
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(17), // ␚, reduce: Message
+			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(17), // tok_identifier, reduce: Message
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
-			reduce(17), // enum, reduce: Message
+			nil,        // =
+			nil,        // enum
 			nil,        // {
 			nil,        // }
-			reduce(17), // message, reduce: Message
-			nil,        // =
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(384), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(17), // service, reduce: Message
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(17), // project, reduce: Message
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S77
+	actionRow{ // S364
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2794,32 +16402,86 @@ var actionTab = actionTable{
 			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
-			shift(103), // {
+			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			nil,        // tok_num
+			shift(385), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S78
+	actionRow{ // S365
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(124), // ;, reduce: MethodReply
+			nil,         // package
+			reduce(124), // tok_identifier, reduce: MethodReply
+			nil,         // import
+			nil,         // tok_literal
+			reduce(124), // =, reduce: MethodReply
+			nil,         // enum
+			reduce(124), // {, reduce: MethodReply
+			reduce(124), // }, reduce: MethodReply
+			nil,         // message
+			reduce(124), // tok_tag, reduce: MethodReply
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(124), // call, reduce: MethodReply
+			reduce(124), // notify, reduce: MethodReply
+			nil,         // (
+			nil,         // )
+			reduce(124), // throws, reduce: MethodReply
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S366
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2827,35 +16489,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			nil,        // tok_identifier
+			shift(202), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			shift(104), // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			shift(144), // [
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			shift(152), // map
+			nil,        // <
+			nil,        // >
+			shift(153), // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
-			nil,        // )
-			nil,        // project
+			shift(386), // )
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S79
+	actionRow{ // S367
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(117), // ;, reduce: MethodThrows
+			nil,         // package
+			reduce(117), // tok_identifier, reduce: MethodThrows
+			nil,         // import
+			nil,         // tok_literal
+			reduce(117), // =, reduce: MethodThrows
+			nil,         // enum
+			reduce(117), // {, reduce: MethodThrows
+			reduce(117), // }, reduce: MethodThrows
+			nil,         // message
+			reduce(117), // tok_tag, reduce: MethodThrows
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(117), // call, reduce: MethodThrows
+			reduce(117), // notify, reduce: MethodThrows
+			nil,         // (
+			nil,         // )
+			shift(389),  // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S368
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2863,107 +16579,134 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(105), // tok_identifier
+			shift(390), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S80
+	actionRow{ // S369
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(47), // ;, reduce: ValueExpr
 			nil,        // package
-			shift(106), // tok_identifier
+			reduce(47), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(47), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S81
+	actionRow{ // S370
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(47), // ;, reduce: ValueExpr
 			nil,        // package
-			shift(107), // tok_identifier
+			reduce(47), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			nil,        // }
-			nil,        // message
 			nil,        // =
+			reduce(47), // enum, reduce: ValueExpr
+			nil,        // {
+			reduce(47), // }, reduce: ValueExpr
+			reduce(47), // message, reduce: ValueExpr
+			reduce(47), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(47), // [, reduce: ValueExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(47), // oneof, reduce: ValueExpr
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(47), // include, reduce: ValueExpr
+			reduce(47), // reserved, reduce: ValueExpr
+			reduce(47), // optional, reduce: ValueExpr
+			reduce(47), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(47), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S82
+	actionRow{ // S371
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2971,71 +16714,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(34), // tok_identifier, reduce: FieldType
+			reduce(20), // tok_identifier, reduce: Enum
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			nil,        // }
-			nil,        // message
 			nil,        // =
+			reduce(20), // enum, reduce: Enum
+			nil,        // {
+			reduce(20), // }, reduce: Enum
+			reduce(20), // message, reduce: Enum
+			reduce(20), // tok_tag, reduce: Enum
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(20), // [, reduce: Enum
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(20), // oneof, reduce: Enum
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(20), // include, reduce: Enum
+			reduce(20), // reserved, reduce: Enum
+			reduce(20), // optional, reduce: Enum
+			reduce(20), // map, reduce: Enum
+			nil,        // <
+			nil,        // >
+			reduce(20), // repeated, reduce: Enum
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S83
+	actionRow{ // S372
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			shift(109), // ;
+			shift(265), // ;
 			nil,        // package
 			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
+			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
 			nil,        // {
 			reduce(2),  // }, reduce: OptEnd
-			nil,        // message
-			nil,        // =
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(2),  // call, reduce: OptEnd
+			nil,        // ,
 			nil,        // :
-			reduce(2),  // notify, reduce: OptEnd
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S84
+	actionRow{ // S373
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3043,107 +16804,179 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			nil,        // tok_identifier
+			shift(392), // tok_identifier
 			nil,        // import
-			shift(110), // tok_literal
+			shift(393), // tok_literal
+			nil,        // =
 			nil,        // enum
-			nil,        // {
+			shift(394), // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
-			shift(111), // true
-			shift(112), // false
-			shift(113), // tok_num
+			nil,        // tok_tag
+			shift(396), // true
+			shift(397), // false
+			shift(398), // tok_num
+			shift(399), // tok_float
+			shift(400), // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S85
+	actionRow{ // S374
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			shift(402), // ;
 			nil,        // package
-			shift(114), // tok_identifier
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(2),  // }, reduce: OptEnd
 			nil,        // message
-			nil,        // =
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
+			nil,        // ]
 			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
 			nil,        // >
-			nil,        // [
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
+			nil,        // (
+			nil,        // )
+			nil,        // throws
+			nil,        // tok_doc
+		},
+	},
+	actionRow{ // S375
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // ;
+			nil,        // package
+			reduce(93), // tok_identifier, reduce: Oneof
+			nil,        // import
+			nil,        // tok_literal
+			nil,        // =
+			reduce(93), // enum, reduce: Oneof
+			nil,        // {
+			reduce(93), // }, reduce: Oneof
+			reduce(93), // message, reduce: Oneof
+			reduce(93), // tok_tag, reduce: Oneof
+			nil,        // true
+			nil,        // false
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(93), // [, reduce: Oneof
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(93), // oneof, reduce: Oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(93), // include, reduce: Oneof
+			reduce(93), // reserved, reduce: Oneof
+			reduce(93), // optional, reduce: Oneof
+			reduce(93), // map, reduce: Oneof
+			nil,        // <
+			nil,        // >
+			reduce(93), // repeated, reduce: Oneof
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S86
+	actionRow{ // S376
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(37), // ␚, reduce: Service
+			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(37), // tok_identifier, reduce: Service
+			reduce(27), // tok_identifier, reduce: Tags
 			nil,        // import
 			nil,        // tok_literal
-			reduce(37), // enum, reduce: Service
+			nil,        // =
+			nil,        // enum
 			nil,        // {
 			nil,        // }
-			reduce(37), // message, reduce: Service
-			nil,        // =
+			nil,        // message
+			reduce(27), // tok_tag, reduce: Tags
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
+			nil,        // tok_float
+			reduce(27), // [, reduce: Tags
+			nil,        // ]
 			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(27), // optional, reduce: Tags
+			reduce(27), // map, reduce: Tags
+			nil,        // <
 			nil,        // >
-			nil,        // [
-			nil,        // ]
-			nil,        // repeated
-			reduce(37), // service, reduce: Service
+			reduce(27), // repeated, reduce: Tags
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(37), // project, reduce: Service
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S87
+	actionRow{ // S377
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3151,35 +16984,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(42), // tok_identifier, reduce: MethodFlag
+			reduce(31), // tok_identifier, reduce: TaggedField
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(42), // }, reduce: MethodFlag
+			reduce(31), // }, reduce: TaggedField
 			nil,        // message
-			nil,        // =
+			reduce(31), // tok_tag, reduce: TaggedField
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(31), // [, reduce: TaggedField
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(42), // call, reduce: MethodFlag
+			nil,        // ,
 			nil,        // :
-			reduce(42), // notify, reduce: MethodFlag
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(31), // optional, reduce: TaggedField
+			reduce(31), // map, reduce: TaggedField
+			nil,        // <
+			nil,        // >
+			reduce(31), // repeated, reduce: TaggedField
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S88
+	actionRow{ // S378
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3187,35 +17029,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(43), // tok_identifier, reduce: MethodFlag
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			shift(403), // =
 			nil,        // enum
 			nil,        // {
-			reduce(43), // }, reduce: MethodFlag
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(43), // call, reduce: MethodFlag
-			nil,        // :
-			reduce(43), // notify, reduce: MethodFlag
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S89
+	actionRow{ // S379
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3223,71 +17074,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(51), // tok_identifier, reduce: ProjArea
+			shift(404), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(51), // }, reduce: ProjArea
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S90
+	actionRow{ // S380
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(47), // ␚, reduce: Project
+			nil,        // ␚
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(47), // tok_identifier, reduce: Project
+			shift(405), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
-			reduce(47), // enum, reduce: Project
-			nil,        // {
-			nil,        // }
-			reduce(47), // message, reduce: Project
 			nil,        // =
+			nil,        // enum
+			nil,        // {
+			shift(407), // }
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			reduce(47), // service, reduce: Project
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			reduce(47), // project, reduce: Project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S91
+	actionRow{ // S381
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3295,215 +17164,449 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(20), // tok_identifier, reduce: OptionExpr
+			reduce(97), // tok_identifier, reduce: FieldExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(20), // }, reduce: OptionExpr
-			nil,        // message
 			nil,        // =
+			reduce(97), // enum, reduce: FieldExpr
+			nil,        // {
+			reduce(97), // }, reduce: FieldExpr
+			reduce(97), // message, reduce: FieldExpr
+			reduce(97), // tok_tag, reduce: FieldExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(97), // [, reduce: FieldExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(97), // oneof, reduce: FieldExpr
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(97), // include, reduce: FieldExpr
+			reduce(97), // reserved, reduce: FieldExpr
+			reduce(97), // optional, reduce: FieldExpr
+			reduce(97), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(97), // repeated, reduce: FieldExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S92
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			reduce(3), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(3), // }, reduce: OptEnd
-			nil,       // message
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
-			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S93
+	actionRow{ // S382
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(24), // ;, reduce: OptionValue
+			shift(265), // ;
 			nil,        // package
-			reduce(24), // tok_identifier, reduce: OptionValue
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(24), // }, reduce: OptionValue
-			nil,        // message
 			nil,        // =
+			reduce(2),  // enum, reduce: OptEnd
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
+			reduce(2),  // message, reduce: OptEnd
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(2),  // oneof, reduce: OptEnd
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(2),  // include, reduce: OptEnd
+			reduce(2),  // reserved, reduce: OptEnd
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S94
+	actionRow{ // S383
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(101), // tok_identifier, reduce: FieldType
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S384
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(21), // ;, reduce: OptionValue
+			reduce(47), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(21), // tok_identifier, reduce: OptionValue
+			reduce(47), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(21), // }, reduce: OptionValue
+			reduce(47), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(47), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			nil,        // call
-			nil,        // :
-			nil,        // notify
+			reduce(47), // call, reduce: ValueExpr
+			reduce(47), // notify, reduce: ValueExpr
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S95
+	actionRow{ // S385
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(128), // ,, reduce: MethodParams
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(128), // ), reduce: MethodParams
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S386
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(125), // ;, reduce: MethodReply
+			nil,         // package
+			reduce(125), // tok_identifier, reduce: MethodReply
+			nil,         // import
+			nil,         // tok_literal
+			reduce(125), // =, reduce: MethodReply
+			nil,         // enum
+			reduce(125), // {, reduce: MethodReply
+			reduce(125), // }, reduce: MethodReply
+			nil,         // message
+			reduce(125), // tok_tag, reduce: MethodReply
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(125), // call, reduce: MethodReply
+			reduce(125), // notify, reduce: MethodReply
+			nil,         // (
+			nil,         // )
+			reduce(125), // throws, reduce: MethodReply
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S387
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(22), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(22), // tok_identifier, reduce: OptionValue
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(22), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			shift(335), // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
-			nil,        // )
-			nil,        // project
+			shift(409), // )
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S96
+	actionRow{ // S388
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(131), // ;, reduce: MethodNo
+			nil,         // package
+			reduce(131), // tok_identifier, reduce: MethodNo
+			nil,         // import
+			nil,         // tok_literal
+			shift(410),  // =
+			nil,         // enum
+			reduce(131), // {, reduce: MethodNo
+			reduce(131), // }, reduce: MethodNo
+			nil,         // message
+			reduce(131), // tok_tag, reduce: MethodNo
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(131), // call, reduce: MethodNo
+			reduce(131), // notify, reduce: MethodNo
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S389
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(23), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(23), // tok_identifier, reduce: OptionValue
+			shift(412), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(23), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S97
+	actionRow{ // S390
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			shift(414),  // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(129), // ,, reduce: MethodParams
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(129), // ), reduce: MethodParams
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S391
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3511,359 +17614,404 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(20), // tok_identifier, reduce: OptionExpr
+			reduce(25), // tok_identifier, reduce: Message
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(20), // }, reduce: OptionExpr
-			reduce(20), // message, reduce: OptionExpr
 			nil,        // =
+			reduce(25), // enum, reduce: Message
+			nil,        // {
+			reduce(25), // }, reduce: Message
+			reduce(25), // message, reduce: Message
+			reduce(25), // tok_tag, reduce: Message
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(20), // map, reduce: OptionExpr
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(20), // [, reduce: OptionExpr
+			nil,        // tok_float
+			reduce(25), // [, reduce: Message
 			nil,        // ]
-			reduce(20), // repeated, reduce: OptionExpr
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(25), // oneof, reduce: Message
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(25), // include, reduce: Message
+			reduce(25), // reserved, reduce: Message
+			reduce(25), // optional, reduce: Message
+			reduce(25), // map, reduce: Message
+			nil,        // <
+			nil,        // >
+			reduce(25), // repeated, reduce: Message
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S98
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // ;
-			nil,       // package
-			reduce(3), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(3), // }, reduce: OptEnd
-			reduce(3), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			reduce(3), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(3), // [, reduce: OptEnd
-			nil,       // ]
-			reduce(3), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S99
+	actionRow{ // S392
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(24), // ;, reduce: OptionValue
+			reduce(45), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(24), // tok_identifier, reduce: OptionValue
+			reduce(45), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(24), // }, reduce: OptionValue
-			reduce(24), // message, reduce: OptionValue
-			nil,        // =
+			reduce(45), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(45), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(24), // map, reduce: OptionValue
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(24), // [, reduce: OptionValue
+			nil,        // tok_float
+			reduce(45), // [, reduce: ValueExpr
 			nil,        // ]
-			reduce(24), // repeated, reduce: OptionValue
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(45), // optional, reduce: ValueExpr
+			reduce(45), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(45), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S100
+	actionRow{ // S393
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(21), // ;, reduce: OptionValue
+			reduce(44), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(21), // tok_identifier, reduce: OptionValue
+			reduce(44), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(21), // }, reduce: OptionValue
-			reduce(21), // message, reduce: OptionValue
-			nil,        // =
+			reduce(44), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(44), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(21), // map, reduce: OptionValue
+			nil,        // tok_float
+			reduce(44), // [, reduce: ValueExpr
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(44), // optional, reduce: ValueExpr
+			reduce(44), // map, reduce: ValueExpr
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			reduce(21), // [, reduce: OptionValue
-			nil,        // ]
-			reduce(21), // repeated, reduce: OptionValue
-			nil,        // service
+			reduce(44), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S101
+	actionRow{ // S394
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(22), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(22), // tok_identifier, reduce: OptionValue
+			reduce(53), // tok_identifier, reduce: ValueFields
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(22), // }, reduce: OptionValue
-			reduce(22), // message, reduce: OptionValue
-			nil,        // =
+			reduce(53), // }, reduce: ValueFields
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(22), // map, reduce: OptionValue
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(22), // [, reduce: OptionValue
+			nil,        // tok_float
+			nil,        // [
 			nil,        // ]
-			reduce(22), // repeated, reduce: OptionValue
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S102
+	actionRow{ // S395
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(23), // ;, reduce: OptionValue
+			reduce(39), // ;, reduce: OptionValue
 			nil,        // package
-			reduce(23), // tok_identifier, reduce: OptionValue
+			reduce(39), // tok_identifier, reduce: OptionValue
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(23), // }, reduce: OptionValue
-			reduce(23), // message, reduce: OptionValue
-			nil,        // =
+			reduce(39), // }, reduce: OptionValue
+			nil,        // message
+			reduce(39), // tok_tag, reduce: OptionValue
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(23), // map, reduce: OptionValue
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(23), // [, reduce: OptionValue
+			nil,        // tok_float
+			reduce(39), // [, reduce: OptionValue
 			nil,        // ]
-			reduce(23), // repeated, reduce: OptionValue
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(39), // optional, reduce: OptionValue
+			reduce(39), // map, reduce: OptionValue
+			nil,        // <
+			nil,        // >
+			reduce(39), // repeated, reduce: OptionValue
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S103
+	actionRow{ // S396
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(40), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(25), // tok_identifier, reduce: Fields
+			reduce(40), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(25), // }, reduce: Fields
-			reduce(25), // message, reduce: Fields
-			nil,        // =
+			reduce(40), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(40), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(25), // map, reduce: Fields
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(25), // [, reduce: Fields
+			nil,        // tok_float
+			reduce(40), // [, reduce: ValueExpr
 			nil,        // ]
-			reduce(25), // repeated, reduce: Fields
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(40), // optional, reduce: ValueExpr
+			reduce(40), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(40), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S104
+	actionRow{ // S397
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(41), // ;, reduce: ValueExpr
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(41), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(41), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(41), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
-			shift(116), // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_num
+			nil,        // tok_float
+			reduce(41), // [, reduce: ValueExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(41), // optional, reduce: ValueExpr
+			reduce(41), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(41), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S105
+	actionRow{ // S398
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(42), // ;, reduce: ValueExpr
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(42), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(42), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(42), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			shift(117), // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(42), // [, reduce: ValueExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(42), // optional, reduce: ValueExpr
+			reduce(42), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(42), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S106
+	actionRow{ // S399
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(43), // ;, reduce: ValueExpr
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(43), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(43), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(43), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
+			nil,        // tok_float
+			reduce(43), // [, reduce: ValueExpr
+			nil,        // ]
 			nil,        // ,
-			nil,        // >
-			nil,        // [
-			shift(118), // ]
-			nil,        // repeated
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(43), // optional, reduce: ValueExpr
+			reduce(43), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(43), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S107
+	actionRow{ // S400
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3871,35 +18019,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(35), // tok_identifier, reduce: FieldType
+			shift(70),  // tok_identifier
 			nil,        // import
-			nil,        // tok_literal
+			shift(71),  // tok_literal
+			nil,        // =
 			nil,        // enum
-			nil,        // {
+			shift(72),  // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
-			nil,        // true
-			nil,        // false
-			nil,        // tok_num
+			nil,        // tok_tag
+			shift(74),  // true
+			shift(75),  // false
+			shift(76),  // tok_num
+			shift(77),  // tok_float
+			shift(78),  // [
+			shift(416), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S108
+	actionRow{ // S401
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3907,35 +18064,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(20), // tok_identifier, reduce: OptionExpr
+			reduce(38), // tok_identifier, reduce: OptionExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(20), // }, reduce: OptionExpr
+			reduce(38), // }, reduce: OptionExpr
 			nil,        // message
-			nil,        // =
+			reduce(38), // tok_tag, reduce: OptionExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(38), // [, reduce: OptionExpr
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(20), // call, reduce: OptionExpr
+			nil,        // ,
 			nil,        // :
-			reduce(20), // notify, reduce: OptionExpr
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(38), // optional, reduce: OptionExpr
+			reduce(38), // map, reduce: OptionExpr
+			nil,        // <
+			nil,        // >
+			reduce(38), // repeated, reduce: OptionExpr
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S109
+	actionRow{ // S402
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -3946,176 +18112,266 @@ var actionTab = actionTable{
 			reduce(3), // tok_identifier, reduce: OptEnd
 			nil,       // import
 			nil,       // tok_literal
+			nil,       // =
 			nil,       // enum
 			nil,       // {
 			reduce(3), // }, reduce: OptEnd
 			nil,       // message
-			nil,       // =
+			reduce(3), // tok_tag, reduce: OptEnd
 			nil,       // true
 			nil,       // false
 			nil,       // tok_num
-			nil,       // map
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			nil,       // [
+			nil,       // tok_float
+			reduce(3), // [, reduce: OptEnd
 			nil,       // ]
-			nil,       // repeated
-			nil,       // service
-			reduce(3), // call, reduce: OptEnd
+			nil,       // ,
 			nil,       // :
-			reduce(3), // notify, reduce: OptEnd
+			nil,       // option_schema
+			nil,       // oneof
+			nil,       // service
+			nil,       // project
+			nil,       // const
+			nil,       // include
+			nil,       // reserved
+			reduce(3), // optional, reduce: OptEnd
+			reduce(3), // map, reduce: OptEnd
+			nil,       // <
+			nil,       // >
+			reduce(3), // repeated, reduce: OptEnd
+			nil,       // call
+			nil,       // notify
 			nil,       // (
 			nil,       // )
-			nil,       // project
+			nil,       // throws
 			nil,       // tok_doc
 		},
 	},
-	actionRow{ // S110
+	actionRow{ // S403
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(24), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(24), // tok_identifier, reduce: OptionValue
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(24), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			nil,        // tok_num
+			shift(418), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(24), // call, reduce: OptionValue
-			nil,        // :
-			reduce(24), // notify, reduce: OptionValue
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S111
+	actionRow{ // S404
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(21), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(21), // tok_identifier, reduce: OptionValue
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			shift(419), // =
 			nil,        // enum
 			nil,        // {
-			reduce(21), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(21), // call, reduce: OptionValue
-			nil,        // :
-			reduce(21), // notify, reduce: OptionValue
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S112
+	actionRow{ // S405
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(22), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(22), // tok_identifier, reduce: OptionValue
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			shift(166), // =
 			nil,        // enum
 			nil,        // {
-			reduce(22), // }, reduce: OptionValue
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(22), // call, reduce: OptionValue
-			nil,        // :
-			reduce(22), // notify, reduce: OptionValue
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S113
+	actionRow{ // S406
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(23), // ;, reduce: OptionValue
+			nil,        // ;
 			nil,        // package
-			reduce(23), // tok_identifier, reduce: OptionValue
+			reduce(37), // tok_identifier, reduce: Options
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(23), // }, reduce: OptionValue
+			reduce(37), // }, reduce: Options
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(23), // call, reduce: OptionValue
-			nil,        // :
-			reduce(23), // notify, reduce: OptionValue
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S114
+	actionRow{ // S407
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(100), // ;, reduce: FieldOption
+			nil,         // package
+			reduce(100), // tok_identifier, reduce: FieldOption
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			reduce(100), // enum, reduce: FieldOption
+			nil,         // {
+			reduce(100), // }, reduce: FieldOption
+			reduce(100), // message, reduce: FieldOption
+			reduce(100), // tok_tag, reduce: FieldOption
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			reduce(100), // [, reduce: FieldOption
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			reduce(100), // oneof, reduce: FieldOption
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			reduce(100), // include, reduce: FieldOption
+			reduce(100), // reserved, reduce: FieldOption
+			reduce(100), // optional, reduce: FieldOption
+			reduce(100), // map, reduce: FieldOption
+			nil,         // <
+			nil,         // >
+			reduce(100), // repeated, reduce: FieldOption
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S408
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4123,35 +18379,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(98), // tok_identifier, reduce: FieldExpr
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			nil,        // }
-			nil,        // message
 			nil,        // =
+			reduce(98), // enum, reduce: FieldExpr
+			nil,        // {
+			reduce(98), // }, reduce: FieldExpr
+			reduce(98), // message, reduce: FieldExpr
+			reduce(98), // tok_tag, reduce: FieldExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(98), // [, reduce: FieldExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			reduce(98), // oneof, reduce: FieldExpr
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			reduce(98), // include, reduce: FieldExpr
+			reduce(98), // reserved, reduce: FieldExpr
+			reduce(98), // optional, reduce: FieldExpr
+			reduce(98), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(98), // repeated, reduce: FieldExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
-			shift(119), // )
-			nil,        // project
+			nil,        // )
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S115
+	actionRow{ // S409
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(126), // ;, reduce: MethodReply
+			nil,         // package
+			reduce(126), // tok_identifier, reduce: MethodReply
+			nil,         // import
+			nil,         // tok_literal
+			reduce(126), // =, reduce: MethodReply
+			nil,         // enum
+			reduce(126), // {, reduce: MethodReply
+			reduce(126), // }, reduce: MethodReply
+			nil,         // message
+			reduce(126), // tok_tag, reduce: MethodReply
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(126), // call, reduce: MethodReply
+			reduce(126), // notify, reduce: MethodReply
+			nil,         // (
+			nil,         // )
+			reduce(126), // throws, reduce: MethodReply
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S410
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4159,71 +18469,179 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(50),  // tok_identifier
+			shift(420), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			shift(120), // }
-			shift(54),  // message
-			nil,        // =
+			nil,        // }
+			nil,        // message
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			nil,        // tok_num
-			shift(57),  // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			shift(58),  // [
+			shift(421), // tok_num
+			nil,        // tok_float
+			nil,        // [
 			nil,        // ]
-			shift(59),  // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S116
+	actionRow{ // S411
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(30), // ;, reduce: FieldOption
+			reduce(99), // ;, reduce: FieldOption
 			nil,        // package
-			reduce(30), // tok_identifier, reduce: FieldOption
+			reduce(99), // tok_identifier, reduce: FieldOption
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			shift(121), // {
-			reduce(30), // }, reduce: FieldOption
-			reduce(30), // message, reduce: FieldOption
 			nil,        // =
+			nil,        // enum
+			shift(422), // {
+			reduce(99), // }, reduce: FieldOption
+			nil,        // message
+			reduce(99), // tok_tag, reduce: FieldOption
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(30), // map, reduce: FieldOption
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(30), // [, reduce: FieldOption
+			nil,        // tok_float
+			nil,        // [
 			nil,        // ]
-			reduce(30), // repeated, reduce: FieldOption
-			nil,        // service
-			nil,        // call
+			nil,        // ,
 			nil,        // :
-			nil,        // notify
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(99), // call, reduce: FieldOption
+			reduce(99), // notify, reduce: FieldOption
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S117
+	actionRow{ // S412
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(119), // ;, reduce: ThrowsList
+			nil,         // package
+			reduce(119), // tok_identifier, reduce: ThrowsList
+			nil,         // import
+			nil,         // tok_literal
+			reduce(119), // =, reduce: ThrowsList
+			nil,         // enum
+			reduce(119), // {, reduce: ThrowsList
+			reduce(119), // }, reduce: ThrowsList
+			nil,         // message
+			reduce(119), // tok_tag, reduce: ThrowsList
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(119), // ,, reduce: ThrowsList
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(119), // call, reduce: ThrowsList
+			reduce(119), // notify, reduce: ThrowsList
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S413
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(118), // ;, reduce: MethodThrows
+			nil,         // package
+			reduce(118), // tok_identifier, reduce: MethodThrows
+			nil,         // import
+			nil,         // tok_literal
+			reduce(118), // =, reduce: MethodThrows
+			nil,         // enum
+			reduce(118), // {, reduce: MethodThrows
+			reduce(118), // }, reduce: MethodThrows
+			nil,         // message
+			reduce(118), // tok_tag, reduce: MethodThrows
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			shift(424),  // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(118), // call, reduce: MethodThrows
+			reduce(118), // notify, reduce: MethodThrows
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S414
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4231,35 +18649,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(123), // tok_identifier
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			nil,        // tok_num
+			shift(425), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S118
+	actionRow{ // S415
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4267,107 +18694,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(124), // tok_identifier
+			shift(92),  // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			shift(426), // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S119
+	actionRow{ // S416
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(46), // ;, reduce: ValueExpr
 			nil,        // package
-			shift(125), // tok_identifier
+			reduce(46), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(46), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(46), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(46), // [, reduce: ValueExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(46), // optional, reduce: ValueExpr
+			reduce(46), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(46), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S120
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(98), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(2), // }, reduce: OptEnd
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			reduce(2), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(2), // [, reduce: OptEnd
-			nil,       // ]
-			reduce(2), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S121
+	actionRow{ // S417
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4375,107 +18784,89 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(18), // tok_identifier, reduce: Options
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(18), // }, reduce: Options
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			reduce(51), // ], reduce: OptComma
+			shift(98),  // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S122
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(98), // ;
-			nil,       // package
-			reduce(2), // tok_identifier, reduce: OptEnd
-			nil,       // import
-			nil,       // tok_literal
-			nil,       // enum
-			nil,       // {
-			reduce(2), // }, reduce: OptEnd
-			reduce(2), // message, reduce: OptEnd
-			nil,       // =
-			nil,       // true
-			nil,       // false
-			nil,       // tok_num
-			reduce(2), // map, reduce: OptEnd
-			nil,       // <
-			nil,       // ,
-			nil,       // >
-			reduce(2), // [, reduce: OptEnd
-			nil,       // ]
-			reduce(2), // repeated, reduce: OptEnd
-			nil,       // service
-			nil,       // call
-			nil,       // :
-			nil,       // notify
-			nil,       // (
-			nil,       // )
-			nil,       // project
-			nil,       // tok_doc
-		},
-	},
-	actionRow{ // S123
+	actionRow{ // S418
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(99), // ;, reduce: FieldOption
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(99), // tok_identifier, reduce: FieldOption
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
-			nil,        // {
-			nil,        // }
+			shift(428), // {
+			reduce(99), // }, reduce: FieldOption
 			nil,        // message
-			nil,        // =
+			reduce(99), // tok_tag, reduce: FieldOption
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			shift(129), // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(99), // [, reduce: FieldOption
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(99), // optional, reduce: FieldOption
+			reduce(99), // map, reduce: FieldOption
+			nil,        // <
+			nil,        // >
+			reduce(99), // repeated, reduce: FieldOption
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S124
+	actionRow{ // S419
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4483,107 +18874,224 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(33), // tok_identifier, reduce: FieldType
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			nil,        // tok_num
+			shift(430), // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S125
+	actionRow{ // S420
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(133), // ;, reduce: MethodNo
+			nil,         // package
+			reduce(133), // tok_identifier, reduce: MethodNo
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(133), // {, reduce: MethodNo
+			reduce(133), // }, reduce: MethodNo
+			nil,         // message
+			reduce(133), // tok_tag, reduce: MethodNo
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(133), // call, reduce: MethodNo
+			reduce(133), // notify, reduce: MethodNo
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S421
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(132), // ;, reduce: MethodNo
+			nil,         // package
+			reduce(132), // tok_identifier, reduce: MethodNo
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			reduce(132), // {, reduce: MethodNo
+			reduce(132), // }, reduce: MethodNo
+			nil,         // message
+			reduce(132), // tok_tag, reduce: MethodNo
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(132), // call, reduce: MethodNo
+			reduce(132), // notify, reduce: MethodNo
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S422
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(45), // ;, reduce: MethodNo
+			nil,        // ;
 			nil,        // package
-			reduce(45), // tok_identifier, reduce: MethodNo
+			reduce(36), // tok_identifier, reduce: Options
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
-			reduce(45), // {, reduce: MethodNo
-			reduce(45), // }, reduce: MethodNo
+			nil,        // {
+			reduce(36), // }, reduce: Options
 			nil,        // message
-			shift(130), // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(45), // call, reduce: MethodNo
-			nil,        // :
-			reduce(45), // notify, reduce: MethodNo
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S126
+	actionRow{ // S423
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			shift(300), // ;
 			nil,        // package
-			reduce(17), // tok_identifier, reduce: Message
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(17), // }, reduce: Message
-			reduce(17), // message, reduce: Message
-			nil,        // =
+			reduce(2),  // }, reduce: OptEnd
+			nil,        // message
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(17), // map, reduce: Message
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(17), // [, reduce: Message
+			nil,        // tok_float
+			nil,        // [
 			nil,        // ]
-			reduce(17), // repeated, reduce: Message
-			nil,        // service
-			nil,        // call
+			nil,        // ,
 			nil,        // :
-			nil,        // notify
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
+			nil,        // map
+			nil,        // <
+			nil,        // >
+			nil,        // repeated
+			reduce(2),  // call, reduce: OptEnd
+			reduce(2),  // notify, reduce: OptEnd
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S127
+	actionRow{ // S424
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4591,71 +19099,134 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(47),  // tok_identifier
+			shift(433), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			shift(132), // }
+			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S128
+	actionRow{ // S425
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			nil,         // tok_identifier
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			nil,         // }
+			nil,         // message
+			nil,         // tok_tag
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(130), // ,, reduce: MethodParams
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			reduce(130), // ), reduce: MethodParams
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S426
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(48), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(29), // tok_identifier, reduce: FieldExpr
+			reduce(48), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(29), // }, reduce: FieldExpr
-			reduce(29), // message, reduce: FieldExpr
-			nil,        // =
+			reduce(48), // }, reduce: ValueExpr
+			nil,        // message
+			reduce(48), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(29), // map, reduce: FieldExpr
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(29), // [, reduce: FieldExpr
+			nil,        // tok_float
+			reduce(48), // [, reduce: ValueExpr
 			nil,        // ]
-			reduce(29), // repeated, reduce: FieldExpr
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(48), // optional, reduce: ValueExpr
+			reduce(48), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(48), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S129
+	actionRow{ // S427
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4663,35 +19234,44 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			reduce(32), // tok_identifier, reduce: FieldType
+			nil,        // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
 			nil,        // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			shift(434), // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S130
+	actionRow{ // S428
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4699,215 +19279,359 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			nil,        // tok_identifier
+			reduce(36), // tok_identifier, reduce: Options
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			nil,        // }
+			reduce(36), // }, reduce: Options
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
-			shift(133), // tok_num
+			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S131
+	actionRow{ // S429
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(30), // ;, reduce: FieldOption
+			shift(402), // ;
 			nil,        // package
-			reduce(30), // tok_identifier, reduce: FieldOption
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
-			shift(134), // {
-			reduce(30), // }, reduce: FieldOption
+			nil,        // {
+			reduce(2),  // }, reduce: OptEnd
 			nil,        // message
-			nil,        // =
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(30), // call, reduce: FieldOption
+			nil,        // ,
 			nil,        // :
-			reduce(30), // notify, reduce: FieldOption
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S132
+	actionRow{ // S430
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(31), // ;, reduce: FieldOption
+			reduce(99), // ;, reduce: FieldOption
 			nil,        // package
-			reduce(31), // tok_identifier, reduce: FieldOption
+			reduce(99), // tok_identifier, reduce: FieldOption
 			nil,        // import
 			nil,        // tok_literal
-			nil,        // enum
-			nil,        // {
-			reduce(31), // }, reduce: FieldOption
-			reduce(31), // message, reduce: FieldOption
 			nil,        // =
+			nil,        // enum
+			shift(428), // {
+			reduce(99), // }, reduce: FieldOption
+			nil,        // message
+			reduce(99), // tok_tag, reduce: FieldOption
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			reduce(31), // map, reduce: FieldOption
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			reduce(31), // [, reduce: FieldOption
+			nil,        // tok_float
+			reduce(99), // [, reduce: FieldOption
 			nil,        // ]
-			reduce(31), // repeated, reduce: FieldOption
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(99), // optional, reduce: FieldOption
+			reduce(99), // map, reduce: FieldOption
+			nil,        // <
+			nil,        // >
+			reduce(99), // repeated, reduce: FieldOption
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S133
+	actionRow{ // S431
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(46), // ;, reduce: MethodNo
+			nil,        // ;
 			nil,        // package
-			reduce(46), // tok_identifier, reduce: MethodNo
+			shift(405), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
-			reduce(46), // {, reduce: MethodNo
-			reduce(46), // }, reduce: MethodNo
+			nil,        // {
+			shift(438), // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(46), // call, reduce: MethodNo
-			nil,        // :
-			reduce(46), // notify, reduce: MethodNo
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S134
+	actionRow{ // S432
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			nil,         // ;
+			nil,         // package
+			reduce(116), // tok_identifier, reduce: ServiceMethod
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(116), // }, reduce: ServiceMethod
+			nil,         // message
+			reduce(116), // tok_tag, reduce: ServiceMethod
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(116), // call, reduce: ServiceMethod
+			reduce(116), // notify, reduce: ServiceMethod
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S433
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(120), // ;, reduce: ThrowsList
+			nil,         // package
+			reduce(120), // tok_identifier, reduce: ThrowsList
+			nil,         // import
+			nil,         // tok_literal
+			reduce(120), // =, reduce: ThrowsList
+			nil,         // enum
+			reduce(120), // {, reduce: ThrowsList
+			reduce(120), // }, reduce: ThrowsList
+			nil,         // message
+			reduce(120), // tok_tag, reduce: ThrowsList
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			reduce(120), // ,, reduce: ThrowsList
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(120), // call, reduce: ThrowsList
+			reduce(120), // notify, reduce: ThrowsList
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S434
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			reduce(47), // ;, reduce: ValueExpr
 			nil,        // package
-			reduce(18), // tok_identifier, reduce: Options
+			reduce(47), // tok_identifier, reduce: ValueExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(18), // }, reduce: Options
+			reduce(47), // }, reduce: ValueExpr
 			nil,        // message
-			nil,        // =
+			reduce(47), // tok_tag, reduce: ValueExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(47), // [, reduce: ValueExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(47), // optional, reduce: ValueExpr
+			reduce(47), // map, reduce: ValueExpr
+			nil,        // <
+			nil,        // >
+			reduce(47), // repeated, reduce: ValueExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S135
+	actionRow{ // S435
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			shift(109), // ;
+			nil,        // ;
 			nil,        // package
-			reduce(2),  // tok_identifier, reduce: OptEnd
+			shift(405), // tok_identifier
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(2),  // }, reduce: OptEnd
+			shift(439), // }
 			nil,        // message
-			nil,        // =
+			nil,        // tok_tag
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
+			nil,        // tok_float
+			nil,        // [
+			nil,        // ]
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			nil,        // optional
 			nil,        // map
 			nil,        // <
-			nil,        // ,
 			nil,        // >
-			nil,        // [
-			nil,        // ]
 			nil,        // repeated
-			nil,        // service
-			reduce(2),  // call, reduce: OptEnd
-			nil,        // :
-			reduce(2),  // notify, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S136
+	actionRow{ // S436
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -4915,103 +19639,220 @@ var actionTab = actionTable{
 			nil,        // empty
 			nil,        // ;
 			nil,        // package
-			shift(47),  // tok_identifier
+			reduce(97), // tok_identifier, reduce: FieldExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			shift(138), // }
+			reduce(97), // }, reduce: FieldExpr
 			nil,        // message
-			nil,        // =
+			reduce(97), // tok_tag, reduce: FieldExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(97), // [, reduce: FieldExpr
 			nil,        // ]
-			nil,        // repeated
+			nil,        // ,
+			nil,        // :
+			nil,        // option_schema
+			nil,        // oneof
 			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(97), // optional, reduce: FieldExpr
+			reduce(97), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(97), // repeated, reduce: FieldExpr
 			nil,        // call
-			nil,        // :
 			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S137
+	actionRow{ // S437
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // ;
+			shift(402), // ;
 			nil,        // package
-			reduce(44), // tok_identifier, reduce: ServiceMethod
+			reduce(2),  // tok_identifier, reduce: OptEnd
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(44), // }, reduce: ServiceMethod
+			reduce(2),  // }, reduce: OptEnd
 			nil,        // message
-			nil,        // =
+			reduce(2),  // tok_tag, reduce: OptEnd
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(2),  // [, reduce: OptEnd
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(44), // call, reduce: ServiceMethod
+			nil,        // ,
 			nil,        // :
-			reduce(44), // notify, reduce: ServiceMethod
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(2),  // optional, reduce: OptEnd
+			reduce(2),  // map, reduce: OptEnd
+			nil,        // <
+			nil,        // >
+			reduce(2),  // repeated, reduce: OptEnd
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
-	actionRow{ // S138
+	actionRow{ // S438
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(100), // ;, reduce: FieldOption
+			nil,         // package
+			reduce(100), // tok_identifier, reduce: FieldOption
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(100), // }, reduce: FieldOption
+			nil,         // message
+			reduce(100), // tok_tag, reduce: FieldOption
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			nil,         // [
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			nil,         // optional
+			nil,         // map
+			nil,         // <
+			nil,         // >
+			nil,         // repeated
+			reduce(100), // call, reduce: FieldOption
+			reduce(100), // notify, reduce: FieldOption
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S439
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,         // INVALID
+			nil,         // ␚
+			nil,         // empty
+			reduce(100), // ;, reduce: FieldOption
+			nil,         // package
+			reduce(100), // tok_identifier, reduce: FieldOption
+			nil,         // import
+			nil,         // tok_literal
+			nil,         // =
+			nil,         // enum
+			nil,         // {
+			reduce(100), // }, reduce: FieldOption
+			nil,         // message
+			reduce(100), // tok_tag, reduce: FieldOption
+			nil,         // true
+			nil,         // false
+			nil,         // tok_num
+			nil,         // tok_float
+			reduce(100), // [, reduce: FieldOption
+			nil,         // ]
+			nil,         // ,
+			nil,         // :
+			nil,         // option_schema
+			nil,         // oneof
+			nil,         // service
+			nil,         // project
+			nil,         // const
+			nil,         // include
+			nil,         // reserved
+			reduce(100), // optional, reduce: FieldOption
+			reduce(100), // map, reduce: FieldOption
+			nil,         // <
+			nil,         // >
+			reduce(100), // repeated, reduce: FieldOption
+			nil,         // call
+			nil,         // notify
+			nil,         // (
+			nil,         // )
+			nil,         // throws
+			nil,         // tok_doc
+		},
+	},
+	actionRow{ // S440
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(31), // ;, reduce: FieldOption
+			nil,        // ;
 			nil,        // package
-			reduce(31), // tok_identifier, reduce: FieldOption
+			reduce(98), // tok_identifier, reduce: FieldExpr
 			nil,        // import
 			nil,        // tok_literal
+			nil,        // =
 			nil,        // enum
 			nil,        // {
-			reduce(31), // }, reduce: FieldOption
+			reduce(98), // }, reduce: FieldExpr
 			nil,        // message
-			nil,        // =
+			reduce(98), // tok_tag, reduce: FieldExpr
 			nil,        // true
 			nil,        // false
 			nil,        // tok_num
-			nil,        // map
-			nil,        // <
-			nil,        // ,
-			nil,        // >
-			nil,        // [
+			nil,        // tok_float
+			reduce(98), // [, reduce: FieldExpr
 			nil,        // ]
-			nil,        // repeated
-			nil,        // service
-			reduce(31), // call, reduce: FieldOption
+			nil,        // ,
 			nil,        // :
-			reduce(31), // notify, reduce: FieldOption
+			nil,        // option_schema
+			nil,        // oneof
+			nil,        // service
+			nil,        // project
+			nil,        // const
+			nil,        // include
+			nil,        // reserved
+			reduce(98), // optional, reduce: FieldExpr
+			reduce(98), // map, reduce: FieldExpr
+			nil,        // <
+			nil,        // >
+			reduce(98), // repeated, reduce: FieldExpr
+			nil,        // call
+			nil,        // notify
 			nil,        // (
 			nil,        // )
-			nil,        // project
+			nil,        // throws
 			nil,        // tok_doc
 		},
 	},
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 25
+const numNTSymbols = 56
 
 type (
 	gotoTable [numStates]gotoRow
@@ -19,12 +19,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -32,6 +58,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -46,12 +77,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -59,6 +116,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -73,12 +135,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -86,6 +174,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -100,12 +193,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -113,6 +232,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -127,12 +251,38 @@ var gotoTab = gotoTable{
 		7,  // Import
 		6,  // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -140,6 +290,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -154,12 +309,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -167,6 +348,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -181,19 +367,50 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		12, // Define
+		20, // TypeAlias
 		13, // Enum
-		14, // Message
+		-1, // EnumElements
+		23, // Message
+		22, // Tags
+		14, // TaggedMessage
+		-1, // TaggedField
+		16, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		15, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		19, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		18, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
-		16, // Service
+		26, // Service
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		17, // Project
 		-1, // ProjElements
@@ -208,12 +425,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -221,6 +464,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -235,12 +483,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -248,6 +522,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -262,12 +541,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -275,6 +580,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -289,12 +599,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -302,6 +638,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -316,12 +657,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
-		24, // OptionValue
+		35, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -329,6 +696,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -343,12 +715,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -356,6 +754,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -370,12 +773,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -383,6 +812,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -397,12 +831,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -410,6 +870,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -424,12 +889,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -437,6 +928,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -451,12 +947,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -464,6 +986,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -478,12 +1005,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -491,6 +1044,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -505,12 +1063,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -518,6 +1102,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -532,12 +1121,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -545,6 +1160,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -559,12 +1179,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -572,6 +1218,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -586,12 +1237,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -599,6 +1276,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -613,19 +1295,50 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
-		-1, // Message
+		-1, // EnumElements
+		37, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
-		-1, // Service
+		39, // Service
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -634,18 +1347,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S23
 		-1, // S'
 		-1, // ProtocolDefine
-		31, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -653,6 +1392,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -661,18 +1405,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S24
 		-1, // S'
 		-1, // ProtocolDefine
-		32, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -680,6 +1450,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -694,12 +1469,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -707,6 +1508,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -721,12 +1527,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -734,6 +1566,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -748,12 +1585,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -761,6 +1624,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -775,12 +1643,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -788,6 +1682,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -802,12 +1701,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -815,6 +1740,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -823,18 +1753,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S30
 		-1, // S'
 		-1, // ProtocolDefine
-		42, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -842,6 +1798,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -856,12 +1817,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -869,6 +1856,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -877,18 +1869,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S32
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		46, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -896,6 +1914,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -910,12 +1933,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -923,6 +1972,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -937,12 +1991,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		51, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -950,6 +2030,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -958,18 +2043,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S35
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		57, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -977,6 +2088,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -991,12 +2107,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1004,6 +2146,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1018,12 +2165,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1031,6 +2204,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1045,12 +2223,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
-		43, // Options
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1058,6 +2262,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1072,12 +2281,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
-		44, // Fields
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1085,6 +2320,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1099,20 +2339,51 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
 		-1, // Service
-		45, // ServiceElements
+		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
-		-1, // MethodNo
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		61, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
@@ -1126,12 +2397,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1139,9 +2436,14 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
-		46, // ProjElements
+		-1, // ProjElements
 		-1, // ProjArea
 	},
 	gotoRow{ // S42
@@ -1153,12 +2455,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1166,6 +2494,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		63, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1180,12 +2513,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		48, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1193,6 +2552,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1207,19 +2571,50 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
-		51, // Message
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		52, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
-		55, // FieldExpr
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
 		-1, // FieldOption
-		56, // FieldType
+		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1228,25 +2623,56 @@ var gotoTab = gotoTable{
 	gotoRow{ // S45
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		67, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		61, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
-		64, // MethodFlag
-		63, // ServiceMethod
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1261,12 +2687,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		68, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1274,10 +2726,15 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
-		70, // ProjArea
+		-1, // ProjArea
 	},
 	gotoRow{ // S47
 		-1, // S'
@@ -1288,12 +2745,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
-		71, // OptionValue
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1301,6 +2784,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1315,12 +2803,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1328,6 +2842,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1336,18 +2855,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S49
 		-1, // S'
 		-1, // ProtocolDefine
-		73, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1355,6 +2900,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1369,12 +2919,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
-		74, // OptionValue
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		69, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1382,6 +2958,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1396,12 +2977,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1409,6 +3016,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1423,12 +3035,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1436,6 +3074,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1444,18 +3087,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S53
 		-1, // S'
 		-1, // ProtocolDefine
-		76, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1463,6 +3132,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1477,12 +3151,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1490,6 +3190,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1504,12 +3209,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1517,6 +3248,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1531,12 +3267,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		73, // ValueExpr
+		80, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1544,6 +3306,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1558,12 +3325,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1571,6 +3364,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1585,12 +3383,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1598,6 +3422,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1612,12 +3441,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		81, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1625,6 +3480,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1639,12 +3499,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
-		83, // OptionValue
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1652,6 +3538,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1666,12 +3557,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1679,6 +3596,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1687,18 +3609,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S62
 		-1, // S'
 		-1, // ProtocolDefine
-		86, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		85, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1706,6 +3654,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1720,12 +3673,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1733,6 +3712,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1747,12 +3731,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1760,6 +3770,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		88, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1774,12 +3789,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1787,9 +3828,14 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
-		-1, // ProjElements
+		89, // ProjElements
 		-1, // ProjArea
 	},
 	gotoRow{ // S66
@@ -1801,12 +3847,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1814,6 +3886,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1828,12 +3905,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
-		71, // OptionValue
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1841,6 +3944,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1849,18 +3957,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S68
 		-1, // S'
 		-1, // ProtocolDefine
-		-1, // OptEnd
+		91, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1868,6 +4002,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1876,18 +4015,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S69
 		-1, // S'
 		-1, // ProtocolDefine
-		90, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1895,6 +4060,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1909,12 +4079,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1922,6 +4118,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1930,18 +4131,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S71
 		-1, // S'
 		-1, // ProtocolDefine
-		91, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1949,6 +4176,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1963,12 +4195,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		94, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -1976,6 +4234,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -1990,12 +4253,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2003,6 +4292,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2011,18 +4305,44 @@ var gotoTab = gotoTable{
 	gotoRow{ // S74
 		-1, // S'
 		-1, // ProtocolDefine
-		97, // OptEnd
+		-1, // OptEnd
 		-1, // Package
 		-1, // Imports
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2030,6 +4350,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2044,12 +4369,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2057,6 +4408,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2071,12 +4427,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2084,6 +4466,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2098,12 +4485,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2111,6 +4524,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2125,12 +4543,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		73, // ValueExpr
+		96, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2138,6 +4582,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2152,12 +4601,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2165,6 +4640,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -2179,12 +4659,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		97, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2192,12 +4698,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
 	gotoRow{ // S81
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		102, // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		100, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		103, // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S82
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2206,12 +4775,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2219,12 +4814,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S82
+	gotoRow{ // S83
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2233,12 +4833,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2246,26 +4872,57 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S83
+	gotoRow{ // S84
 		-1,  // S'
 		-1,  // ProtocolDefine
-		108, // OptEnd
+		-1,  // OptEnd
 		-1,  // Package
 		-1,  // Imports
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
-		-1,  // Fields
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		106, // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
 		-1,  // FieldOption
 		-1,  // FieldType
@@ -2273,12 +4930,17 @@ var gotoTab = gotoTable{
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S84
+	gotoRow{ // S85
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2287,12 +4949,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2300,12 +4988,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S85
+	gotoRow{ // S86
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		109, // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S87
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2314,12 +5065,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2327,12 +5104,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S86
+	gotoRow{ // S88
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2341,12 +5123,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2354,12 +5162,133 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S87
+	gotoRow{ // S89
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		112, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		114, // ProjArea
+	},
+	gotoRow{ // S90
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		115, // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S91
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2368,12 +5297,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2381,12 +5336,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S88
+	gotoRow{ // S92
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2395,12 +5355,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2408,12 +5394,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S89
+	gotoRow{ // S93
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2422,12 +5413,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2435,12 +5452,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S90
+	gotoRow{ // S94
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2449,12 +5471,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2462,12 +5510,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S91
+	gotoRow{ // S95
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2476,12 +5529,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2489,12 +5568,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S92
+	gotoRow{ // S96
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		118, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S97
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2503,12 +5645,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2516,12 +5684,133 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S93
+	gotoRow{ // S98
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		120, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		122, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S100
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2530,12 +5819,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2543,26 +5858,16413 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S94
-		-1, // S'
-		-1, // ProtocolDefine
-		-1, // OptEnd
-		-1, // Package
-		-1, // Imports
-		-1, // Import
-		-1, // Defines
-		-1, // Define
+	gotoRow{ // S101
+		-1,  // S'
+		-1,  // ProtocolDefine
+		123, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S102
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		124, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S105
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		128, // ReservedRanges
+		130, // ReservedRange
+		129, // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S106
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		132, // Enum
+		-1,  // EnumElements
+		139, // Message
+		137, // Tags
+		133, // TaggedMessage
+		142, // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		134, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		147, // Include
+		138, // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		146, // Oneof
+		-1,  // OneofFields
+		143, // FieldExpr
+		-1,  // FieldOption
+		150, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S108
+		-1,  // S'
+		-1,  // ProtocolDefine
+		155, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		159, // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		160, // TaggedMethod
+		-1,  // Options
+		157, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		162, // MethodFlag
+		161, // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S110
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		167, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S113
+		-1,  // S'
+		-1,  // ProtocolDefine
+		169, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S115
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S116
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		175, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S118
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S121
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		185, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S122
+		-1,  // S'
+		-1,  // ProtocolDefine
+		191, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S123
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S124
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S127
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S131
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		199, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S136
+		-1,  // S'
+		-1,  // ProtocolDefine
+		201, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S137
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		203, // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		205, // FieldExpr
+		-1,  // FieldOption
+		150, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S149
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		212, // ReservedRanges
+		130, // ReservedRange
+		213, // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S150
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		215, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S154
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		220, // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		222, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S158
+		-1,  // S'
+		-1,  // ProtocolDefine
+		225, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S159
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		227, // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S166
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		233, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S167
+		-1,  // S'
+		-1,  // ProtocolDefine
+		239, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S171
+		-1,  // S'
+		-1,  // ProtocolDefine
+		242, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S172
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S174
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		243, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S175
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		244, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S178
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S180
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		247, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S184
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		248, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S190
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		250, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S191
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S195
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		253, // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S198
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		258, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S199
+		-1,  // S'
+		-1,  // ProtocolDefine
+		264, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S200
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S201
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S202
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S204
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S205
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S206
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		267, // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S207
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S210
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S211
+		-1,  // S'
+		-1,  // ProtocolDefine
+		272, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S219
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		283, // SchemaScopes
+		284, // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S220
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		288, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		289, // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S221
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		293, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S222
+		-1,  // S'
+		-1,  // ProtocolDefine
+		299, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S224
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		302, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		303, // MethodRequest
+		-1,  // MethodReply
+		304, // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S232
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		305, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S233
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S238
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		307, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S239
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S240
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S247
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		311, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S250
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		313, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S255
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S257
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		314, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S262
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S263
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		316, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S265
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		317, // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S270
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S271
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		321, // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S272
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S274
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S276
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S277
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S278
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S279
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S281
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S285
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S289
+		-1,  // S'
+		-1,  // ProtocolDefine
+		329, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S292
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		330, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S294
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S298
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		332, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S304
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S307
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		337, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S308
+		-1,  // S'
+		-1,  // ProtocolDefine
+		338, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S309
+		-1,  // S'
+		-1,  // ProtocolDefine
+		339, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S310
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S316
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		343, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S317
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		102, // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		100, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		103, // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S318
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		345, // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S321
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		349, // Tags
+		-1,  // TaggedMessage
+		351, // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		347, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		352, // FieldExpr
+		-1,  // FieldOption
+		353, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S322
+		-1,  // S'
+		-1,  // ProtocolDefine
+		355, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S323
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		357, // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S328
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		361, // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S330
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S331
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S332
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		363, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S334
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		367, // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S335
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		368, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S339
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S340
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S342
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S344
+		-1,  // S'
+		-1,  // ProtocolDefine
+		371, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S345
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		132, // Enum
+		-1,  // EnumElements
+		139, // Message
+		137, // Tags
+		133, // TaggedMessage
+		142, // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		134, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		147, // Include
+		138, // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		146, // Oneof
+		-1,  // OneofFields
+		143, // FieldExpr
+		-1,  // FieldOption
+		150, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S346
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		374, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S347
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S348
+		-1,  // S'
+		-1,  // ProtocolDefine
+		375, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S349
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		377, // FieldExpr
+		-1,  // FieldOption
+		353, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S350
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S354
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		379, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S356
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		380, // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S357
+		-1,  // S'
+		-1,  // ProtocolDefine
+		381, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S358
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		382, // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S363
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S366
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		302, // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		387, // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S367
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		388, // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S369
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S372
+		-1,  // S'
+		-1,  // ProtocolDefine
+		391, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S373
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		395, // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S374
+		-1,  // S'
+		-1,  // ProtocolDefine
+		401, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S377
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S378
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S379
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S380
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		406, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S381
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S382
+		-1,  // S'
+		-1,  // ProtocolDefine
+		408, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S383
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2570,12 +22272,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S95
+	gotoRow{ // S384
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2584,12 +22291,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2597,12 +22330,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S96
+	gotoRow{ // S385
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2611,12 +22349,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2624,12 +22388,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S97
+	gotoRow{ // S386
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2638,12 +22407,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2651,12 +22446,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S98
+	gotoRow{ // S387
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2665,12 +22465,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2678,12 +22504,133 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S99
+	gotoRow{ // S388
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		411, // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S389
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		413, // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S390
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2692,12 +22639,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2705,12 +22678,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S100
+	gotoRow{ // S391
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2719,12 +22697,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2732,12 +22736,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S101
+	gotoRow{ // S392
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2746,12 +22755,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2759,12 +22794,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S102
+	gotoRow{ // S393
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2773,12 +22813,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2786,12 +22852,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S103
+	gotoRow{ // S394
 		-1,  // S'
 		-1,  // ProtocolDefine
 		-1,  // OptEnd
@@ -2800,12 +22871,38 @@ var gotoTab = gotoTable{
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
-		115, // Fields
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		415, // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
 		-1,  // FieldOption
 		-1,  // FieldType
@@ -2813,12 +22910,17 @@ var gotoTab = gotoTable{
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S104
+	gotoRow{ // S395
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2827,12 +22929,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2840,12 +22968,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S105
+	gotoRow{ // S396
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2854,12 +22987,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2867,12 +23026,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S106
+	gotoRow{ // S397
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2881,12 +23045,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2894,12 +23084,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S107
+	gotoRow{ // S398
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2908,12 +23103,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2921,12 +23142,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S108
+	gotoRow{ // S399
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2935,12 +23161,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2948,12 +23200,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S109
+	gotoRow{ // S400
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		73,  // ValueExpr
+		417, // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S401
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2962,12 +23277,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -2975,12 +23316,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S110
+	gotoRow{ // S402
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -2989,12 +23335,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3002,12 +23374,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S111
+	gotoRow{ // S403
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3016,12 +23393,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3029,12 +23432,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S112
+	gotoRow{ // S404
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3043,12 +23451,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3056,12 +23490,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S113
+	gotoRow{ // S405
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		167, // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S406
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3070,12 +23567,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3083,12 +23606,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S114
+	gotoRow{ // S407
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3097,12 +23625,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3110,12 +23664,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S115
+	gotoRow{ // S408
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3124,25 +23683,172 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
-		51, // Message
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		52, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
-		55, // FieldExpr
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
 		-1, // FieldOption
-		56, // FieldType
+		-1, // FieldType
 		-1, // Service
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S116
+	gotoRow{ // S409
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S410
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S411
 		-1,  // S'
 		-1,  // ProtocolDefine
 		-1,  // OptEnd
@@ -3151,25 +23857,172 @@ var gotoTab = gotoTable{
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
-		122, // FieldOption
+		423, // FieldOption
 		-1,  // FieldType
 		-1,  // Service
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S117
+	gotoRow{ // S412
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S414
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3178,12 +24031,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3191,12 +24070,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S118
+	gotoRow{ // S415
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3205,12 +24089,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3218,12 +24128,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S119
+	gotoRow{ // S416
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3232,12 +24147,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3245,26 +24186,57 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S120
+	gotoRow{ // S417
 		-1,  // S'
 		-1,  // ProtocolDefine
-		126, // OptEnd
+		-1,  // OptEnd
 		-1,  // Package
 		-1,  // Imports
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		427, // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
 		-1,  // FieldOption
 		-1,  // FieldType
@@ -3272,12 +24244,17 @@ var gotoTab = gotoTable{
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S121
+	gotoRow{ // S418
 		-1,  // S'
 		-1,  // ProtocolDefine
 		-1,  // OptEnd
@@ -3286,52 +24263,56 @@ var gotoTab = gotoTable{
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
-		127, // Options
-		-1,  // OptionExpr
-		-1,  // OptionValue
-		-1,  // Fields
-		-1,  // FieldExpr
-		-1,  // FieldOption
-		-1,  // FieldType
-		-1,  // Service
-		-1,  // ServiceElements
-		-1,  // MethodFlag
-		-1,  // ServiceMethod
-		-1,  // MethodNo
-		-1,  // Project
-		-1,  // ProjElements
-		-1,  // ProjArea
-	},
-	gotoRow{ // S122
-		-1,  // S'
-		-1,  // ProtocolDefine
-		128, // OptEnd
-		-1,  // Package
-		-1,  // Imports
-		-1,  // Import
-		-1,  // Defines
-		-1,  // Define
-		-1,  // Enum
-		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
-		-1,  // FieldOption
+		429, // FieldOption
 		-1,  // FieldType
 		-1,  // Service
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S123
+	gotoRow{ // S419
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3340,12 +24321,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3353,12 +24360,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S124
+	gotoRow{ // S420
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3367,12 +24379,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3380,39 +24418,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S125
-		-1,  // S'
-		-1,  // ProtocolDefine
-		-1,  // OptEnd
-		-1,  // Package
-		-1,  // Imports
-		-1,  // Import
-		-1,  // Defines
-		-1,  // Define
-		-1,  // Enum
-		-1,  // Message
-		-1,  // Options
-		-1,  // OptionExpr
-		-1,  // OptionValue
-		-1,  // Fields
-		-1,  // FieldExpr
-		-1,  // FieldOption
-		-1,  // FieldType
-		-1,  // Service
-		-1,  // ServiceElements
-		-1,  // MethodFlag
-		-1,  // ServiceMethod
-		131, // MethodNo
-		-1,  // Project
-		-1,  // ProjElements
-		-1,  // ProjArea
-	},
-	gotoRow{ // S126
+	gotoRow{ // S421
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3421,12 +24437,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3434,12 +24476,133 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S127
+	gotoRow{ // S422
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		431, // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S423
+		-1,  // S'
+		-1,  // ProtocolDefine
+		432, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S424
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3448,12 +24611,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		48, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3461,12 +24650,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S128
+	gotoRow{ // S425
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3475,12 +24669,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3488,12 +24708,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S129
+	gotoRow{ // S426
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3502,12 +24727,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3515,12 +24766,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S130
+	gotoRow{ // S427
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3529,12 +24785,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3542,12 +24824,133 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S131
+	gotoRow{ // S428
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		435, // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S429
+		-1,  // S'
+		-1,  // ProtocolDefine
+		436, // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		-1,  // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S430
 		-1,  // S'
 		-1,  // ProtocolDefine
 		-1,  // OptEnd
@@ -3556,25 +24959,114 @@ var gotoTab = gotoTable{
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
-		135, // FieldOption
+		437, // FieldOption
 		-1,  // FieldType
 		-1,  // Service
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S132
+	gotoRow{ // S431
+		-1,  // S'
+		-1,  // ProtocolDefine
+		-1,  // OptEnd
+		-1,  // Package
+		-1,  // Imports
+		-1,  // Import
+		-1,  // Defines
+		-1,  // Define
+		-1,  // TypeAlias
+		-1,  // Enum
+		-1,  // EnumElements
+		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		406, // OptionExpr
+		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
+		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
+		-1,  // FieldExpr
+		-1,  // FieldOption
+		-1,  // FieldType
+		-1,  // Service
+		-1,  // ServiceElements
+		-1,  // MethodFlag
+		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
+		-1,  // MethodNo
+		-1,  // Project
+		-1,  // ProjElements
+		-1,  // ProjArea
+	},
+	gotoRow{ // S432
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3583,12 +25075,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3596,12 +25114,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S133
+	gotoRow{ // S433
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3610,12 +25133,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3623,12 +25172,75 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S134
+	gotoRow{ // S434
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S435
 		-1,  // S'
 		-1,  // ProtocolDefine
 		-1,  // OptEnd
@@ -3637,12 +25249,38 @@ var gotoTab = gotoTable{
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
-		136, // Options
-		-1,  // OptionExpr
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
+		-1,  // Options
+		406, // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
 		-1,  // FieldOption
 		-1,  // FieldType
@@ -3650,26 +25288,115 @@ var gotoTab = gotoTable{
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S135
+	gotoRow{ // S436
+		-1, // S'
+		-1, // ProtocolDefine
+		-1, // OptEnd
+		-1, // Package
+		-1, // Imports
+		-1, // Import
+		-1, // Defines
+		-1, // Define
+		-1, // TypeAlias
+		-1, // Enum
+		-1, // EnumElements
+		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
+		-1, // Options
+		-1, // OptionExpr
+		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
+		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
+		-1, // FieldExpr
+		-1, // FieldOption
+		-1, // FieldType
+		-1, // Service
+		-1, // ServiceElements
+		-1, // MethodFlag
+		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
+		-1, // MethodNo
+		-1, // Project
+		-1, // ProjElements
+		-1, // ProjArea
+	},
+	gotoRow{ // S437
 		-1,  // S'
 		-1,  // ProtocolDefine
-		137, // OptEnd
+		440, // OptEnd
 		-1,  // Package
 		-1,  // Imports
 		-1,  // Import
 		-1,  // Defines
 		-1,  // Define
+		-1,  // TypeAlias
 		-1,  // Enum
+		-1,  // EnumElements
 		-1,  // Message
+		-1,  // Tags
+		-1,  // TaggedMessage
+		-1,  // TaggedField
+		-1,  // TaggedService
+		-1,  // TaggedMethod
 		-1,  // Options
 		-1,  // OptionExpr
 		-1,  // OptionValue
+		-1,  // ValueExpr
+		-1,  // ValueList
+		-1,  // OptComma
+		-1,  // ValueFields
+		-1,  // OptionSchema
+		-1,  // SchemaItems
+		-1,  // SchemaScope
+		-1,  // SchemaScopes
+		-1,  // ScopeName
+		-1,  // SchemaDefault
+		-1,  // Const
+		-1,  // ConstValues
 		-1,  // Fields
+		-1,  // Include
+		-1,  // Reserved
+		-1,  // ReservedRanges
+		-1,  // ReservedRange
+		-1,  // ReservedNames
+		-1,  // Oneof
+		-1,  // OneofFields
 		-1,  // FieldExpr
 		-1,  // FieldOption
 		-1,  // FieldType
@@ -3677,12 +25404,17 @@ var gotoTab = gotoTable{
 		-1,  // ServiceElements
 		-1,  // MethodFlag
 		-1,  // ServiceMethod
+		-1,  // MethodThrows
+		-1,  // ThrowsList
+		-1,  // MethodRequest
+		-1,  // MethodReply
+		-1,  // MethodParams
 		-1,  // MethodNo
 		-1,  // Project
 		-1,  // ProjElements
 		-1,  // ProjArea
 	},
-	gotoRow{ // S136
+	gotoRow{ // S438
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3691,12 +25423,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
-		48, // OptionExpr
+		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3704,12 +25462,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S137
+	gotoRow{ // S439
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3718,12 +25481,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3731,12 +25520,17 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
 		-1, // ProjArea
 	},
-	gotoRow{ // S138
+	gotoRow{ // S440
 		-1, // S'
 		-1, // ProtocolDefine
 		-1, // OptEnd
@@ -3745,12 +25539,38 @@ var gotoTab = gotoTable{
 		-1, // Import
 		-1, // Defines
 		-1, // Define
+		-1, // TypeAlias
 		-1, // Enum
+		-1, // EnumElements
 		-1, // Message
+		-1, // Tags
+		-1, // TaggedMessage
+		-1, // TaggedField
+		-1, // TaggedService
+		-1, // TaggedMethod
 		-1, // Options
 		-1, // OptionExpr
 		-1, // OptionValue
+		-1, // ValueExpr
+		-1, // ValueList
+		-1, // OptComma
+		-1, // ValueFields
+		-1, // OptionSchema
+		-1, // SchemaItems
+		-1, // SchemaScope
+		-1, // SchemaScopes
+		-1, // ScopeName
+		-1, // SchemaDefault
+		-1, // Const
+		-1, // ConstValues
 		-1, // Fields
+		-1, // Include
+		-1, // Reserved
+		-1, // ReservedRanges
+		-1, // ReservedRange
+		-1, // ReservedNames
+		-1, // Oneof
+		-1, // OneofFields
 		-1, // FieldExpr
 		-1, // FieldOption
 		-1, // FieldType
@@ -3758,6 +25578,11 @@ var gotoTab = gotoTable{
 		-1, // ServiceElements
 		-1, // MethodFlag
 		-1, // ServiceMethod
+		-1, // MethodThrows
+		-1, // ThrowsList
+		-1, // MethodRequest
+		-1, // MethodReply
+		-1, // MethodParams
 		-1, // MethodNo
 		-1, // Project
 		-1, // ProjElements
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 52
-	numStates      = 139
-	numSymbols     = 56
+	numProductions = 139
+	numStates      = 441
+	numSymbols     = 96
 )
 
 // Stack
@@ -143,7 +143,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Define : Message	<<  >>`,
+		String: `Define : TaggedMessage	<<  >>`,
 		Id:         "Define",
 		NTType:     7,
 		Index:      12,
@@ -163,7 +163,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Define : Service	<<  >>`,
+		String: `Define : TaggedService	<<  >>`,
 		Id:         "Define",
 		NTType:     7,
 		Index:      14,
@@ -183,150 +183,840 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Enum : "enum" tok_identifier "{" Options "}" OptEnd	<< bridge.NewEnum(C, X[1], X[3]) >>`,
-		Id:         "Enum",
-		NTType:     8,
+		String: `Define : Const	<<  >>`,
+		Id:         "Define",
+		NTType:     7,
 		Index:      16,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Define : OptionSchema	<<  >>`,
+		Id:         "Define",
+		NTType:     7,
+		Index:      17,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Define : TypeAlias	<<  >>`,
+		Id:         "Define",
+		NTType:     7,
+		Index:      18,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TypeAlias : tok_identifier tok_identifier "=" tok_identifier OptEnd	<< bridge.NewTypeAlias(C, X[0], X[1], X[3]) >>`,
+		Id:         "TypeAlias",
+		NTType:     8,
+		Index:      19,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewTypeAlias(C, X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Enum : "enum" tok_identifier "{" EnumElements "}" OptEnd	<< bridge.NewEnum(C, X[1], X[3], X[4]) >>`,
+		Id:         "Enum",
+		NTType:     9,
+		Index:      20,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewEnum(C, X[1], X[3])
+			return bridge.NewEnum(C, X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumElements : empty	<< &ast.YTEnumDef{}, nil >>`,
+		Id:         "EnumElements",
+		NTType:     10,
+		Index:      21,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTEnumDef{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `EnumElements : EnumElements OptionExpr	<< bridge.EnumElement(C, X[0], X[1]) >>`,
+		Id:         "EnumElements",
+		NTType:     10,
+		Index:      22,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.EnumElement(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumElements : EnumElements Tags OptionExpr	<< bridge.TaggedEnumElement(C, X[0], X[1], X[2]) >>`,
+		Id:         "EnumElements",
+		NTType:     10,
+		Index:      23,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.TaggedEnumElement(C, X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumElements : EnumElements Reserved	<< bridge.EnumReserved(C, X[0], X[1]) >>`,
+		Id:         "EnumElements",
+		NTType:     10,
+		Index:      24,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.EnumReserved(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Message : "message" tok_identifier MethodNo "{" Fields "}" OptEnd	<< bridge.NewMessage(C, X[1], X[2], X[4], X[5]) >>`,
+		Id:         "Message",
+		NTType:     11,
+		Index:      25,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewMessage(C, X[1], X[2], X[4], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `Tags : tok_tag	<< bridge.AppendTag(nil, X[0]) >>`,
+		Id:         "Tags",
+		NTType:     12,
+		Index:      26,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendTag(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Tags : Tags tok_tag	<< bridge.AppendTag(X[0], X[1]) >>`,
+		Id:         "Tags",
+		NTType:     12,
+		Index:      27,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendTag(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedMessage : Message	<<  >>`,
+		Id:         "TaggedMessage",
+		NTType:     13,
+		Index:      28,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedMessage : Tags Message	<< bridge.SetTags(C, X[0], X[1]) >>`,
+		Id:         "TaggedMessage",
+		NTType:     13,
+		Index:      29,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.SetTags(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedField : FieldExpr	<<  >>`,
+		Id:         "TaggedField",
+		NTType:     14,
+		Index:      30,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedField : Tags FieldExpr	<< bridge.SetTags(C, X[0], X[1]) >>`,
+		Id:         "TaggedField",
+		NTType:     14,
+		Index:      31,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.SetTags(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedService : Service	<<  >>`,
+		Id:         "TaggedService",
+		NTType:     15,
+		Index:      32,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedService : Tags Service	<< bridge.SetTags(C, X[0], X[1]) >>`,
+		Id:         "TaggedService",
+		NTType:     15,
+		Index:      33,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.SetTags(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedMethod : ServiceMethod	<<  >>`,
+		Id:         "TaggedMethod",
+		NTType:     16,
+		Index:      34,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TaggedMethod : Tags ServiceMethod	<< bridge.SetTags(C, X[0], X[1]) >>`,
+		Id:         "TaggedMethod",
+		NTType:     16,
+		Index:      35,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.SetTags(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Options : empty	<<  >>`,
+		Id:         "Options",
+		NTType:     17,
+		Index:      36,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Options : Options OptionExpr	<< bridge.AppendOption(C, X[0], X[1]) >>`,
+		Id:         "Options",
+		NTType:     17,
+		Index:      37,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendOption(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `OptionExpr : tok_identifier OptionValue OptEnd	<< bridge.OptionExpr(C, X[0], X[1]) >>`,
+		Id:         "OptionExpr",
+		NTType:     18,
+		Index:      38,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.OptionExpr(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `OptionValue : "=" ValueExpr	<< X[1], nil >>`,
+		Id:         "OptionValue",
+		NTType:     19,
+		Index:      39,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : "true"	<< bridge.BoolValue(true) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      40,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.BoolValue(true)
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : "false"	<< bridge.BoolValue(false) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      41,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.BoolValue(false)
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : tok_num	<< bridge.IntValue(X[0]) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      42,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.IntValue(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : tok_float	<< bridge.FloatValue(X[0]) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      43,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FloatValue(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : tok_literal	<< bridge.StringValue(X[0]) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.StringValue(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : tok_identifier	<< bridge.ConstRefValue(C, X[0]) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      45,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ConstRefValue(C, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : "[" "]"	<< bridge.ListValue(nil, nil) >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      46,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ListValue(nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : "[" ValueList OptComma "]"	<< X[1], nil >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      47,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueExpr : "{" ValueFields "}"	<< X[1], nil >>`,
+		Id:         "ValueExpr",
+		NTType:     20,
+		Index:      48,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : ValueExpr	<< bridge.ListValue(nil, X[0]) >>`,
+		Id:         "ValueList",
+		NTType:     21,
+		Index:      49,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ListValue(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : ValueList "," ValueExpr	<< bridge.ListValue(X[0], X[2]) >>`,
+		Id:         "ValueList",
+		NTType:     21,
+		Index:      50,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ListValue(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `OptComma : empty	<<  >>`,
+		Id:         "OptComma",
+		NTType:     22,
+		Index:      51,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `OptComma : ","	<<  >>`,
+		Id:         "OptComma",
+		NTType:     22,
+		Index:      52,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueFields : empty	<< bridge.ObjectValue(nil, nil, nil) >>`,
+		Id:         "ValueFields",
+		NTType:     23,
+		Index:      53,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ObjectValue(nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `ValueFields : ValueFields tok_identifier ":" ValueExpr OptComma	<< bridge.ObjectValue(X[0], X[1], X[3]) >>`,
+		Id:         "ValueFields",
+		NTType:     23,
+		Index:      54,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ObjectValue(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `OptionSchema : "option_schema" tok_identifier "{" SchemaItems "}" OptEnd	<< bridge.NewOptionSchema(C, X[1], X[3]) >>`,
+		Id:         "OptionSchema",
+		NTType:     24,
+		Index:      55,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewOptionSchema(C, X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaItems : empty	<< &ast.YTOptionSchema{}, nil >>`,
+		Id:         "SchemaItems",
+		NTType:     25,
+		Index:      56,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTOptionSchema{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaItems : SchemaItems tok_identifier tok_identifier SchemaScope SchemaDefault OptEnd	<< bridge.OptionSchemaItem(C, X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "SchemaItems",
+		NTType:     25,
+		Index:      57,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.OptionSchemaItem(C, X[0], X[1], X[2], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaScope : empty	<<  >>`,
+		Id:         "SchemaScope",
+		NTType:     26,
+		Index:      58,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaScope : "[" SchemaScopes "]"	<< X[1], nil >>`,
+		Id:         "SchemaScope",
+		NTType:     26,
+		Index:      59,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaScopes : ScopeName	<< bridge.AppendScope(nil, X[0]) >>`,
+		Id:         "SchemaScopes",
+		NTType:     27,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendScope(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaScopes : SchemaScopes "," ScopeName	<< bridge.AppendScope(X[0], X[2]) >>`,
+		Id:         "SchemaScopes",
+		NTType:     27,
+		Index:      61,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendScope(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : tok_identifier	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : "message"	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : "oneof"	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      64,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : "enum"	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      65,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : "service"	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      66,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ScopeName : "project"	<<  >>`,
+		Id:         "ScopeName",
+		NTType:     28,
+		Index:      67,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaDefault : empty	<<  >>`,
+		Id:         "SchemaDefault",
+		NTType:     29,
+		Index:      68,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `SchemaDefault : OptionValue	<<  >>`,
+		Id:         "SchemaDefault",
+		NTType:     29,
+		Index:      69,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Const : "const" tok_identifier tok_identifier "{" ConstValues "}" OptEnd	<< bridge.NewConst(C, X[1], X[2], X[4]) >>`,
+		Id:         "Const",
+		NTType:     30,
+		Index:      70,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewConst(C, X[1], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `ConstValues : empty	<< &ast.YTConst{}, nil >>`,
+		Id:         "ConstValues",
+		NTType:     31,
+		Index:      71,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTConst{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ConstValues : ConstValues tok_identifier "=" tok_num OptEnd	<< bridge.ConstValue(C, X[0], X[1], X[3]) >>`,
+		Id:         "ConstValues",
+		NTType:     31,
+		Index:      72,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ConstValue(C, X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `ConstValues : ConstValues tok_identifier "=" tok_literal OptEnd	<< bridge.ConstValue(C, X[0], X[1], X[3]) >>`,
+		Id:         "ConstValues",
+		NTType:     31,
+		Index:      73,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ConstValue(C, X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : empty	<< &ast.YTMessage{},nil >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      74,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTMessage{},nil
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields TaggedField	<< bridge.FieldField(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      75,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldField(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields OptionExpr	<< bridge.FieldOption(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      76,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldOption(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields TaggedMessage	<< bridge.FieldMessage(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      77,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldMessage(C, X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Fields : Fields Enum	<< bridge.FieldEnum(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      78,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FieldEnum(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Message : "message" tok_identifier "{" Fields "}" OptEnd	<< bridge.NewMessage(C, X[1], X[3]) >>`,
-		Id:         "Message",
-		NTType:     9,
-		Index:      17,
-		NumSymbols: 6,
+		String: `Fields : Fields Oneof	<< bridge.FieldOneof(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      79,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewMessage(C, X[1], X[3])
+			return bridge.FieldOneof(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Options : empty	<<  >>`,
-		Id:         "Options",
-		NTType:     10,
-		Index:      18,
-		NumSymbols: 0,
+		String: `Fields : Fields Reserved	<< bridge.FieldReserved(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      80,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return bridge.FieldReserved(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Options : Options OptionExpr	<< bridge.AppendOption(C, X[0], X[1]) >>`,
-		Id:         "Options",
-		NTType:     10,
-		Index:      19,
+		String: `Fields : Fields Include	<< bridge.FieldInclude(C, X[0], X[1]) >>`,
+		Id:         "Fields",
+		NTType:     32,
+		Index:      81,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.AppendOption(C, X[0], X[1])
+			return bridge.FieldInclude(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `OptionExpr : tok_identifier OptionValue OptEnd	<< bridge.OptionExpr(C, X[0], X[1]) >>`,
-		Id:         "OptionExpr",
-		NTType:     11,
-		Index:      20,
+		String: `Include : "include" tok_identifier OptEnd	<< bridge.NewInclude(C, X[0], X[1], nil) >>`,
+		Id:         "Include",
+		NTType:     33,
+		Index:      82,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.OptionExpr(C, X[0], X[1])
+			return bridge.NewInclude(C, X[0], X[1], nil)
 		},
 	},
 	ProdTabEntry{
-		String: `OptionValue : "=" "true"	<< true, nil >>`,
-		Id:         "OptionValue",
-		NTType:     12,
-		Index:      21,
-		NumSymbols: 2,
+		String: `Include : "include" tok_identifier "=" tok_num OptEnd	<< bridge.NewInclude(C, X[0], X[1], X[3]) >>`,
+		Id:         "Include",
+		NTType:     33,
+		Index:      83,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return true, nil
+			return bridge.NewInclude(C, X[0], X[1], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `OptionValue : "=" "false"	<< false, nil >>`,
-		Id:         "OptionValue",
-		NTType:     12,
-		Index:      22,
-		NumSymbols: 2,
+		String: `Reserved : "reserved" ReservedRanges ";"	<< bridge.NewReserved(C, X[0], X[1]) >>`,
+		Id:         "Reserved",
+		NTType:     34,
+		Index:      84,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return false, nil
+			return bridge.NewReserved(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `OptionValue : "=" tok_num	<< X[1], nil >>`,
-		Id:         "OptionValue",
-		NTType:     12,
-		Index:      23,
-		NumSymbols: 2,
+		String: `Reserved : "reserved" ReservedNames ";"	<< bridge.NewReserved(C, X[0], X[1]) >>`,
+		Id:         "Reserved",
+		NTType:     34,
+		Index:      85,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[1], nil
+			return bridge.NewReserved(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `OptionValue : "=" tok_literal	<< X[1], nil >>`,
-		Id:         "OptionValue",
-		NTType:     12,
-		Index:      24,
-		NumSymbols: 2,
+		String: `ReservedRanges : ReservedRange	<< bridge.AppendReservedRange(C, nil, X[0]) >>`,
+		Id:         "ReservedRanges",
+		NTType:     35,
+		Index:      86,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[1], nil
+			return bridge.AppendReservedRange(C, nil, X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Fields : empty	<< &ast.YTMessage{},nil >>`,
-		Id:         "Fields",
-		NTType:     13,
-		Index:      25,
-		NumSymbols: 0,
+		String: `ReservedRanges : ReservedRanges "," ReservedRange	<< bridge.AppendReservedRange(C, X[0], X[2]) >>`,
+		Id:         "ReservedRanges",
+		NTType:     35,
+		Index:      87,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return &ast.YTMessage{},nil
+			return bridge.AppendReservedRange(C, X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Fields : Fields FieldExpr	<< bridge.FieldField(C, X[0], X[1]) >>`,
-		Id:         "Fields",
-		NTType:     13,
-		Index:      26,
-		NumSymbols: 2,
+		String: `ReservedRange : tok_num	<< bridge.NewReservedRange(C, X[0], nil, nil) >>`,
+		Id:         "ReservedRange",
+		NTType:     36,
+		Index:      88,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.FieldField(C, X[0], X[1])
+			return bridge.NewReservedRange(C, X[0], nil, nil)
 		},
 	},
 	ProdTabEntry{
-		String: `Fields : Fields OptionExpr	<< bridge.FieldOption(C, X[0], X[1]) >>`,
-		Id:         "Fields",
-		NTType:     13,
-		Index:      27,
+		String: `ReservedRange : tok_num tok_identifier tok_num	<< bridge.NewReservedRange(C, X[0], X[1], X[2]) >>`,
+		Id:         "ReservedRange",
+		NTType:     36,
+		Index:      89,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReservedRange(C, X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedRange : tok_num tok_identifier tok_identifier	<< bridge.NewReservedRange(C, X[0], X[1], X[2]) >>`,
+		Id:         "ReservedRange",
+		NTType:     36,
+		Index:      90,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewReservedRange(C, X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedNames : tok_literal	<< bridge.AppendReservedName(C, nil, X[0]) >>`,
+		Id:         "ReservedNames",
+		NTType:     37,
+		Index:      91,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedName(C, nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ReservedNames : ReservedNames "," tok_literal	<< bridge.AppendReservedName(C, X[0], X[2]) >>`,
+		Id:         "ReservedNames",
+		NTType:     37,
+		Index:      92,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendReservedName(C, X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Oneof : "oneof" tok_identifier "{" OneofFields "}" OptEnd	<< bridge.NewOneof(C, X[1], X[3], X[4]) >>`,
+		Id:         "Oneof",
+		NTType:     38,
+		Index:      93,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewOneof(C, X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `OneofFields : empty	<< &ast.YTOneof{}, nil >>`,
+		Id:         "OneofFields",
+		NTType:     39,
+		Index:      94,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return &ast.YTOneof{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `OneofFields : OneofFields TaggedField	<< bridge.OneofField(C, X[0], X[1]) >>`,
+		Id:         "OneofFields",
+		NTType:     39,
+		Index:      95,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.FieldOption(C, X[0], X[1])
+			return bridge.OneofField(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Fields : Fields Message	<< bridge.FieldMessage(C, X[0], X[1]) >>`,
-		Id:         "Fields",
-		NTType:     13,
-		Index:      28,
+		String: `OneofFields : OneofFields OptionExpr	<< bridge.OneofOption(C, X[0], X[1]) >>`,
+		Id:         "OneofFields",
+		NTType:     39,
+		Index:      96,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.FieldMessage(C, X[0], X[1])
+			return bridge.OneofOption(C, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `FieldExpr : FieldType tok_identifier "=" tok_num FieldOption OptEnd	<< bridge.NewField(C,X[0], X[1], X[3], X[4]) >>`,
 		Id:         "FieldExpr",
-		NTType:     14,
-		Index:      29,
+		NTType:     40,
+		Index:      97,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.NewField(C,X[0], X[1], X[3], X[4])
 		},
 	},
+	ProdTabEntry{
+		String: `FieldExpr : "optional" FieldType tok_identifier "=" tok_num FieldOption OptEnd	<< bridge.NewOptionalField(C,X[1], X[2], X[4], X[5]) >>`,
+		Id:         "FieldExpr",
+		NTType:     40,
+		Index:      98,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewOptionalField(C,X[1], X[2], X[4], X[5])
+		},
+	},
 	ProdTabEntry{
 		String: `FieldOption : empty	<<  >>`,
 		Id:         "FieldOption",
-		NTType:     15,
-		Index:      30,
+		NTType:     41,
+		Index:      99,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -335,8 +1025,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldOption : "{" Options "}"	<< X[1], nil >>`,
 		Id:         "FieldOption",
-		NTType:     15,
-		Index:      31,
+		NTType:     41,
+		Index:      100,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -345,8 +1035,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : "map" "<" tok_identifier "," tok_identifier ">"	<< bridge.MapType(C, X[2], X[4]) >>`,
 		Id:         "FieldType",
-		NTType:     16,
-		Index:      32,
+		NTType:     42,
+		Index:      101,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.MapType(C, X[2], X[4])
@@ -355,8 +1045,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : "map" "[" tok_identifier "]" tok_identifier	<< bridge.MapType(C, X[2], X[4]) >>`,
 		Id:         "FieldType",
-		NTType:     16,
-		Index:      33,
+		NTType:     42,
+		Index:      102,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.MapType(C, X[2], X[4])
@@ -365,8 +1055,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : "repeated" tok_identifier	<< bridge.ArrayType(C, X[1]) >>`,
 		Id:         "FieldType",
-		NTType:     16,
-		Index:      34,
+		NTType:     42,
+		Index:      103,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ArrayType(C, X[1])
@@ -375,48 +1065,68 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FieldType : "[" "]" tok_identifier	<< bridge.ArrayType(C, X[2]) >>`,
 		Id:         "FieldType",
-		NTType:     16,
-		Index:      35,
+		NTType:     42,
+		Index:      104,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ArrayType(C, X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `FieldType : "[" tok_num "]" tok_identifier	<< bridge.FixedArrayType(C, X[1], X[3]) >>`,
+		Id:         "FieldType",
+		NTType:     42,
+		Index:      105,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FixedArrayType(C, X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldType : "[" tok_identifier "]" tok_identifier	<< bridge.FixedArrayType(C, X[1], X[3]) >>`,
+		Id:         "FieldType",
+		NTType:     42,
+		Index:      106,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.FixedArrayType(C, X[1], X[3])
+		},
+	},
 	ProdTabEntry{
 		String: `FieldType : tok_identifier	<< bridge.BasicOrCustomType(C, X[0]) >>`,
 		Id:         "FieldType",
-		NTType:     16,
-		Index:      36,
+		NTType:     42,
+		Index:      107,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.BasicOrCustomType(C, X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Service : "service" tok_identifier "{" ServiceElements "}" OptEnd	<< bridge.NewService(C, X[1], X[3]) >>`,
+		String: `Service : "service" tok_identifier MethodThrows "{" ServiceElements "}" OptEnd	<< bridge.NewService(C, X[1], X[4], X[2], X[5]) >>`,
 		Id:         "Service",
-		NTType:     17,
-		Index:      37,
-		NumSymbols: 6,
+		NTType:     43,
+		Index:      108,
+		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewService(C, X[1], X[3])
+			return bridge.NewService(C, X[1], X[4], X[2], X[5])
 		},
 	},
 	ProdTabEntry{
 		String: `ServiceElements : empty	<< &ast.YTService{}, nil >>`,
 		Id:         "ServiceElements",
-		NTType:     18,
-		Index:      38,
+		NTType:     44,
+		Index:      109,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return &ast.YTService{}, nil
 		},
 	},
 	ProdTabEntry{
-		String: `ServiceElements : ServiceElements ServiceMethod	<< bridge.ServiceMethod(C, X[0], X[1]) >>`,
+		String: `ServiceElements : ServiceElements TaggedMethod	<< bridge.ServiceMethod(C, X[0], X[1]) >>`,
 		Id:         "ServiceElements",
-		NTType:     18,
-		Index:      39,
+		NTType:     44,
+		Index:      110,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ServiceMethod(C, X[0], X[1])
@@ -425,8 +1135,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ServiceElements : ServiceElements OptionExpr	<< bridge.ServiceOption(C, X[0], X[1]) >>`,
 		Id:         "ServiceElements",
-		NTType:     18,
-		Index:      40,
+		NTType:     44,
+		Index:      111,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ServiceOption(C, X[0], X[1])
@@ -435,8 +1145,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ServiceElements : ServiceElements MethodFlag	<< bridge.ServiceFlag(C, X[0], X[1]) >>`,
 		Id:         "ServiceElements",
-		NTType:     18,
-		Index:      41,
+		NTType:     44,
+		Index:      112,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ServiceFlag(C, X[0], X[1])
@@ -445,8 +1155,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MethodFlag : "call" ":"	<< X[0], nil >>`,
 		Id:         "MethodFlag",
-		NTType:     19,
-		Index:      42,
+		NTType:     45,
+		Index:      113,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -455,28 +1165,178 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MethodFlag : "notify" ":"	<< X[0], nil >>`,
 		Id:         "MethodFlag",
-		NTType:     19,
-		Index:      43,
+		NTType:     45,
+		Index:      114,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodFlag : tok_identifier ":"	<< X[0], nil >>`,
+		Id:         "MethodFlag",
+		NTType:     45,
+		Index:      115,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ServiceMethod : tok_identifier "(" tok_identifier ")" tok_identifier MethodNo FieldOption OptEnd	<< bridge.NewMethod(C, X[0], X[2], X[4], X[5], X[6]) >>`,
+		String: `ServiceMethod : tok_identifier "(" MethodRequest ")" MethodReply MethodThrows MethodNo FieldOption OptEnd	<< bridge.NewMethod(C, X[0], X[2], X[4], X[5], X[6], X[7]) >>`,
 		Id:         "ServiceMethod",
-		NTType:     20,
-		Index:      44,
-		NumSymbols: 8,
+		NTType:     46,
+		Index:      116,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.NewMethod(C, X[0], X[2], X[4], X[5], X[6], X[7])
+		},
+	},
+	ProdTabEntry{
+		String: `MethodThrows : empty	<<  >>`,
+		Id:         "MethodThrows",
+		NTType:     47,
+		Index:      117,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodThrows : "throws" ThrowsList	<< X[1], nil >>`,
+		Id:         "MethodThrows",
+		NTType:     47,
+		Index:      118,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ThrowsList : tok_identifier	<< bridge.AppendThrows(nil, X[0]) >>`,
+		Id:         "ThrowsList",
+		NTType:     48,
+		Index:      119,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendThrows(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ThrowsList : ThrowsList "," tok_identifier	<< bridge.AppendThrows(X[0], X[2]) >>`,
+		Id:         "ThrowsList",
+		NTType:     48,
+		Index:      120,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendThrows(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MethodRequest : empty	<<  >>`,
+		Id:         "MethodRequest",
+		NTType:     49,
+		Index:      121,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodRequest : tok_identifier	<< X[0], nil >>`,
+		Id:         "MethodRequest",
+		NTType:     49,
+		Index:      122,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodRequest : MethodParams	<< X[0], nil >>`,
+		Id:         "MethodRequest",
+		NTType:     49,
+		Index:      123,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodReply : tok_identifier	<< X[0], nil >>`,
+		Id:         "MethodReply",
+		NTType:     50,
+		Index:      124,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodReply : "(" ")"	<< nil, nil >>`,
+		Id:         "MethodReply",
+		NTType:     50,
+		Index:      125,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodReply : "(" MethodParams ")"	<< X[1], nil >>`,
+		Id:         "MethodReply",
+		NTType:     50,
+		Index:      126,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MethodParams : FieldType tok_identifier	<< bridge.AppendParam(C, nil, X[0], X[1], nil) >>`,
+		Id:         "MethodParams",
+		NTType:     51,
+		Index:      127,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendParam(C, nil, X[0], X[1], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `MethodParams : FieldType tok_identifier "=" tok_num	<< bridge.AppendParam(C, nil, X[0], X[1], X[3]) >>`,
+		Id:         "MethodParams",
+		NTType:     51,
+		Index:      128,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendParam(C, nil, X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `MethodParams : MethodParams "," FieldType tok_identifier	<< bridge.AppendParam(C, X[0], X[2], X[3], nil) >>`,
+		Id:         "MethodParams",
+		NTType:     51,
+		Index:      129,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.AppendParam(C, X[0], X[2], X[3], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `MethodParams : MethodParams "," FieldType tok_identifier "=" tok_num	<< bridge.AppendParam(C, X[0], X[2], X[3], X[5]) >>`,
+		Id:         "MethodParams",
+		NTType:     51,
+		Index:      130,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return bridge.NewMethod(C, X[0], X[2], X[4], X[5], X[6])
+			return bridge.AppendParam(C, X[0], X[2], X[3], X[5])
 		},
 	},
 	ProdTabEntry{
 		String: `MethodNo : empty	<<  >>`,
 		Id:         "MethodNo",
-		NTType:     21,
-		Index:      45,
+		NTType:     52,
+		Index:      131,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -485,18 +1345,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MethodNo : "=" tok_num	<< X[1], nil >>`,
 		Id:         "MethodNo",
-		NTType:     21,
-		Index:      46,
+		NTType:     52,
+		Index:      132,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
 		},
 	},
+	ProdTabEntry{
+		String: `MethodNo : "=" tok_identifier	<< bridge.ConstRef(C, X[1]) >>`,
+		Id:         "MethodNo",
+		NTType:     52,
+		Index:      133,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return bridge.ConstRef(C, X[1])
+		},
+	},
 	ProdTabEntry{
 		String: `Project : "project" tok_identifier "{" ProjElements "}" OptEnd	<< bridge.NewProject(C, X[1], X[3]) >>`,
 		Id:         "Project",
-		NTType:     22,
-		Index:      47,
+		NTType:     53,
+		Index:      134,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.NewProject(C, X[1], X[3])
@@ -505,8 +1375,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ProjElements : empty	<< &ast.YTProject{}, nil >>`,
 		Id:         "ProjElements",
-		NTType:     23,
-		Index:      48,
+		NTType:     54,
+		Index:      135,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return &ast.YTProject{}, nil
@@ -515,8 +1385,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ProjElements : ProjElements ProjArea	<< bridge.ProjectArea(C, X[0], X[1]) >>`,
 		Id:         "ProjElements",
-		NTType:     23,
-		Index:      49,
+		NTType:     54,
+		Index:      136,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ProjectArea(C, X[0], X[1])
@@ -525,8 +1395,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ProjElements : ProjElements OptionExpr	<< bridge.ProjectOption(C, X[0], X[1]) >>`,
 		Id:         "ProjElements",
-		NTType:     23,
-		Index:      50,
+		NTType:     54,
+		Index:      137,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return bridge.ProjectOption(C, X[0], X[1])
@@ -535,8 +1405,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ProjArea : tok_identifier ":"	<< X[0], nil >>`,
 		Id:         "ProjArea",
-		NTType:     24,
-		Index:      51,
+		NTType:     55,
+		Index:      138,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -14,28 +14,37 @@ var TokMap = token.NewTokenMap(
 		"tok_identifier",
 		"import",
 		"tok_literal",
+		"=",
 		"enum",
 		"{",
 		"}",
 		"message",
-		"=",
+		"tok_tag",
 		"true",
 		"false",
 		"tok_num",
+		"tok_float",
+		"[",
+		"]",
+		",",
+		":",
+		"option_schema",
+		"oneof",
+		"service",
+		"project",
+		"const",
+		"include",
+		"reserved",
+		"optional",
 		"map",
 		"<",
-		",",
 		">",
-		"[",
-		"]",
 		"repeated",
-		"service",
 		"call",
-		":",
 		"notify",
 		"(",
 		")",
-		"project",
+		"throws",
 		"tok_doc",
 	},
 	map[string]token.Type{
@@ -47,28 +56,37 @@ var TokMap = token.NewTokenMap(
 		"tok_identifier": 5,
 		"import":         6,
 		"tok_literal":    7,
-		"enum":           8,
-		"{":              9,
-		"}":              10,
-		"message":        11,
-		"=":              12,
-		"true":           13,
-		"false":          14,
-		"tok_num":        15,
-		"map":            16,
-		"<":              17,
-		",":              18,
-		">":              19,
-		"[":              20,
-		"]":              21,
-		"repeated":       22,
-		"service":        23,
-		"call":           24,
-		":":              25,
-		"notify":         26,
-		"(":              27,
-		")":              28,
-		"project":        29,
-		"tok_doc":        30,
+		"=":              8,
+		"enum":           9,
+		"{":              10,
+		"}":              11,
+		"message":        12,
+		"tok_tag":        13,
+		"true":           14,
+		"false":          15,
+		"tok_num":        16,
+		"tok_float":      17,
+		"[":              18,
+		"]":              19,
+		",":              20,
+		":":              21,
+		"option_schema":  22,
+		"oneof":          23,
+		"service":        24,
+		"project":        25,
+		"const":          26,
+		"include":        27,
+		"reserved":       28,
+		"optional":       29,
+		"map":            30,
+		"<":              31,
+		">":              32,
+		"repeated":       33,
+		"call":           34,
+		"notify":         35,
+		"(":              36,
+		")":              37,
+		"throws":         38,
+		"tok_doc":        39,
 	},
 )
@@ -69,22 +69,22 @@ message m2 {
 				Pkg: &buildpb.PackageDesc{
 					Package: "test",
 					Doc: &buildpb.DocDesc{
-						Doc:     []string{"// package doc\n"},
-						TailDoc: "// package tail doc\n",
+						Doc:     []string{"// package doc"},
+						TailDoc: "// package tail doc",
 					},
 				},
 				Imports: []*buildpb.ImportDesc{
 					{
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// import doc 1\n"},
-							TailDoc: "// import tail doc\n",
+							Doc:     []string{"// import doc 1"},
+							TailDoc: "// import tail doc",
 						},
 						Alias: "",
 						File:  "abc",
 					},
 					{
 						Doc: &buildpb.DocDesc{
-							Doc: []string{"// import doc 2\n"},
+							Doc: []string{"// import doc 2"},
 						},
 						Alias: "abc2",
 						File:  "abc2",
@@ -94,20 +94,35 @@ message m2 {
 					{
 						Name: "e1",
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// enum doc\n"},
-							TailDoc: "// enum tail doc \n",
+							Doc:     []string{"// enum doc"},
+							TailDoc: "// enum tail doc",
 						},
 						Options: &buildpb.OptionDesc{
 							Options: map[string]*buildpb.OptionValue{
 								"opt.v1": {
 									IntValue: 1,
 									Doc: &buildpb.DocDesc{
-										Doc:     []string{"// option doc \n"},
-										TailDoc: "// option tail doc \n",
+										Doc:     []string{"// option doc"},
+										TailDoc: "// option tail doc",
+									},
+									Kind: buildpb.OptionKind_OptionInt,
+									Pos: &buildpb.SourcePos{
+										File:   "test.wproto",
+										Line:   14,
+										Column: 5,
+										Path:   "Enums/e1/Options/opt.v1",
 									},
 								},
 								"opt.xf": {
-									IntValue: 1,
+									IntValue:  1,
+									Kind:      buildpb.OptionKind_OptionBool,
+									BoolValue: true,
+									Pos: &buildpb.SourcePos{
+										File:   "test.wproto",
+										Line:   15,
+										Column: 5,
+										Path:   "Enums/e1/Options/opt.xf",
+									},
 								},
 							},
 						},
@@ -115,19 +130,39 @@ message m2 {
 							{
 								Name: "v1",
 								Doc: &buildpb.DocDesc{
-									Doc:     []string{"// enum value doc \n"},
-									TailDoc: "// enum value tail doc\n",
+									Doc:     []string{"// enum value doc"},
+									TailDoc: "// enum value tail doc",
 								},
 								Value: 1,
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   12,
+									Column: 5,
+									Path:   "Enums/e1/Values/v1",
+								},
 							},
 							{
 								Name: "v2",
 								Doc: &buildpb.DocDesc{
-									TailDoc: "// hex enum value\n",
+									TailDoc: "// hex enum value",
 								},
 								Value: 3,
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   17,
+									Column: 5,
+									Path:   "Enums/e1/Values/v2",
+								},
 							},
 						},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      9,
+							Column:    6,
+							EndLine:   18,
+							EndColumn: 1,
+							Path:      "Enums/e1",
+						},
 					},
 				},
 				Options: &buildpb.OptionDesc{},
@@ -135,16 +170,16 @@ message m2 {
 					{
 						Name: "m1",
 						Doc: &buildpb.DocDesc{
-							Doc:     []string{"// message doc\n"},
-							TailDoc: "// message tail doc\n",
+							Doc:     []string{"// message doc"},
+							TailDoc: "// message tail doc",
 						},
 						Options: &buildpb.OptionDesc{},
 						Fields: []*buildpb.Field{
 							{
 								Name: "f1",
 								Doc: &buildpb.DocDesc{
-									Doc:     []string{"// field doc\n"},
-									TailDoc: "// field tail doc \n",
+									Doc:     []string{"// field doc"},
+									TailDoc: "// field tail doc",
 								},
 								Options: &buildpb.OptionDesc{},
 								No:      1,
@@ -153,16 +188,30 @@ message m2 {
 									Key:     "int32",
 									KeyBase: buildpb.BaseTypeDesc_Int32,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   27,
+									Column: 11,
+									Path:   "Msgs/m1/Fields/f1",
+								},
 							},
 							{
 								Name: "f2",
 								Doc: &buildpb.DocDesc{
-									Doc: []string{"// field 2 doc \n"},
+									Doc: []string{"// field 2 doc"},
 								},
 								Options: &buildpb.OptionDesc{
 									Options: map[string]*buildpb.OptionValue{
 										"opt.field": {
-											IntValue: 1,
+											IntValue:  1,
+											Kind:      buildpb.OptionKind_OptionBool,
+											BoolValue: true,
+											Pos: &buildpb.SourcePos{
+												File:   "test.wproto",
+												Line:   31,
+												Column: 9,
+												Path:   "Msgs/m1/Fields/f2/Options/opt.field",
+											},
 										},
 									},
 								},
@@ -172,6 +221,12 @@ message m2 {
 									Key:     "int64",
 									KeyBase: buildpb.BaseTypeDesc_Int64,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   30,
+									Column: 11,
+									Path:   "Msgs/m1/Fields/f2",
+								},
 							},
 							{
 								Name: "f3",
@@ -182,9 +237,23 @@ message m2 {
 									KeyBase: buildpb.BaseTypeDesc_Int32,
 								},
 								Options: &buildpb.OptionDesc{},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   33,
+									Column: 20,
+									Path:   "Msgs/m1/Fields/f3",
+								},
 							},
 						},
 						SubMsgs: []*buildpb.MsgDesc{},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      24,
+							Column:    9,
+							EndLine:   34,
+							EndColumn: 1,
+							Path:      "Msgs/m1",
+						},
 					},
 					{
 						Name:    "m2",
@@ -199,6 +268,12 @@ message m2 {
 									Key:        "m1",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   37,
+									Column: 8,
+									Path:   "Msgs/m2/Fields/f1",
+								},
 							},
 							{
 								Name:    "f2",
@@ -209,6 +284,12 @@ message m2 {
 									Key:        "abc.abc",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   41,
+									Column: 13,
+									Path:   "Msgs/m2/Fields/f2",
+								},
 							},
 							{
 								Name:    "f3",
@@ -219,6 +300,12 @@ message m2 {
 									Key:        "m3",
 									ElemCustom: true,
 								},
+								Pos: &buildpb.SourcePos{
+									File:   "test.wproto",
+									Line:   42,
+									Column: 8,
+									Path:   "Msgs/m2/Fields/f3",
+								},
 							},
 						},
 						SubMsgs: []*buildpb.MsgDesc{
@@ -235,11 +322,33 @@ message m2 {
 											Key:     "int32",
 											KeyBase: buildpb.BaseTypeDesc_Int32,
 										},
+										Pos: &buildpb.SourcePos{
+											File:   "test.wproto",
+											Line:   39,
+											Column: 15,
+											Path:   "Msgs/m2/SubMsgs/m3/Fields/f1",
+										},
 									},
 								},
 								SubMsgs: []*buildpb.MsgDesc{},
+								Pos: &buildpb.SourcePos{
+									File:      "test.wproto",
+									Line:      38,
+									Column:    13,
+									EndLine:   40,
+									EndColumn: 5,
+									Path:      "Msgs/m2/SubMsgs/m3",
+								},
 							},
 						},
+						Pos: &buildpb.SourcePos{
+							File:      "test.wproto",
+							Line:      36,
+							Column:    9,
+							EndLine:   43,
+							EndColumn: 1,
+							Path:      "Msgs/m2",
+						},
 					},
 				},
 			},
@@ -270,3 +379,1034 @@ message m2 {
 		})
 	}
 }
+
+func TestParseOneof(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+message event {
+	int64 uid = 1;
+	// payload doc
+	oneof payload {
+		event.opt = 1
+		string text = 2;
+		int32 code = 3;
+	}
+	int64 time = 4;
+}
+`))
+	if !assert.Nil(t, err, "parse oneof") {
+		return
+	}
+	assert.Nil(t, prog.AnalyseProgram(), "analyse oneof")
+	msg := prog.Messages[0]
+	if assert.Len(t, msg.Oneofs, 1, "oneof") {
+		oneof := msg.Oneofs[0]
+		assert.Equal(t, "payload", oneof.Name, "oneof name")
+		assert.Len(t, oneof.Fields, 2, "oneof fields")
+		assert.True(t, oneof.HasOption("event.opt"), "oneof option")
+		assert.Equal(t, []string{"// payload doc"}, oneof.Doc, "oneof doc")
+	}
+	assert.Len(t, msg.Fields, 4, "message fields")
+	assert.Same(t, msg.Oneofs[0], msg.Fields[1].Oneof, "field oneof")
+	assert.Nil(t, msg.Fields[3].Oneof, "normal field")
+
+	desc := prog.GetFileDesc().Msgs[0]
+	assert.Equal(t, []string{"text", "code"}, desc.Oneofs[0].Fields, "oneof desc")
+	assert.Equal(t, "payload", desc.Fields[2].Oneof, "field desc")
+
+	for name, data := range map[string]string{
+		"number repeated":  "package test\nmessage m { int32 a = 1; oneof o { int32 b = 1; } }",
+		"name repeated":    "package test\nmessage m { int32 o = 1; oneof o { int32 b = 2; } }",
+		"list field":       "package test\nmessage m { oneof o { []int32 b = 2; } }",
+		"empty oneof":      "package test\nmessage m { oneof o { } }",
+		"oneofs no repeat": "package test\nmessage m { oneof o { int32 a = 1; } oneof o2 { int32 b = 1; } }",
+	} {
+		prog, err := Parse("test.wproto", []byte(data))
+		if assert.Nil(t, err, name) {
+			assert.NotNil(t, prog.AnalyseProgram(), name)
+		}
+	}
+}
+
+func TestParseReserved(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+enum color {
+	reserved 2, 10 to max;
+	reserved "yellow";
+	red = 1
+	blue = 3
+}
+message user {
+	reserved 3, 5 to 9;
+	reserved "old_name", "nick";
+	int64 uid = 1;
+	string name = 2;
+	int32 to = 10;
+}
+`))
+	if !assert.Nil(t, err, "parse reserved") {
+		return
+	}
+	assert.Nil(t, prog.AnalyseProgram(), "analyse reserved")
+	msg := prog.Messages[0]
+	if assert.Len(t, msg.Reserved.Ranges, 2, "message reserved ranges") {
+		assert.EqualValues(t, 5, msg.Reserved.Ranges[1].Start, "range start")
+		assert.EqualValues(t, 9, msg.Reserved.Ranges[1].End, "range end")
+	}
+	assert.Len(t, msg.Reserved.Names, 2, "message reserved names")
+	assert.Len(t, msg.Fields, 3, "message fields")
+	enum := prog.EnumDefs[0]
+	assert.Len(t, enum.Values, 2, "enum values")
+	if assert.Len(t, enum.Reserved.Ranges, 2, "enum reserved ranges") {
+		assert.Equal(t, ast.ReservedMax, enum.Reserved.Ranges[1].End, "range max")
+	}
+
+	desc := prog.GetFileDesc()
+	assert.Equal(t, []string{"old_name", "nick"}, desc.Msgs[0].ReservedNames, "reserved names desc")
+	assert.Len(t, desc.Enums[0].ReservedRanges, 2, "reserved ranges desc")
+
+	for name, data := range map[string]string{
+		"field id reserved":   "package test\nmessage m { reserved 3, 5 to 9; int32 a = 6; }",
+		"field name reserved": "package test\nmessage m { reserved \"a\"; int32 a = 1; }",
+		"range overlap":       "package test\nmessage m { reserved 3 to 6, 5 to 9; }",
+		"name repeated":       "package test\nmessage m { reserved \"a\", \"a\"; }",
+		"enum value reserved": "package test\nenum e { reserved 1; v = 1 }",
+		"enum name reserved":  "package test\nenum e { reserved \"v\"; v = 1 }",
+		"range zero":          "package test\nmessage m { reserved 0 to 5; }",
+		"range internal":      "package test\nmessage m { reserved 19500; }",
+		"range too large":     "package test\nmessage m { reserved 10 to 536870912; }",
+	} {
+		prog, err := Parse("test.wproto", []byte(data))
+		if assert.Nil(t, err, name) {
+			assert.NotNil(t, prog.AnalyseProgram(), name)
+		}
+	}
+	for name, data := range map[string]string{
+		"range invalid": "package test\nmessage m { reserved 9 to 5; }",
+		"range keyword": "package test\nmessage m { reserved 5 from 9; }",
+	} {
+		_, err := Parse("test.wproto", []byte(data))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseFieldNo(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte("package test\nmessage m { int32 a = 256; int32 b = 0x1000; int32 c = 536870911; }"))
+	if assert.Nil(t, err, "large field no") {
+		assert.EqualValues(t, 256, prog.Messages[0].Fields[0].No, "field no")
+		assert.EqualValues(t, 4096, prog.Messages[0].Fields[1].No, "hex field no")
+		assert.EqualValues(t, 536870911, prog.GetFileDesc().Msgs[0].Fields[2].No, "max field no")
+	}
+	for name, no := range map[string]string{
+		"zero":           "0",
+		"negative":       "-1",
+		"too large":      "536870912",
+		"int32 overflow": "4294967297",
+		"reserved start": "19000",
+		"reserved end":   "19999",
+	} {
+		_, err := Parse("test.wproto", []byte("package test\nmessage m { int32 a = "+no+"; }"))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseOptionValue(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+const C int32 { V = 7 }
+test.bool = true
+test.int = 0x10
+test.float = -1.5e2
+test.list = [1, 2, 3,]
+test.empty = []
+test.object = {
+	name: "x"
+	ratio: 0.5,
+	ids: [C.V, 2]
+	sub: { on: false }
+}
+`))
+	if !assert.Nil(t, err, "parse option value") {
+		return
+	}
+	assert.Nil(t, prog.AnalyseProgram(), "analyse option value")
+	assert.True(t, prog.GetOptionBool("test.bool"), "bool")
+	assert.EqualValues(t, 1, prog.GetOptionInt("test.bool"), "bool compatible int")
+	assert.EqualValues(t, 16, prog.GetOptionInt("test.int"), "int")
+	assert.Equal(t, -150.0, prog.GetOptionFloat("test.float"), "float")
+	assert.Equal(t, 16.0, prog.GetOptionFloat("test.int"), "int as float")
+	if list := prog.GetOptionList("test.list"); assert.Len(t, list, 3, "list") {
+		assert.EqualValues(t, 3, *list[2].IntVal, "list item")
+	}
+	assert.Equal(t, ast.OptionValueList, prog.GetOptionValue("test.empty").Kind, "empty list")
+	obj := prog.GetOptionValue("test.object")
+	assert.Len(t, obj.Object, 4, "object")
+	assert.Equal(t, "x", *obj.Field("name").Value, "object field")
+	assert.EqualValues(t, 7, *obj.Field("ids").List[0].IntVal, "const in list")
+	assert.False(t, *obj.Field("sub").Field("on").BoolVal, "nested object")
+	assert.Equal(t, `{name: x, ratio: 0.5, ids: [7, 2], sub: {on: false}}`, obj.String(), "object string")
+
+	desc := prog.GetFileDesc()
+	assert.Equal(t, 0.5, desc.Options.GetObject("test.object")["ratio"].FloatValue, "object desc")
+	assert.True(t, desc.GetBool("test.bool", false), "bool desc")
+	assert.Equal(t, -150.0, desc.GetFloat64("test.float", 0), "float desc")
+	assert.Len(t, desc.GetList("test.list"), 3, "list desc")
+
+	_, err = Parse("test.wproto", []byte("package test\ntest.object = { a: 1, a: 2 }\n"))
+	assert.NotNil(t, err, "object key repeated")
+}
+
+func TestParseOptionSchema(t *testing.T) {
+	const schema = `package test
+option_schema test {
+	// 是否开启
+	enable bool [file, message]
+	ratio float = 1
+	tags list [field]
+	name string
+}
+`
+	prog, err := Parse("test.wproto", []byte(schema+`test.enable = true
+message rq {
+	test.ratio = 2
+	int32 a = 1 { test.tags = ["x"] }
+}
+`))
+	if !assert.Nil(t, err, "parse option schema") {
+		return
+	}
+	if !assert.Nil(t, prog.AnalyseProgram(), "analyse option schema") {
+		return
+	}
+	if assert.Len(t, prog.OptionSchemas, 1, "option schema") {
+		s := prog.OptionSchemas[0]
+		assert.Equal(t, "test", s.Namespace, "namespace")
+		assert.Equal(t, ast.ScopeFile|ast.ScopeMessage, s.Lookup("enable").Scope, "scope")
+		assert.Equal(t, ast.OptionValueFloat, s.Lookup("ratio").Type, "type")
+		assert.EqualValues(t, 1, *s.Lookup("ratio").Default.IntVal, "default")
+	}
+
+	for name, tc := range map[string]struct {
+		src string
+		msg string
+	}{
+		"unknown key":     {"test.enabel = true\n", "did you mean [test.enable]"},
+		"wrong scope":     {"test.tags = []\n", "only allowed in field"},
+		"wrong type":      {"test.name = 1\n", "need [string]"},
+		"namespace typo":  {"tset.enable = true\n", "did you mean [test.enable]"},
+		"default type":    {"option_schema other { a int = \"x\" }\n", "default value type"},
+		"invalid scope":   {"option_schema other { a int [files] }\n", "option scope [files] invalid"},
+		"other namespace": {"other.anything = 1\n", ""},
+	} {
+		prog, err := Parse("test.wproto", []byte(schema+tc.src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		if tc.msg == "" {
+			assert.Nil(t, err, name)
+			continue
+		}
+		if assert.NotNil(t, err, name) {
+			assert.Contains(t, err.Error(), tc.msg, name)
+		}
+	}
+}
+
+func TestApplyCmdOptions(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+test.level = 1
+message rq {
+	test.name = "source"
+	int32 a = 1 { test.tag = "a" }
+	int32 b = 2
+	message inner { int32 c = 1 }
+}
+service svc {
+	call:
+	f1(rq) rq
+	f2(rq) rq { rpc.timeout = 1 }
+}
+`))
+	if !assert.Nil(t, err, "parse") {
+		return
+	}
+	if !assert.Nil(t, prog.AnalyseProgram(), "analyse") {
+		return
+	}
+	err = prog.ApplyCmdOptions(
+		"test.level=2",
+		"test.flag",
+		"test.rq.a:go.tag=json:\"x\"",
+		"rq.*:test.tag=all",
+		"rq.b:test.tag=b",
+		"message/rq:test.name=cmd",
+		"message/*.inner:test.sub=true",
+		"service/*:rpc.timeout=5",
+		"method/svc.f1:rpc.retry=1.5",
+		"file/test:test.file=x",
+	)
+	if !assert.Nil(t, err, "apply") {
+		return
+	}
+	assert.EqualValues(t, 2, prog.GetOptionInt("test.level"), "file option replaced")
+	assert.True(t, prog.HasOption("test.flag"), "file option without value")
+	assert.Equal(t, "x", prog.GetOptionString("test.file"), "file selector")
+	msg := prog.Messages[0]
+	assert.Equal(t, "cmd", msg.GetOptionString("test.name"), "message override source")
+	assert.Equal(t, `json:"x"`, msg.Fields[0].GetOptionString("go.tag"), "field selector with package")
+	assert.Equal(t, "all", msg.Fields[0].GetOptionString("test.tag"), "glob field")
+	assert.Equal(t, "b", msg.Fields[1].GetOptionString("test.tag"), "later override wins")
+	assert.True(t, msg.SubMsgs[0].GetOptionBool("test.sub"), "nested message")
+	assert.False(t, msg.SubMsgs[0].Fields[0].HasOption("test.tag"), "glob not cross message")
+	svc := prog.Services[0]
+	assert.EqualValues(t, 5, svc.GetOptionInt("rpc.timeout"), "service")
+	assert.EqualValues(t, 1, svc.Methods[1].GetOptionInt("rpc.timeout"), "method keep source option")
+	assert.Equal(t, 1.5, svc.Methods[0].GetOptionFloat("rpc.retry"), "method")
+	assert.False(t, svc.Methods[1].HasOption("rpc.retry"), "method not match")
+
+	for _, v := range []string{"xx", "rq:xx=1", "bad/rq:test.a=1", ":test.a=1", "[:test.a=1"} {
+		assert.NotNil(t, prog.ApplyCmdOptions(v), v)
+	}
+}
+
+func TestParseTypeAlias(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+// 玩家ID
+type player_id = int64
+type item_id = uint32;
+type owner_id = player_id
+message item {
+	int32 type = 1
+	player_id owner = 2
+	[]item_id ids = 3
+	map<item_id, player_id> owners = 4
+	owner_id last = 5
+	message sub { item_id id = 1 }
+}
+`))
+	if !assert.Nil(t, err, "parse type alias") {
+		return
+	}
+	if !assert.Nil(t, prog.AnalyseProgram(), "analyse type alias") {
+		return
+	}
+	if assert.Len(t, prog.Aliases, 3, "aliases") {
+		assert.Equal(t, []string{"// 玩家ID"}, prog.Aliases[0].Doc, "alias doc")
+		assert.Equal(t, ast.BaseTypeInt64, prog.Aliases[2].Base, "alias of alias")
+	}
+	fields := prog.Messages[0].Fields
+	assert.Equal(t, "type", fields[0].Name, "type as field name")
+	assert.Equal(t, ast.BaseTypeInt64, fields[1].Type.YTBaseType, "alias resolved")
+	assert.Nil(t, fields[1].Type.YTCustomType, "alias is not custom type")
+	assert.Equal(t, "item_id", fields[2].Type.YTListType.Alias.Name, "list alias")
+	assert.Equal(t, ast.BaseTypeUint32, fields[3].Type.YTMapTypee.Key, "map key alias")
+	assert.Equal(t, "player_id", fields[3].Type.YTMapTypee.Value.Alias.Name, "map value alias")
+	assert.Equal(t, "owner_id", fields[4].Type.Alias.Name, "alias name kept")
+	assert.Equal(t, ast.BaseTypeUint32, prog.Messages[0].SubMsgs[0].Fields[0].Type.YTBaseType, "sub message alias")
+
+	desc := prog.GetFileDesc()
+	if assert.Len(t, desc.Aliases, 3, "alias desc") {
+		assert.Equal(t, "test", desc.Aliases[0].Package, "alias package")
+	}
+	typ := desc.Msgs[0].Fields[3].Type
+	assert.Equal(t, "uint32", typ.Key, "map key base")
+	assert.Equal(t, "item_id", typ.KeyAlias.Name, "map key alias desc")
+	assert.Equal(t, "player_id", typ.ValueAlias.Name, "map value alias desc")
+	assert.Equal(t, "owner_id", desc.Msgs[0].Fields[4].Type.KeyAlias.Name, "field alias desc")
+
+	for name, src := range map[string]string{
+		"not basic":  "type a = item\nmessage item {}\n",
+		"circular":   "type a = b\ntype b = a\n",
+		"repeated":   "type a = int32\nmessage a {}\n",
+		"basic name": "type int32 = int64\n",
+		"map key":    "message m { map<m, int32> v = 1 }\n",
+		"keyword":    "typo a = int32\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseNestedType(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+enum kind { k0 = 0 }
+message item {
+	enum kind { none = 0; weapon = 2 }
+	message attr {
+		kind k = 1
+		message value { int32 v = 1 }
+	}
+	kind k = 1
+	attr a = 2
+	[]attr.value vals = 3
+}
+message bag {
+	item.kind k = 1
+	kind top = 2
+	map<int32, item.attr.value> v = 3
+	test.item.attr a = 4
+	value flat = 5
+}
+`))
+	if !assert.Nil(t, err, "parse nested type") {
+		return
+	}
+	if !assert.Nil(t, prog.AnalyseProgram(), "analyse nested type") {
+		return
+	}
+	assert.Len(t, prog.EnumDefs, 1, "nested enum not in file")
+	item, bag := prog.Messages[0], prog.Messages[1]
+	if assert.Len(t, item.SubEnums, 1, "nested enum") {
+		assert.Equal(t, "item.kind", item.SubEnums[0].FullName, "enum full name")
+		assert.EqualValues(t, 2, item.SubEnums[0].Values[1].Value, "enum value")
+	}
+	attr := item.SubMsgs[0]
+	assert.Equal(t, "item.attr", attr.FullName, "message full name")
+	assert.Equal(t, "item.kind", attr.Fields[0].Type.FullName, "resolve from outer scope")
+	assert.Equal(t, "item.kind", item.Fields[0].Type.FullName, "nested enum first")
+	assert.Equal(t, attr, item.Fields[1].Type.Msg, "nested message")
+	assert.Equal(t, "item.attr.value", item.Fields[2].Type.YTListType.FullName, "qualified list element")
+	assert.Equal(t, "item.kind", bag.Fields[0].Type.FullName, "qualified enum")
+	assert.Equal(t, "kind", bag.Fields[1].Type.FullName, "top level enum")
+	assert.Equal(t, "item.attr.value", bag.Fields[2].Type.YTMapTypee.Value.FullName, "qualified map value")
+	assert.Equal(t, attr, bag.Fields[3].Type.Msg, "package qualified")
+	assert.Equal(t, "item.attr.value", bag.Fields[4].Type.FullName, "flat nested message name")
+
+	desc := prog.GetFileDesc()
+	if assert.Len(t, desc.Msgs[0].SubEnums, 1, "sub enum desc") {
+		assert.Equal(t, "item.kind", desc.Msgs[0].SubEnums[0].FullName, "sub enum full name desc")
+	}
+	assert.Equal(t, "item.attr", desc.Msgs[0].SubMsgs[0].FullName, "sub message full name desc")
+	assert.Equal(t, "item.attr.value", desc.Msgs[1].Fields[2].Type.FullName, "type full name desc")
+
+	for name, src := range map[string]string{
+		"unknown":       "message m { m.kind k = 1 }\n",
+		"enum repeated": "message m { enum e { a = 1 } enum e { b = 1 } }\n",
+		"enum and msg":  "message m { enum e { a = 1 } message e {} }\n",
+		"inner scope":   "message m { message n { enum e { a = 1 } } e v = 1 }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseFieldDefault(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+const limit int32 { max_hp = 1000 }
+enum color { red = 0; blue = 1 }
+type player_id = int64
+message attr { int32 v = 1 }
+message item {
+	optional int32 hp = 1 { default = limit.max_hp; example.opt = 1 }
+	optional attr sub = 2
+	float32 speed = 3 { default = 2 }
+	color c = 4 { default = color.blue }
+	bool ok = 5 { default = true }
+	player_id owner = 6 { default = 10 }
+}
+`))
+	if !assert.Nil(t, err, "parse field default %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse field default %v", err) {
+		return
+	}
+	fields := prog.Messages[1].Fields
+	assert.True(t, fields[0].Optional, "optional")
+	assert.True(t, fields[1].Optional, "optional message")
+	assert.EqualValues(t, 1000, *fields[0].Default.Value.IntVal, "const default")
+	assert.Len(t, fields[0].Opts, 1, "default not in options")
+	assert.EqualValues(t, 2, *fields[2].Default.Value.FloatVal, "int to float default")
+	assert.Equal(t, "blue", *fields[3].Default.Value.Value, "enum default name")
+
+	desc := prog.GetFileDesc().Msgs[1].Fields
+	assert.True(t, desc[0].Optional, "optional desc")
+	assert.Nil(t, desc[1].Default, "no default desc")
+	assert.EqualValues(t, 1, desc[3].Default.IntValue, "enum default desc")
+	assert.True(t, desc[4].Default.BoolValue, "bool default desc")
+	assert.EqualValues(t, 10, desc[5].Default.IntValue, "alias default desc")
+
+	for name, src := range map[string]string{
+		"int8 range":    "message m { int8 v = 1 { default = 200 } }\n",
+		"uint negative": "message m { uint32 v = 1 { default = -1 } }\n",
+		"string":        "message m { string v = 1 { default = 1 } }\n",
+		"bool":          "message m { bool v = 1 { default = 1 } }\n",
+		"list":          "message m { []int32 v = 1 { default = 1 } }\n",
+		"message":       "message m { m v = 1 { default = 1 } }\n",
+		"enum value":    "enum e { a = 0 }\nmessage m { e v = 1 { default = e.b } }\n",
+		"enum name":     "enum e { a = 0 }\nenum f { a = 0 }\nmessage m { e v = 1 { default = f.a } }\n",
+		"repeated":      "message m { int32 v = 1 { default = 1; default = 2 } }\n",
+		"optional list": "message m { optional []int32 v = 1 }\n",
+		"oneof":         "message m { oneof o { optional int32 v = 1 } }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseFixedArray(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+const limit int32 { slots = 4; zero = 0 }
+message item { int32 id = 1 }
+message player {
+	[4]int32 equips = 1
+	[limit.slots]item bag = 2
+	[]int32 list = 3 { max_len = 16 }
+	map<int32, item> items = 4 { max_len = limit.slots }
+	string name = 5 { max_len = 32; example.opt = 1 }
+	[2]bytes keys = 6
+}
+`))
+	if !assert.Nil(t, err, "parse fixed array %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse fixed array %v", err) {
+		return
+	}
+	fields := prog.Messages[1].Fields
+	assert.EqualValues(t, 4, fields[0].Type.YTListType.Size, "fixed array")
+	assert.EqualValues(t, 4, fields[1].Type.YTListType.Size, "const size")
+	assert.EqualValues(t, 0, fields[2].Type.YTListType.Size, "list")
+	assert.Len(t, fields[4].Opts, 1, "max_len not in options")
+
+	desc := prog.GetFileDesc().Msgs[1].Fields
+	assert.EqualValues(t, 4, desc[1].Type.Size, "size desc")
+	assert.EqualValues(t, 16, desc[2].Type.MaxLen, "list max_len desc")
+	assert.EqualValues(t, 4, desc[3].Type.MaxLen, "map max_len desc")
+	assert.EqualValues(t, 32, desc[4].Type.MaxLen, "string max_len desc")
+	for k, v := range map[int]string{0: "[4]int32", 1: "[4]*Item", 2: "[]int32", 5: "[2][]byte"} {
+		typ, err := desc[k].GoType()
+		assert.Nil(t, err, "go type %d", k)
+		assert.Equal(t, v, typ, "go type %d", k)
+	}
+
+	for name, src := range map[string]string{
+		"zero size":      "message m { [0]int32 v = 1 }\n",
+		"const zero":     "const c int32 { zero = 0 }\nmessage m { [c.zero]int32 v = 1 }\n",
+		"const unknown":  "message m { [c.none]int32 v = 1 }\n",
+		"max_len int":    "message m { int32 v = 1 { max_len = 1 } }\n",
+		"max_len fixed":  "message m { [2]int32 v = 1 { max_len = 1 } }\n",
+		"max_len zero":   "message m { string v = 1 { max_len = 0 } }\n",
+		"max_len string": "message m { string v = 1 { max_len = \"1\" } }\n",
+		"oneof":          "message m { oneof o { [2]int32 v = 1 } }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseInlineMethod(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+message item_info { int64 id = 1 }
+message login_rq { string account = 1 }
+service svc {
+	call:
+	get_item(int64 uid, int32 item_id) (item_info info, int32 code = 3)
+	login(login_rq) (int32 code) = 5
+	ping() void
+	list([]int64 ids) item_info { opt.timeout = 3 }
+}
+`))
+	if !assert.Nil(t, err, "parse inline method %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse inline method %v", err) {
+		return
+	}
+	names := []string{}
+	for _, msg := range prog.Messages {
+		names = append(names, msg.Name)
+	}
+	assert.Equal(t, []string{"item_info", "login_rq", "svc_get_item_rq", "svc_get_item_rs", "svc_login_rs", "svc_list_rq"}, names, "synthesised messages")
+	rq := prog.Messages[2]
+	if assert.Len(t, rq.Fields, 2, "request params") {
+		assert.Equal(t, "item_id", rq.Fields[1].Name, "param name")
+		assert.EqualValues(t, 2, rq.Fields[1].No, "param field no")
+	}
+	rs := prog.Messages[3]
+	assert.Equal(t, prog.Messages[0], rs.Fields[0].Type.Msg, "reply param type")
+	assert.EqualValues(t, 3, rs.Fields[1].No, "explicit param field no")
+
+	methods := prog.Services[0].Methods
+	assert.Equal(t, "svc_get_item_rq", methods[0].Request.Name, "inline request")
+	assert.Equal(t, "svc_get_item_rs", methods[0].Reply.Name, "inline reply")
+	assert.Same(t, rq, methods[0].ParamRequest, "inline request message")
+	assert.Same(t, rs, methods[0].ParamReply, "inline reply message")
+	assert.Equal(t, "login_rq", methods[1].Request.Name, "named request")
+	assert.Equal(t, "svc_login_rs", methods[1].Reply.Name, "inline reply with named request")
+	assert.Nil(t, methods[1].ParamRequest, "named request")
+	assert.Nil(t, methods[2].Request, "empty request")
+	assert.Nil(t, methods[2].Reply, "void reply")
+	assert.EqualValues(t, 3, methods[3].GetOptionInt("opt.timeout"), "method option")
+
+	desc := prog.GetFileDesc()
+	assert.Equal(t, "svc_get_item_rq", desc.Services[0].Methods[0].Request.Name, "request desc")
+	assert.Len(t, desc.Msgs, 6, "message desc")
+
+	// 不同服务的同名方法
+	prog, err = Parse("test.wproto", []byte(`package test
+service s1 { get(int32 a) (int32 b) }
+service s2 { get(string a = 2) void }
+`))
+	if assert.Nil(t, err, "same method name") && assert.Nil(t, prog.AnalyseProgram(), "same method name") {
+		methods := []*ast.YTMethod{prog.Services[0].Methods[0], prog.Services[1].Methods[0]}
+		assert.Equal(t, "s1_get_rq", methods[0].Request.Name, "service 1 request")
+		assert.Equal(t, "s1_get_rs", methods[0].Reply.Name, "service 1 reply")
+		assert.Equal(t, "s2_get_rq", methods[1].Request.Name, "service 2 request")
+		assert.EqualValues(t, 2, methods[1].ParamRequest.Fields[0].No, "service 2 param no")
+		assert.Len(t, prog.Messages, 3, "same method name messages")
+	}
+
+	for name, src := range map[string]string{
+		"name repeated":  "message s_f_rq {}\nservice s { f(int32 a) void }\n",
+		"param repeated": "service s { f(int32 a, int32 a) void }\n",
+		"no repeated":    "service s { f(int32 a = 2, int32 b = 2) void }\n",
+		"unknown type":   "service s { f(none a) void }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		if assert.NotNil(t, err, name) && name == "name repeated" {
+			assert.Contains(t, err.Error(), "[s_f_rq]", name)
+			assert.Contains(t, err.Error(), "test.wproto:3:", "error at method")
+		}
+	}
+}
+
+func TestParseMethodThrows(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+enum common_err { ok = 0; busy = 1 }
+enum login_err { bad_pass = 100; banned = 101 }
+message rq { int32 a = 1 }
+message rs { int32 b = 1 }
+service svc throws common_err {
+	login(rq) rs throws login_err, common_err = 5 { opt.timeout = 1 }
+	logout(rq) void
+}
+`))
+	if !assert.Nil(t, err, "parse throws %v", err) {
+		return
+	}
+	assert.EqualValues(t, 5, *prog.Services[0].Methods[0].No.Value, "method no after throws")
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse throws %v", err) {
+		return
+	}
+	svc := prog.Services[0]
+	assert.Equal(t, prog.EnumDefs[0], svc.Throws[0].Enum, "service throws")
+	login := svc.Methods[0]
+	if assert.Len(t, login.Throws, 2, "method throws") {
+		assert.Equal(t, prog.EnumDefs[1], login.Throws[0].Enum, "method throws enum")
+	}
+	assert.EqualValues(t, 1, login.GetOptionInt("opt.timeout"), "method option after throws")
+
+	desc := prog.GetFileDesc().Services[0]
+	assert.Len(t, desc.Throws, 1, "service throws desc")
+	methods := desc.Methods
+	if assert.Len(t, methods[0].Throws, 2, "service and method throws merged") {
+		assert.Equal(t, "common_err", methods[0].Throws[0].FullName, "service throws first")
+		assert.Equal(t, "login_err", methods[0].Throws[1].Enum.Name, "method throws enum desc")
+	}
+	assert.Len(t, methods[0].Errors(), 4, "method errors")
+	assert.Len(t, methods[1].Throws, 1, "inherit service throws")
+
+	for name, src := range map[string]string{
+		"message":  "message m {}\nservice s { f(m) m throws m }\n",
+		"unknown":  "message m {}\nservice s { f(m) m throws none }\n",
+		"repeated": "enum e { a = 0 }\nmessage m {}\nservice s { f(m) m throws e, e }\n",
+		"service":  "message m {}\nservice s throws m { f(m) m }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseMethodFlag(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+message rq { int32 a = 1 }
+message rs { int32 b = 1 }
+service svc {
+	get(rq) rs
+notify:
+	kick(rq) void
+server_stream:
+	watch(rq) rs
+client_stream:
+	upload(rq) rs
+bidi_stream:
+	chat(rq) rs
+broadcast:
+	announce(rq) ()
+}
+`))
+	if !assert.Nil(t, err, "parse method flag %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse method flag %v", err) {
+		return
+	}
+	flags := []ast.MethodFlag{ast.Call, ast.Notify, ast.ServerStream, ast.ClientStream, ast.BidiStream, ast.Broadcast}
+	for k, method := range prog.Services[0].Methods {
+		assert.Equal(t, flags[k], method.Flag, method.Name)
+	}
+	desc := prog.GetFileDesc().Services[0].Methods
+	assert.Equal(t, buildpb.MethodType_ServerStream, desc[2].Type, "method type desc")
+	assert.Equal(t, buildpb.MethodType_Broadcast, desc[5].Type, "broadcast type desc")
+
+	for name, src := range map[string]string{
+		"invalid flag":    "service s {\nstream:\n f(m) m\n}\n",
+		"notify reply":    "service s {\nnotify:\n f(m) m\n}\n",
+		"broadcast reply": "service s {\nbroadcast:\n f(m) m\n}\n",
+		"stream no reply": "service s {\nserver_stream:\n f(m) void\n}\n",
+		"client no rq":    "service s {\nclient_stream:\n f() m\n}\n",
+		"bidi no reply":   "service s {\nbidi_stream:\n f(m) ()\n}\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\nmessage m {}\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseInclude(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+message rq {
+	include header;
+	include trace = 10
+	int32 a = 20
+	message inner { include header = 20; int32 b = 1 }
+}
+message header {
+	int64 uid = 1
+	oneof target { int32 zone = 2; string server = 3 }
+}
+message trace { string trace_id = 1 }
+`))
+	if !assert.Nil(t, err, "parse include %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse include %v", err) {
+		return
+	}
+	rq := prog.Messages[0]
+	names := []string{"uid", "zone", "server", "trace_id", "a"}
+	nos := []int32{1, 2, 3, 11, 20}
+	if assert.Len(t, rq.Fields, len(names), "include fields") {
+		for k, field := range rq.Fields {
+			assert.Equal(t, names[k], field.Name, "field name")
+			assert.Equal(t, nos[k], field.No, field.Name)
+		}
+	}
+	assert.Equal(t, prog.Messages[1], rq.Fields[0].Include.Msg, "field origin")
+	assert.Nil(t, rq.Fields[4].Include, "own field")
+	if assert.Len(t, rq.Oneofs, 1, "include oneof") {
+		assert.Equal(t, rq.Oneofs[0], rq.Fields[1].Oneof, "copied oneof")
+		assert.NotEqual(t, prog.Messages[1].Oneofs[0], rq.Oneofs[0], "oneof copied")
+	}
+	assert.EqualValues(t, 21, rq.SubMsgs[0].Fields[0].No, "nested include offset")
+	assert.Len(t, prog.Messages[1].Fields, 3, "source message unchanged")
+
+	desc := prog.GetFileDesc().Msgs[0].Fields
+	assert.Equal(t, "trace", desc[3].Embed.Msg.Name, "embed desc")
+	assert.EqualValues(t, 10, desc[3].EmbedOffset, "embed offset desc")
+
+	for name, src := range map[string]string{
+		"name conflict":   "message h { int32 a = 1 }\nmessage m { include h = 10; int32 a = 1 }\n",
+		"number conflict": "message h { int32 a = 1 }\nmessage m { include h; int32 b = 1 }\n",
+		"offset":          "message h { int32 a = 1 }\nmessage m { include h; include h = 10 }\n",
+		"reserved":        "message h { int32 a = 1 }\nmessage m { reserved 5; include h = 4 }\n",
+		"range":           "message h { int32 a = 1 }\nmessage m { include h = 18999 }\n",
+		"cycle":           "message h { include m }\nmessage m { include h }\n",
+		"self":            "message m { include m }\n",
+		"enum":            "enum e { a = 0 }\nmessage m { include e }\n",
+		"unknown":         "message m { include none }\n",
+		"oneof conflict":  "message h { oneof o { int32 a = 1 } }\nmessage m { include h = 10; oneof o { int32 b = 1 } }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseTargetTags(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+enum kind {
+	normal = 0
+	@gm debug = 1
+}
+// 登录请求
+@server @client
+message login_rq {
+	string account = 1
+	@server string ip = 2
+	@server message inner { int32 a = 1 }
+}
+@server message server_info { int32 load = 1 }
+message user {
+	int64 uid = 1
+	@server server_info info = 2
+	oneof ext { @server int32 sid = 3; @server int32 zone = 4 }
+}
+service user_svr {
+	login(login_rq) user
+	@gm kick(int64 uid) ()
+}
+@gm service gm_svr {
+	debug(login_rq) ()
+}
+@server @gm service inner_svr {
+	push(int64 uid) ()
+	@gm @client reload(int32 v) ()
+}
+`))
+	if !assert.Nil(t, err, "parse tags %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse tags %v", err) {
+		return
+	}
+	rq := prog.Messages[0]
+	assert.Equal(t, []string{"server", "client"}, rq.TagNames(), "message tags")
+	if assert.NotNil(t, rq.YTDoc, "doc before tags") {
+		assert.Equal(t, []string{"// 登录请求"}, rq.Doc, "doc before tags")
+	}
+	assert.True(t, rq.Fields[1].HasTag("server"), "field tag")
+	assert.True(t, prog.EnumDefs[0].Values[1].HasTag("gm"), "enum value tag")
+	assert.True(t, prog.Services[0].Methods[1].HasTag("gm"), "method tag")
+	assert.True(t, prog.Services[1].HasTag("gm"), "service tag")
+	inline := 0
+	for _, msg := range prog.Messages {
+		switch msg.Name {
+		case "user_svr_kick_rq":
+			assert.True(t, msg.HasTag("gm"), "inline params message tag")
+		case "inner_svr_push_rq":
+			assert.Equal(t, []string{"server", "gm"}, msg.TagNames(), "inline params message service tags")
+		case "inner_svr_reload_rq":
+			assert.Equal(t, []string{"gm"}, msg.TagNames(), "inline params message tags in service tags")
+		default:
+			continue
+		}
+		inline++
+	}
+	assert.Equal(t, 3, inline, "inline params messages")
+	assert.Equal(t, []string{"server", "client"}, prog.GetFileDesc().Msgs[0].Tags, "tags desc")
+
+	// 同一个tag只能设置一次
+	_, err = Parse("test.wproto", []byte("package test\n@a @a message m {}\n"))
+	assert.NotNil(t, err, "repeated tag")
+	_, err = Parse("test.wproto", []byte("package test\nenum e { @a opt.x = 1 }\n"))
+	assert.NotNil(t, err, "enum option tag")
+	_, err = Parse("test.wproto", []byte("package test\n@server service s {\n\t@client f(int32 a) ()\n}\n"))
+	if assert.NotNil(t, err, "method tags not match service tags") {
+		assert.Contains(t, err.Error(), "test.wproto:3:", "method position")
+	}
+}
+
+func TestFilterTarget(t *testing.T) {
+	src := `package test
+enum kind {
+	normal = 0
+	@gm debug = 1
+}
+message login_rq {
+	string account = 1
+	@server string ip = 2
+	@server message inner { int32 a = 1 }
+}
+@server message server_info { int32 load = 1 }
+message user {
+	int64 uid = 1
+	oneof ext { @server int32 sid = 3; @client int32 zone = 4 }
+	oneof only { @server int32 x = 5 }
+}
+service user_svr {
+	login(login_rq) user
+	@gm kick(int64 uid) ()
+}
+@gm service gm_svr {
+	debug(login_rq) ()
+}
+@server service notify_svr {
+	push(int64 uid) ()
+}
+`
+	prog, err := Parse("test.wproto", []byte(src))
+	if !assert.Nil(t, err, "parse %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse %v", err) {
+		return
+	}
+	assert.Len(t, prog.GetFileDesc().Msgs, 5, "desc before filter")
+	if !assert.Nil(t, prog.FilterTarget("client"), "filter client") {
+		return
+	}
+	assert.Equal(t, "client", prog.Target(), "target")
+	names := []string{}
+	for _, msg := range prog.Messages {
+		names = append(names, msg.Name)
+	}
+	assert.Equal(t, []string{"login_rq", "user"}, names, "messages")
+	rq := prog.Messages[0]
+	assert.Len(t, rq.Fields, 1, "fields")
+	assert.Len(t, rq.SubMsgs, 0, "nested message")
+	user := prog.Messages[1]
+	assert.Len(t, user.Fields, 2, "oneof fields")
+	if assert.Len(t, user.Oneofs, 1, "empty oneof removed") {
+		assert.Len(t, user.Oneofs[0].Fields, 1, "oneof field")
+	}
+	assert.Len(t, prog.EnumDefs[0].Values, 1, "enum values")
+	if assert.Len(t, prog.Services, 1, "services") {
+		assert.Len(t, prog.Services[0].Methods, 1, "methods")
+	}
+	desc := prog.GetFileDesc()
+	assert.Equal(t, "client", desc.Target, "target desc")
+	assert.Len(t, desc.Msgs, 2, "desc after filter")
+
+	// 保留的定义引用了裁剪的定义
+	for name, src := range map[string]string{
+		"field":   "@server message s {}\nmessage m { s v = 1 }\n",
+		"list":    "@server message s {}\nmessage m { []s v = 1 }\n",
+		"map":     "@server message s {}\nmessage m { map<int32,s> v = 1 }\n",
+		"nested":  "@server message s { message n {} }\nmessage m { s.n v = 1 }\n",
+		"method":  "@server message s {}\nservice svc { f(s) () }\n",
+		"include": "@server message s { int32 a = 1 }\nmessage m { include s }\n",
+		"default": "enum e { a = 0; @server b = 1 }\nmessage m { e v = 1 { default = e.b } }\n",
+	} {
+		prog, err := Parse("test.wproto", []byte("package test\n"+src))
+		if err == nil {
+			err = prog.AnalyseProgram()
+		}
+		if !assert.Nil(t, err, name) {
+			continue
+		}
+		assert.NotNil(t, prog.FilterTarget("client"), name)
+	}
+}
+
+func TestParseDocTags(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+// 旧的用户信息
+// @deprecated use user2
+// @since 1.4
+// @see user2, test.kind
+message user {
+	int64 uid = 1
+}
+/*
+新的用户信息
+@example
+	user2{uid: 1}
+	user2{uid: 2}
+@since 1.5
+*/
+message user2 {
+	int64 uid = 1 // @deprecated
+}
+`))
+	if !assert.Nil(t, err, "parse doc tags %v", err) {
+		return
+	}
+	user := prog.Messages[0]
+	assert.True(t, user.IsDeprecated(), "deprecated")
+	assert.Equal(t, "use user2", user.DeprecatedReason, "deprecated reason")
+	assert.Equal(t, "1.4", user.Since, "since")
+	assert.Equal(t, []string{"user2", "test.kind"}, user.See, "see")
+	user2 := prog.Messages[1]
+	assert.False(t, user2.IsDeprecated(), "not deprecated")
+	assert.Equal(t, []string{"user2{uid: 1}\nuser2{uid: 2}"}, user2.Examples, "examples")
+	assert.Equal(t, "1.5", user2.Since, "since after example")
+	assert.True(t, user2.Fields[0].IsDeprecated(), "tail doc deprecated")
+
+	desc := prog.GetFileDesc().Msgs[0].Doc
+	assert.True(t, desc.Deprecated, "desc deprecated")
+	assert.Equal(t, "use user2", desc.DeprecatedReason, "desc reason")
+	assert.Equal(t, []string{"user2", "test.kind"}, desc.See, "desc see")
+}
+
+func TestParseSourcePos(t *testing.T) {
+	prog, err := Parse("test.wproto", []byte(`package test
+enum kind {
+	normal = 0
+}
+message outer {
+	go.tag = 1
+	int32 a = 1
+	message inner {
+		int64 b = 1
+	}
+}
+service svc {
+	get(outer) outer
+}
+`))
+	if !assert.Nil(t, err, "parse %v", err) {
+		return
+	}
+	if err = prog.AnalyseProgram(); !assert.Nil(t, err, "analyse %v", err) {
+		return
+	}
+	desc := prog.GetFileDesc()
+	pos := desc.Enums[0].Pos
+	assert.Equal(t, "test.wproto", pos.File, "file")
+	assert.Equal(t, []int32{2, 6, 4, 1}, []int32{pos.Line, pos.Column, pos.EndLine, pos.EndColumn}, "enum pos")
+	assert.Equal(t, "Enums/kind", pos.Path, "enum path")
+	assert.Equal(t, "Enums/kind/Values/normal", desc.Enums[0].Values[0].Pos.Path, "enum value path")
+	assert.EqualValues(t, 3, desc.Enums[0].Values[0].Pos.Line, "enum value line")
+
+	var outer *buildpb.MsgDesc
+	for _, msg := range desc.Msgs {
+		if msg.Name == "outer" {
+			outer = msg
+		}
+	}
+	if !assert.NotNil(t, outer, "outer message") {
+		return
+	}
+	assert.EqualValues(t, 11, outer.Pos.EndLine, "message end")
+	assert.Equal(t, "Msgs/outer/Fields/a", outer.Fields[0].Pos.Path, "field path")
+	assert.EqualValues(t, 7, outer.Fields[0].Pos.Line, "field line")
+	assert.Equal(t, "Msgs/outer/Options/go.tag", outer.Options.Options["go.tag"].Pos.Path, "option path")
+	assert.EqualValues(t, 6, outer.Options.Options["go.tag"].Pos.Line, "option line")
+	assert.Equal(t, "Msgs/outer/SubMsgs/inner/Fields/b", outer.SubMsgs[0].Fields[0].Pos.Path, "nested field path")
+
+	method := desc.Services[0].Methods[0]
+	assert.Equal(t, "Services/svc/Methods/get", method.Pos.Path, "method path")
+	assert.EqualValues(t, 13, method.Pos.Line, "method line")
+	assert.EqualValues(t, 14, desc.Services[0].Pos.EndLine, "service end")
+}
+
+func TestParseContextAnalyser(t *testing.T) {
+	dep := &ast.YTProgram{Pkg: &ast.YTPackage{Name: "dep"}}
+	var files []string
+	ctx := &ast.Context{Analyser: ast.RecursionAnalyseFunc(func(file string) (*ast.YTProgram, error) {
+		files = append(files, file)
+		if file == "dep.wproto" {
+			return dep, nil
+		}
+		return nil, nil
+	})}
+	prog, err := ParseContext(ctx, "test.wproto", []byte("package test\nimport \"dep.wproto\"\nimport \"other.wproto\"\n"))
+	if !assert.Nil(t, err, "parse %v", err) {
+		return
+	}
+	assert.Equal(t, []string{"dep.wproto", "other.wproto"}, files, "analyse imports")
+	assert.Same(t, dep, prog.Imports[0].Prog, "analysed import")
+	assert.Nil(t, prog.Imports[1].Prog, "import not analysed")
+}
@@ -17,44 +17,63 @@ package protocol
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"path"
 	"testing"
 
 	"github.com/walleframe/wctl/protocol/ast"
-	"github.com/walleframe/wctl/protocol/wproto/lexer"
-	"github.com/walleframe/wctl/protocol/wproto/parser"
+	"github.com/walleframe/wctl/protocol/wproto"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/walleframe/wctl/utils"
 )
 
-func parseWProtoSrc(src string) (*ast.YTProgram, error) {
-	s := lexer.NewLexer([]byte(src))
-	p := parser.NewParser()
-	a, err := p.Parse(s)
-	if err != nil {
-		return nil, err
-	}
-	if val, ok := a.(*ast.YTProgram); ok {
-		return val, nil
-	}
-	return nil, errors.New("invalid type")
+func parseWProtoSrc(src string, analyser ast.RecursionAnalyser) (*ast.YTProgram, error) {
+	return wproto.ParseContext(&ast.Context{Analyser: analyser}, "test.wproto", []byte(src))
 }
 
 func optInt(v int64) *ast.YTOptionValue {
 	return &ast.YTOptionValue{
+		Kind:   ast.OptionValueInt,
 		IntVal: &v,
 	}
 }
 
 func optString(v string) *ast.YTOptionValue {
 	return &ast.YTOptionValue{
+		Kind:  ast.OptionValueString,
 		Value: &v,
 	}
 }
 
+// 方法请求/回复引用的消息, 分析阶段替换为实际定义
+func methodRef(name, field string) *ast.YTMessage {
+	return &ast.YTMessage{
+		Name: name,
+		Fields: []*ast.YTField{
+			{
+				No:   1,
+				Name: field,
+				Type: &ast.YTFieldType{
+					YTCustomType: &ast.YTCustomType{Name: name},
+				},
+			},
+		},
+	}
+}
+
+func optBool(v bool) *ast.YTOptionValue {
+	i := int64(0)
+	if v {
+		i = 1
+	}
+	return &ast.YTOptionValue{
+		Kind:    ast.OptionValueBool,
+		BoolVal: &v,
+		IntVal:  &i,
+	}
+}
+
 func doc(v ...string) *ast.YTDoc {
 	return &ast.YTDoc{
 		Doc: v,
@@ -66,7 +85,7 @@ func TestWProtoParse(t *testing.T) {
 	if 1 == 0 {
 		utils.Flag.ShowDetail = true
 	}
-	ast.RegisterRecursionAnalyser = ast.RecursionAnalyseFunc(func(file string) (prog *ast.YTProgram, err error) {
+	analyser := ast.RecursionAnalyseFunc(func(file string) (prog *ast.YTProgram, err error) {
 		prog = &ast.YTProgram{
 			Pkg: &ast.YTPackage{
 				Name: path.Base(file),
@@ -110,7 +129,7 @@ test.opt3 = "xx"
 // package doc
 package x1
 
-test.opt1
+test.opt1 = true
 // opt comment
 test.opt2 = 1
 test.opt3 = "xx"
@@ -123,7 +142,8 @@ test.opt3 = "xx"
 				YTOptions: ast.YTOptions{
 					Opts: []*ast.YTOption{
 						{
-							Key: "test.opt1",
+							Key:   "test.opt1",
+							Value: optBool(true),
 						},
 						{
 							YTDoc: doc("// opt comment"),
@@ -158,7 +178,7 @@ test.opt3 = "xx"
 		},
 		{
 			name: "enum",
-			src:  `package x1;enum e1 {x=2;y;z=6}; enum e2{a;b;c;opt.xx}`,
+			src:  `package x1;enum e1 {x=2;y=3;z=6}; enum e2{a=0;b=1;c=2;opt.xx=true}`,
 			data: &ast.YTProgram{
 				Pkg: &ast.YTPackage{
 					Name: "x1",
@@ -186,7 +206,8 @@ test.opt3 = "xx"
 						YTOptions: ast.YTOptions{
 							Opts: []*ast.YTOption{
 								{
-									Key: "opt.xx",
+									Key:   "opt.xx",
+									Value: optBool(true),
 								},
 							},
 						},
@@ -216,12 +237,12 @@ package x1
 
 // comment for m1
 message m1 {
-  test.opt1
+  test.opt1 = true
   int32 f1 = 1;
   int64 f2 = 2
   // comment f3
   string f3 = 3 {
-     fopt.v1
+     fopt.v1 = true
      // comment fopt.v2
      fopt.v2 = "xx"
   }
@@ -265,7 +286,8 @@ message m1 {
 								YTOptions: ast.YTOptions{
 									Opts: []*ast.YTOption{
 										{
-											Key: "fopt.v1",
+											Key:   "fopt.v1",
+											Value: optBool(true),
 										},
 										{
 											Key:   "fopt.v2",
@@ -293,7 +315,8 @@ message m1 {
 						YTOptions: ast.YTOptions{
 							Opts: []*ast.YTOption{
 								{
-									Key: "test.opt1",
+									Key:   "test.opt1",
+									Value: optBool(true),
 								},
 								{
 									Key:   "test.opt2",
@@ -324,7 +347,7 @@ message m1 {
 		},
 		{
 			name: "message only options",
-			src:  `package x1;message m1 {test.opt1}`,
+			src:  `package x1;message m1 {test.opt1 = true}`,
 			data: &ast.YTProgram{
 				Pkg: &ast.YTPackage{
 					Name: "x1",
@@ -335,7 +358,8 @@ message m1 {
 						YTOptions: ast.YTOptions{
 							Opts: []*ast.YTOption{
 								{
-									Key: "test.opt1",
+									Key:   "test.opt1",
+									Value: optBool(true),
 								},
 							},
 						},
@@ -345,7 +369,7 @@ message m1 {
 		},
 		{
 			name: "message only options 2",
-			src:  `package x1;message m1 {test.opt1}; message m2{}`,
+			src:  `package x1;message m1 {test.opt1 = true}; message m2{}`,
 			data: &ast.YTProgram{
 				Pkg: &ast.YTPackage{
 					Name: "x1",
@@ -356,7 +380,8 @@ message m1 {
 						YTOptions: ast.YTOptions{
 							Opts: []*ast.YTOption{
 								{
-									Key: "test.opt1",
+									Key:   "test.opt1",
+									Value: optBool(true),
 								},
 							},
 						},
@@ -398,10 +423,10 @@ message m1 {
 			src: `package x1;message m1 {int32 f1 = 1;}; message m2{};
 service s1
 {
-  set(m1);
-  get() m1;
-  mul(m1) m2{
-    opt.m1
+  set(m1) void;
+  get(void) m1;
+  mul(m1) m2 {
+    opt.m1 = true
     opt.m2 = 2
   };
   opt.xx = "1"
@@ -441,56 +466,22 @@ service s1
 						},
 						Methods: []*ast.YTMethod{
 							{
-								Name: "set",
-								Request: &ast.YTMessage{
-									Name: "m1",
-									Fields: []*ast.YTField{
-										{
-											No:   1,
-											Name: "f1",
-											Type: &ast.YTFieldType{
-												YTBaseType: ast.BaseTypeInt32,
-											},
-										},
-									},
-								},
+								Name:    "set",
+								Request: methodRef("m1", "rq"),
 							},
 							{
-								Name: "get",
-								Reply: &ast.YTMessage{
-									Name: "m1",
-									Fields: []*ast.YTField{
-										{
-											No:   1,
-											Name: "f1",
-											Type: &ast.YTFieldType{
-												YTBaseType: ast.BaseTypeInt32,
-											},
-										},
-									},
-								},
+								Name:  "get",
+								Reply: methodRef("m1", "rs"),
 							},
 							{
-								Name: "mul",
-								Request: &ast.YTMessage{
-									Name: "m1",
-									Fields: []*ast.YTField{
-										{
-											No:   1,
-											Name: "f1",
-											Type: &ast.YTFieldType{
-												YTBaseType: ast.BaseTypeInt32,
-											},
-										},
-									},
-								},
-								Reply: &ast.YTMessage{
-									Name: "m2",
-								},
+								Name:    "mul",
+								Request: methodRef("m1", "rq"),
+								Reply:   methodRef("m2", "rs"),
 								YTOptions: ast.YTOptions{
 									Opts: []*ast.YTOption{
 										{
-											Key: "opt.m1",
+											Key:   "opt.m1",
+											Value: optBool(true),
 										},
 										{
 											Key:   "opt.m2",
@@ -508,7 +499,7 @@ service s1
 			name: "project",
 			src: `package x1;
 project m1 {
-test.opt1
+test.opt1 = true
 xx:
   vcf.ff = "xx"
 }`,
@@ -519,11 +510,13 @@ xx:
 				Projects: []*ast.YTProject{
 					{
 						Name: "m1",
+						Area: "xx",
 						Conf: map[string]*ast.YTOptions{
 							"": {
 								Opts: []*ast.YTOption{
 									{
-										Key: "test.opt1",
+										Key:   "test.opt1",
+										Value: optBool(true),
 									},
 								},
 							},
@@ -584,7 +577,7 @@ xx:
 			continue
 		}
 		t.Run(fmt.Sprintf("parse %s", v.name), func(t *testing.T) {
-			prog, err := parseWProtoSrc(v.src)
+			prog, err := parseWProtoSrc(v.src, analyser)
 			if v.err {
 				t.Logf("parse %s error: %v\n", v.name, err)
 				assert.NotNil(t, err, "it should be error")
@@ -596,7 +589,7 @@ xx:
 				return
 			}
 			if v.data.Pkg != nil || prog.Pkg != nil {
-				assert.Equal(t, v.data.Pkg, prog.Pkg, "compare package")
+				assertValue(t, v.data.Pkg, prog.Pkg, "compare package")
 			}
 			if len(v.data.YTOptions.Opts) > 0 || len(prog.YTOptions.Opts) > 0 {
 				assertValue(t, v.data.YTOptions.Opts, prog.YTOptions.Opts, "compare file options")
@@ -626,7 +619,28 @@ xx:
 }
 
 func assertValue(t testing.TB, except, value interface{}, msgAndArgs ...interface{}) {
-	j1, _ := json.Marshal(except)
-	j2, _ := json.Marshal(value)
-	assert.JSONEq(t, string(j1), string(j2), msgAndArgs...)
+	assert.Equal(t, withoutPos(except), withoutPos(value), msgAndArgs...)
+}
+
+// 转换为json结构并删除源码位置. 期望值不填写位置
+func withoutPos(v interface{}) (data interface{}) {
+	buf, _ := json.Marshal(v)
+	json.Unmarshal(buf, &data)
+	var strip func(v interface{})
+	strip = func(v interface{}) {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			delete(val, "DefPos")
+			delete(val, "EndPos")
+			for _, item := range val {
+				strip(item)
+			}
+		case []interface{}:
+			for _, item := range val {
+				strip(item)
+			}
+		}
+	}
+	strip(data)
+	return
 }
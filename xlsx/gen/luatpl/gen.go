@@ -57,7 +57,7 @@ func exportMergeDefine(sheets []*parser.XlsxSheet, opts *gen.ExportOption) (err
 			return err
 		}
 		// set sheet scripts
-		setLuaState(l, sheets)
+		setLuaState(l, sheets, opts)
 		// run
 		err = l.DoFile(filepath.Join(path, "init.lua"))
 		if err != nil {